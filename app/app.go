@@ -20,6 +20,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/cosmos/cosmos-sdk/x/staking"
 
+	"github.com/ovrclk/akash/x/audit"
+	"github.com/ovrclk/akash/x/authz"
+	"github.com/ovrclk/akash/x/cert"
 	"github.com/ovrclk/akash/x/deployment"
 	"github.com/ovrclk/akash/x/market"
 	"github.com/ovrclk/akash/x/provider"
@@ -66,6 +69,9 @@ type AkashApp struct {
 		deployment deployment.Keeper
 		market     market.Keeper
 		provider   provider.Keeper
+		audit      audit.Keeper
+		authz      authz.Keeper
+		cert       cert.Keeper
 	}
 
 	mm *module.Manager
@@ -203,6 +209,7 @@ func NewApp(
 	)
 
 	app.setAkashKeepers()
+	app.setAkashUpgradeHandlers()
 
 	app.mm = module.NewManager(
 		append([]module.AppModule{
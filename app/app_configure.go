@@ -3,7 +3,13 @@
 package app
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade"
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/audit"
+	"github.com/ovrclk/akash/x/authz"
+	"github.com/ovrclk/akash/x/cert"
 	"github.com/ovrclk/akash/x/deployment"
 	"github.com/ovrclk/akash/x/market"
 	"github.com/ovrclk/akash/x/provider"
@@ -14,6 +20,9 @@ func akashModuleBasics() []module.AppModuleBasic {
 		deployment.AppModuleBasic{},
 		market.AppModuleBasic{},
 		provider.AppModuleBasic{},
+		audit.AppModuleBasic{},
+		authz.AppModuleBasic{},
+		cert.AppModuleBasic{},
 	}
 }
 
@@ -22,6 +31,9 @@ func akashKVStoreKeys() []string {
 		deployment.StoreKey,
 		market.StoreKey,
 		provider.StoreKey,
+		audit.StoreKey,
+		authz.StoreKey,
+		cert.StoreKey,
 	}
 }
 
@@ -29,6 +41,7 @@ func (app *AkashApp) setAkashKeepers() {
 	app.keeper.deployment = deployment.NewKeeper(
 		app.cdc,
 		app.keys[deployment.StoreKey],
+		app.keeper.params.Subspace(deployment.DefaultParamspace),
 	)
 
 	app.keeper.market = market.NewKeeper(
@@ -39,7 +52,60 @@ func (app *AkashApp) setAkashKeepers() {
 	app.keeper.provider = provider.NewKeeper(
 		app.cdc,
 		app.keys[provider.StoreKey],
+		app.keeper.params.Subspace(provider.DefaultParamspace),
 	)
+
+	app.keeper.audit = audit.NewKeeper(
+		app.cdc,
+		app.keys[audit.StoreKey],
+		app.keeper.params.Subspace(audit.DefaultParamspace),
+	)
+
+	app.keeper.authz = authz.NewKeeper(
+		app.cdc,
+		app.keys[authz.StoreKey],
+	)
+
+	app.keeper.cert = cert.NewKeeper(
+		app.cdc,
+		app.keys[cert.StoreKey],
+	)
+}
+
+// storeMigrationPlans are the upgrade plans that migrate akash module stores
+// to their consensus versions
+var storeMigrationPlans = []string{
+	"akash-stores-v2",
+	"akash-stores-v3",
+	"akash-stores-v4",
+}
+
+func (app *AkashApp) akashMigrator() *sdkutil.Migrator {
+	m := sdkutil.NewMigrator()
+	if err := deployment.RegisterMigrations(m, app.keeper.deployment); err != nil {
+		panic(err)
+	}
+	if err := market.RegisterMigrations(m, app.keeper.market); err != nil {
+		panic(err)
+	}
+	if err := provider.RegisterMigrations(m, app.keeper.provider); err != nil {
+		panic(err)
+	}
+	if err := audit.RegisterMigrations(m, app.keeper.audit); err != nil {
+		panic(err)
+	}
+	return m
+}
+
+func (app *AkashApp) setAkashUpgradeHandlers() {
+	migrator := app.akashMigrator()
+	for _, name := range storeMigrationPlans {
+		app.keeper.upgrade.SetUpgradeHandler(name, func(ctx sdk.Context, plan upgrade.Plan) {
+			if err := migrator.Migrate(ctx); err != nil {
+				panic(err)
+			}
+		})
+	}
 }
 
 func (app *AkashApp) akashAppModules() []module.AppModule {
@@ -47,17 +113,30 @@ func (app *AkashApp) akashAppModules() []module.AppModule {
 		deployment.NewAppModule(
 			app.keeper.deployment,
 			app.keeper.market,
+			app.keeper.authz,
 			app.keeper.bank,
 		),
 
 		market.NewAppModule(
 			app.keeper.market,
 			app.keeper.deployment,
+			app.keeper.provider,
+			app.keeper.audit,
+			app.keeper.bank,
+		),
+
+		provider.NewAppModule(
 			app.keeper.provider,
 			app.keeper.bank,
+			app.keeper.market,
+			app.keeper.deployment,
 		),
 
-		provider.NewAppModule(app.keeper.provider, app.keeper.bank, app.keeper.market),
+		audit.NewAppModule(app.keeper.audit, app.keeper.provider),
+
+		authz.NewAppModule(app.keeper.authz),
+
+		cert.NewAppModule(app.keeper.cert),
 	}
 }
 
@@ -69,8 +148,11 @@ func (app *AkashApp) akashEndBlockModules() []string {
 
 func (app *AkashApp) akashInitGenesisOrder() []string {
 	return []string{
+		authz.ModuleName,
 		deployment.ModuleName,
 		provider.ModuleName,
+		audit.ModuleName,
+		cert.ModuleName,
 		market.ModuleName,
 	}
 }
@@ -17,6 +17,9 @@ func akashKVStoreKeys() []string {
 func (app *AkashApp) setAkashKeepers() {
 }
 
+func (app *AkashApp) setAkashUpgradeHandlers() {
+}
+
 func (app *AkashApp) akashAppModules() []module.AppModule {
 	return []module.AppModule{}
 }
@@ -10,7 +10,6 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 
-	simapp "github.com/cosmos/cosmos-sdk/simapp"
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
@@ -19,7 +18,7 @@ func TestSimAppExport(t *testing.T) {
 	app := NewApp(log.NewTMLogger(log.NewSyncWriter(os.Stdout)),
 		db, nil, 0, map[int64]bool{})
 
-	genesisState := simapp.NewDefaultGenesisState()
+	genesisState := ModuleBasics().DefaultGenesis()
 	stateBytes, err := codec.MarshalJSONIndent(app.Codec(), genesisState)
 	require.NoError(t, err)
 
@@ -46,3 +45,23 @@ func TestBlackListedAddrs(t *testing.T) {
 		require.True(t, app.keeper.bank.BlacklistedAddr(app.keeper.supply.GetModuleAddress(acc)))
 	}
 }
+
+func TestAkashInvariantsRegistered(t *testing.T) {
+	db := dbm.NewMemDB()
+	app := NewApp(log.NewNopLogger(), db, nil, 0, map[int64]bool{})
+
+	routes := make(map[string]bool)
+	for _, route := range app.keeper.crisis.Routes() {
+		routes[route.FullRoute()] = true
+	}
+
+	for _, route := range []string{
+		"deployment/closed-deployments",
+		"deployment/matched-groups",
+		"market/active-leases",
+		"market/matched-orders",
+		"provider/registered-providers",
+	} {
+		require.True(t, routes[route], route)
+	}
+}
@@ -87,6 +87,9 @@ func TestFullAppSimulation(t *testing.T) {
 	require.NoError(t, err)
 	require.NoError(t, simErr)
 
+	// crisis checks invariants every FlagPeriodValue blocks; check the final state as well
+	app.keeper.crisis.AssertInvariants(app.NewContext(true, abci.Header{Height: app.LastBlockHeight()}))
+
 	if config.Commit {
 		simapp.PrintStats(db)
 	}
@@ -1,6 +1,8 @@
 package client
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 
 	ccontext "github.com/cosmos/cosmos-sdk/client/context"
@@ -8,6 +10,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authutils "github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	aquery "github.com/ovrclk/akash/x/audit/query"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dquery "github.com/ovrclk/akash/x/deployment/query"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mquery "github.com/ovrclk/akash/x/market/query"
@@ -24,13 +28,13 @@ var (
 	ErrBroadcastTx = errors.New("broadcast tx error")
 )
 
-// QueryClient interface includes query clients of deployment, market and provider modules
+// QueryClient interface includes query clients of deployment, market, provider and audit modules
 type QueryClient interface {
 	dquery.Client
 	mquery.Client
 	pquery.Client
+	aquery.Client
 
-	// TODO: implement with search parameters
 	ActiveLeasesForProvider(id sdk.AccAddress) (mquery.Leases, error)
 }
 
@@ -77,7 +81,9 @@ func (c *client) Tx() TxClient {
 	return c
 }
 
-func (c *client) Broadcast(msgs ...sdk.Msg) error {
+func (c *client) Broadcast(msgs ...sdk.Msg) (err error) {
+	defer func(start time.Time) { observeBroadcast(start, err) }(time.Now())
+
 	txbldr, err := authutils.PrepareTxBuilder(c.txbldr, c.cctx)
 	if err != nil {
 		return err
@@ -109,6 +115,7 @@ type qclient struct {
 	dclient dquery.Client
 	mclient mquery.Client
 	pclient pquery.Client
+	aclient aquery.Client
 }
 
 // NewQueryClient creates new query client instance
@@ -116,18 +123,21 @@ func NewQueryClient(
 	dclient dquery.Client,
 	mclient mquery.Client,
 	pclient pquery.Client,
+	aclient aquery.Client,
 ) QueryClient {
 	return &qclient{
 		dclient: dclient,
 		mclient: mclient,
 		pclient: pclient,
+		aclient: aclient,
 	}
 }
 
-func (c *qclient) Deployments(filters dquery.DeploymentFilters) (dquery.Deployments, error) {
+func (c *qclient) Deployments(filters dquery.DeploymentFilters) (dquery.DeploymentsResponse, error) {
 	if c.dclient == nil {
-		return dquery.Deployments{}, ErrClientNotFound
+		return dquery.DeploymentsResponse{}, ErrClientNotFound
 	}
+	defer observeQuery("deployments", time.Now())
 	return c.dclient.Deployments(filters)
 }
 
@@ -135,6 +145,7 @@ func (c *qclient) Deployment(id dtypes.DeploymentID) (dquery.Deployment, error)
 	if c.dclient == nil {
 		return dquery.Deployment{}, ErrClientNotFound
 	}
+	defer observeQuery("deployment", time.Now())
 	return c.dclient.Deployment(id)
 }
 
@@ -142,13 +153,15 @@ func (c *qclient) Group(id dtypes.GroupID) (dquery.Group, error) {
 	if c.dclient == nil {
 		return dquery.Group{}, ErrClientNotFound
 	}
+	defer observeQuery("group", time.Now())
 	return c.dclient.Group(id)
 }
 
-func (c *qclient) Orders(filters mquery.OrderFilters) (mquery.Orders, error) {
+func (c *qclient) Orders(filters mquery.OrderFilters) (mquery.OrdersResponse, error) {
 	if c.mclient == nil {
-		return mquery.Orders{}, ErrClientNotFound
+		return mquery.OrdersResponse{}, ErrClientNotFound
 	}
+	defer observeQuery("orders", time.Now())
 	return c.mclient.Orders(filters)
 }
 
@@ -156,13 +169,15 @@ func (c *qclient) Order(id mtypes.OrderID) (mquery.Order, error) {
 	if c.mclient == nil {
 		return mquery.Order{}, ErrClientNotFound
 	}
+	defer observeQuery("order", time.Now())
 	return c.mclient.Order(id)
 }
 
-func (c *qclient) Bids(filters mquery.BidFilters) (mquery.Bids, error) {
+func (c *qclient) Bids(filters mquery.BidFilters) (mquery.BidsResponse, error) {
 	if c.mclient == nil {
-		return mquery.Bids{}, ErrClientNotFound
+		return mquery.BidsResponse{}, ErrClientNotFound
 	}
+	defer observeQuery("bids", time.Now())
 	return c.mclient.Bids(filters)
 }
 
@@ -170,13 +185,15 @@ func (c *qclient) Bid(id mtypes.BidID) (mquery.Bid, error) {
 	if c.mclient == nil {
 		return mquery.Bid{}, ErrClientNotFound
 	}
+	defer observeQuery("bid", time.Now())
 	return c.mclient.Bid(id)
 }
 
-func (c *qclient) Leases(filters mquery.LeaseFilters) (mquery.Leases, error) {
+func (c *qclient) Leases(filters mquery.LeaseFilters) (mquery.LeasesResponse, error) {
 	if c.mclient == nil {
-		return mquery.Leases{}, ErrClientNotFound
+		return mquery.LeasesResponse{}, ErrClientNotFound
 	}
+	defer observeQuery("leases", time.Now())
 	return c.mclient.Leases(filters)
 }
 
@@ -184,13 +201,23 @@ func (c *qclient) Lease(id mtypes.LeaseID) (mquery.Lease, error) {
 	if c.mclient == nil {
 		return mquery.Lease{}, ErrClientNotFound
 	}
+	defer observeQuery("lease", time.Now())
 	return c.mclient.Lease(id)
 }
 
+func (c *qclient) Stats(filters mquery.StatsFilters) (mquery.MarketStats, error) {
+	if c.mclient == nil {
+		return mquery.MarketStats{}, ErrClientNotFound
+	}
+	defer observeQuery("stats", time.Now())
+	return c.mclient.Stats(filters)
+}
+
 func (c *qclient) Providers() (pquery.Providers, error) {
 	if c.pclient == nil {
 		return pquery.Providers{}, ErrClientNotFound
 	}
+	defer observeQuery("providers", time.Now())
 	return c.pclient.Providers()
 }
 
@@ -198,5 +225,30 @@ func (c *qclient) Provider(id sdk.AccAddress) (*pquery.Provider, error) {
 	if c.pclient == nil {
 		return nil, ErrClientNotFound
 	}
+	defer observeQuery("provider", time.Now())
 	return c.pclient.Provider(id)
 }
+
+func (c *qclient) AllProvidersAttributes() (aquery.Providers, error) {
+	if c.aclient == nil {
+		return aquery.Providers{}, ErrClientNotFound
+	}
+	defer observeQuery("all-providers-attributes", time.Now())
+	return c.aclient.AllProvidersAttributes()
+}
+
+func (c *qclient) ProviderAttributes(id sdk.AccAddress) (aquery.Providers, error) {
+	if c.aclient == nil {
+		return aquery.Providers{}, ErrClientNotFound
+	}
+	defer observeQuery("provider-attributes", time.Now())
+	return c.aclient.ProviderAttributes(id)
+}
+
+func (c *qclient) ProviderAuditorAttributes(id atypes.ProviderID) (aquery.Provider, error) {
+	if c.aclient == nil {
+		return aquery.Provider{}, ErrClientNotFound
+	}
+	defer observeQuery("provider-auditor-attributes", time.Now())
+	return c.aclient.ProviderAuditorAttributes(id)
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "akash_client_query_duration_seconds",
+		Help: "Latency of chain queries",
+	}, []string{"query"})
+
+	broadcastDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "akash_client_broadcast_duration_seconds",
+		Help: "Latency of transaction broadcasts by result: ok or error",
+	}, []string{"result"})
+)
+
+// observeQuery records the duration of query started at start; use with defer
+func observeQuery(query string, start time.Time) {
+	queryDuration.WithLabelValues(query).Observe(time.Since(start).Seconds())
+}
+
+func observeBroadcast(start time.Time, err error) {
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	broadcastDuration.WithLabelValues(result).Observe(time.Since(start).Seconds())
+}
@@ -5,7 +5,9 @@ package main
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	ecmd "github.com/ovrclk/akash/events/cmd"
+	icmd "github.com/ovrclk/akash/indexer/cmd"
 	pcmd "github.com/ovrclk/akash/provider/cmd"
+	scmd "github.com/ovrclk/akash/sdl/cmd"
 	"github.com/spf13/cobra"
 )
 
@@ -13,5 +15,7 @@ func addOtherCommands(root *cobra.Command, cdc *codec.Codec) {
 	root.AddCommand(
 		pcmd.RootCmd(cdc),
 		ecmd.EventCmd(cdc),
+		icmd.RootCmd(cdc),
+		scmd.SDLCmd(cdc),
 	)
 }
@@ -5,11 +5,15 @@ package main
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	ecmd "github.com/ovrclk/akash/events/cmd"
+	icmd "github.com/ovrclk/akash/indexer/cmd"
+	scmd "github.com/ovrclk/akash/sdl/cmd"
 	"github.com/spf13/cobra"
 )
 
 func addOtherCommands(root *cobra.Command, cdc *codec.Codec) {
 	root.AddCommand(
 		ecmd.EventCmd(cdc),
+		icmd.RootCmd(cdc),
+		scmd.SDLCmd(cdc),
 	)
 }
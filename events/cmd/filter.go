@@ -0,0 +1,126 @@
+package cmd
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/pflag"
+
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+const (
+	flagModule     = "module"
+	flagType       = "type"
+	flagOwner      = "owner"
+	flagProvider   = "provider"
+	flagDSeq       = "dseq"
+	flagFormat     = "format"
+	flagFromHeight = "from-height"
+
+	formatJSON  = "json"
+	formatTable = "table"
+
+	evOwnerKey    = "owner"
+	evProviderKey = "provider"
+	evDSeqKey     = "dseq"
+)
+
+// providerModules identify providers by their owner attribute
+var providerModules = []string{"provider", "audit"}
+
+// eventFilter selects the events to print. Empty fields match every event.
+type eventFilter struct {
+	modules  []string
+	types    []string
+	owner    string
+	provider string
+	dseq     uint64
+}
+
+func addFilterFlags(flags *pflag.FlagSet) {
+	flags.StringSlice(flagModule, nil, "Only print events of these modules (deployment, market, provider, audit)")
+	flags.StringSlice(flagType, nil, "Only print events of these types (e.g. order-created, lease-closed)")
+	flags.String(flagOwner, "", "Only print events of deployments, orders, bids and leases owned by this account")
+	flags.String(flagProvider, "", "Only print events of this provider")
+	flags.Uint64(flagDSeq, 0, "Only print events of the deployment with this sequence; use with --owner")
+}
+
+func filterFromFlags(flags *pflag.FlagSet) (eventFilter, error) {
+	var (
+		filter eventFilter
+		err    error
+	)
+
+	if filter.modules, err = flags.GetStringSlice(flagModule); err != nil {
+		return filter, err
+	}
+	if filter.types, err = flags.GetStringSlice(flagType); err != nil {
+		return filter, err
+	}
+	if filter.owner, err = flags.GetString(flagOwner); err != nil {
+		return filter, err
+	}
+	if filter.owner != "" {
+		if _, err = sdk.AccAddressFromBech32(filter.owner); err != nil {
+			return filter, err
+		}
+	}
+	if filter.provider, err = flags.GetString(flagProvider); err != nil {
+		return filter, err
+	}
+	if filter.provider != "" {
+		if _, err = sdk.AccAddressFromBech32(filter.provider); err != nil {
+			return filter, err
+		}
+	}
+	if filter.dseq, err = flags.GetUint64(flagDSeq); err != nil {
+		return filter, err
+	}
+	return filter, nil
+}
+
+// accept returns true if the event matches every filter set
+func (f eventFilter) accept(ev sdkutil.Event) bool {
+	if len(f.modules) > 0 && !contains(f.modules, ev.Module) {
+		return false
+	}
+
+	if len(f.types) > 0 && !contains(f.types, ev.Action) {
+		return false
+	}
+
+	owner, _ := sdkutil.GetString(ev.Attributes, evOwnerKey)
+
+	if f.provider != "" {
+		provider, err := sdkutil.GetString(ev.Attributes, evProviderKey)
+		if err != nil && contains(providerModules, ev.Module) {
+			provider = owner
+		}
+		if provider != f.provider {
+			return false
+		}
+	}
+
+	if f.owner != "" && (contains(providerModules, ev.Module) || owner != f.owner) {
+		return false
+	}
+
+	if f.dseq != 0 {
+		dseq, err := sdkutil.GetString(ev.Attributes, evDSeqKey)
+		if err != nil || dseq != strconv.FormatUint(f.dseq, 10) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func contains(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
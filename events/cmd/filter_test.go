@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/testutil"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+func parseModuleEvent(t *testing.T, mev sdkutil.ModuleEvent) sdkutil.Event {
+	t.Helper()
+	ev, err := sdkutil.ParseEvent(sdk.StringifyEvent(sdk.Events{mev.ToSDKEvent()}.ToABCIEvents()[0]))
+	require.NoError(t, err)
+	return ev
+}
+
+func TestEventFilter(t *testing.T) {
+	lease := testutil.LeaseID(t)
+	leaseEv := parseModuleEvent(t, mtypes.EventLeaseCreated{ID: lease, Price: testutil.Coin(t)})
+	providerEv := parseModuleEvent(t, ptypes.EventProviderUpdate{Owner: lease.Provider})
+
+	tests := []struct {
+		name     string
+		filter   eventFilter
+		lease    bool
+		provider bool
+	}{
+		{name: "empty", lease: true, provider: true},
+		{name: "module", filter: eventFilter{modules: []string{"market"}}, lease: true},
+		{name: "type", filter: eventFilter{types: []string{"provider-update"}}, provider: true},
+		{name: "type-mismatch", filter: eventFilter{types: []string{"lease-closed"}}},
+		{name: "owner", filter: eventFilter{owner: lease.Owner.String()}, lease: true},
+		{name: "owner-mismatch", filter: eventFilter{owner: testutil.AccAddress(t).String()}},
+		{name: "provider", filter: eventFilter{provider: lease.Provider.String()}, lease: true, provider: true},
+		{name: "deployment", filter: eventFilter{owner: lease.Owner.String(), dseq: lease.DSeq}, lease: true},
+		{name: "deployment-mismatch", filter: eventFilter{owner: lease.Owner.String(), dseq: lease.DSeq + 1}},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.lease, test.filter.accept(leaseEv), test.name)
+		assert.Equal(t, test.provider, test.filter.accept(providerEv), test.name)
+	}
+}
@@ -2,14 +2,21 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	ccontext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/cmd/common"
 	"github.com/ovrclk/akash/events"
 	"github.com/ovrclk/akash/pubsub"
+	"github.com/ovrclk/akash/sdkutil"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -25,12 +32,41 @@ func EventCmd(cdc *codec.Codec) *cobra.Command {
 		},
 	}
 
+	addFilterFlags(cmd.Flags())
+	cmd.Flags().String(flagFormat, formatJSON, "Output format: json (one event per line) or table")
+	cmd.Flags().Int64(flagFromHeight, 0, "Print the events of past blocks starting at this height before following new blocks")
+
 	return cmd
 }
 
-func getEvents(ctx context.Context, cdc *codec.Codec, _ *cobra.Command, _ []string) error {
+func getEvents(ctx context.Context, cdc *codec.Codec, cmd *cobra.Command, _ []string) error {
 	cctx := ccontext.NewCLIContext().WithCodec(cdc)
 
+	filter, err := filterFromFlags(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	format, err := cmd.Flags().GetString(flagFormat)
+	if err != nil {
+		return err
+	}
+
+	var printer eventPrinter
+	switch format {
+	case formatJSON:
+		printer = newJSONPrinter(os.Stdout)
+	case formatTable:
+		printer = newTablePrinter(os.Stdout)
+	default:
+		return fmt.Errorf("invalid format: %v", format)
+	}
+
+	height, err := cmd.Flags().GetInt64(flagFromHeight)
+	if err != nil {
+		return err
+	}
+
 	if err := cctx.Client.Start(); err != nil {
 		return err
 	}
@@ -47,6 +83,9 @@ func getEvents(ctx context.Context, cdc *codec.Codec, _ *cobra.Command, _ []stri
 	}
 
 	group.Go(func() error {
+		if height > 0 {
+			return events.PublishFrom(ctx, cctx.Client, "akash-cli", bus, height)
+		}
 		return events.Publish(ctx, cctx.Client, "akash-cli", bus)
 	})
 
@@ -56,10 +95,91 @@ func getEvents(ctx context.Context, cdc *codec.Codec, _ *cobra.Command, _ []stri
 			case <-subscriber.Done():
 				return nil
 			case ev := <-subscriber.Events():
-				cctx.PrintOutput(ev)
+				mev, ok := ev.(sdkutil.ModuleEvent)
+				if !ok {
+					continue
+				}
+				sev, err := sdkutil.ParseEvent(sdk.StringifyEvent(sdk.Events{mev.ToSDKEvent()}.ToABCIEvents()[0]))
+				if err != nil || !filter.accept(sev) {
+					continue
+				}
+				if err := printer.print(sev); err != nil {
+					return err
+				}
 			}
 		}
 	})
 
 	return group.Wait()
 }
+
+type eventPrinter interface {
+	print(sdkutil.Event) error
+}
+
+type jsonPrinter struct {
+	enc *json.Encoder
+}
+
+type jsonEvent struct {
+	Module     string            `json:"module"`
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+func newJSONPrinter(w io.Writer) eventPrinter {
+	return &jsonPrinter{enc: json.NewEncoder(w)}
+}
+
+func (p *jsonPrinter) print(ev sdkutil.Event) error {
+	obj := jsonEvent{
+		Module:     ev.Module,
+		Type:       ev.Action,
+		Attributes: make(map[string]string, len(ev.Attributes)),
+	}
+	for _, attr := range eventAttributes(ev) {
+		obj.Attributes[attr.Key] = attr.Value
+	}
+	return p.enc.Encode(obj)
+}
+
+// tablePrinter writes fixed width columns so rows line up while streaming
+type tablePrinter struct {
+	w      io.Writer
+	header bool
+}
+
+const tableRowFormat = "%-12v %-20v %v\n"
+
+func newTablePrinter(w io.Writer) eventPrinter {
+	return &tablePrinter{w: w}
+}
+
+func (p *tablePrinter) print(ev sdkutil.Event) error {
+	if !p.header {
+		if _, err := fmt.Fprintf(p.w, tableRowFormat, "MODULE", "TYPE", "ATTRIBUTES"); err != nil {
+			return err
+		}
+		p.header = true
+	}
+
+	attrs := make([]string, 0, len(ev.Attributes))
+	for _, attr := range eventAttributes(ev) {
+		attrs = append(attrs, attr.Key+"="+attr.Value)
+	}
+
+	_, err := fmt.Fprintf(p.w, tableRowFormat, ev.Module, ev.Action, strings.Join(attrs, " "))
+	return err
+}
+
+// eventAttributes returns the event attributes besides module and action
+func eventAttributes(ev sdkutil.Event) []sdk.Attribute {
+	attrs := make([]sdk.Attribute, 0, len(ev.Attributes))
+	for _, attr := range ev.Attributes {
+		if attr.Key == sdk.AttributeKeyModule || attr.Key == sdk.AttributeKeyAction {
+			continue
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
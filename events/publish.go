@@ -2,81 +2,273 @@ package events
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/sdkutil"
+	atypes "github.com/ovrclk/akash/x/audit/types"
+	certtypes "github.com/ovrclk/akash/x/cert/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
 	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
 	tmclient "github.com/tendermint/tendermint/rpc/client"
 	ctypes "github.com/tendermint/tendermint/rpc/core/types"
 	tmtmtypes "github.com/tendermint/tendermint/types"
-	"golang.org/x/sync/errgroup"
 )
 
+const (
+	blockTimeout = time.Minute
+
+	minReconnectDelay = time.Second
+	maxReconnectDelay = 30 * time.Second
+)
+
+// ErrBlockTimeout is the error when no new block is received within the block timeout
+var ErrBlockTimeout = errors.New("timed out waiting for new block")
+
+// EventBlockEnd is published after all the events of a block, letting
+// subscribers associate the preceding events with the block height.
+type EventBlockEnd struct {
+	Height int64
+}
+
+// Client is the tendermint RPC client needed to follow new blocks and query their results
+type Client interface {
+	tmclient.EventsClient
+	tmclient.StatusClient
+	BlockResults(height *int64) (*ctypes.ResultBlockResults, error)
+}
+
+// Status is the progress of a publisher
+type Status struct {
+	// Height of the last block whose events were published
+	Height int64 `json:"height"`
+	// Connected is false while the publisher is reconnecting
+	Connected bool `json:"connected"`
+}
+
+// Publisher publishes the events of every block to a bus. It follows new block
+// headers and reads each block's events through block results queries, so blocks
+// missed while disconnected are replayed once the publisher reconnects.
+type Publisher struct {
+	client Client
+	name   string
+	bus    pubsub.Bus
+	log    log.Logger
+
+	// blockTimeout is how long to wait for a new block before reconnecting
+	blockTimeout time.Duration
+
+	mtx       sync.Mutex
+	height    int64
+	connected bool
+}
+
+// NewPublisher returns a publisher that starts after the given height;
+// zero starts with the next block.
+func NewPublisher(log log.Logger, client Client, name string, bus pubsub.Bus, height int64) *Publisher {
+	return &Publisher{
+		client: client,
+		name:   name,
+		bus:    bus,
+		log:    log.With("cmp", "events-publisher"),
+		height: height,
+
+		blockTimeout: blockTimeout,
+	}
+}
+
 // Publish events using tm buses to clients. Waits on context
 // shutdown signals to exit.
-func Publish(ctx context.Context, tmbus tmclient.EventsClient, name string, bus pubsub.Bus) error {
+func Publish(ctx context.Context, client Client, name string, bus pubsub.Bus) error {
+	return NewPublisher(log.NewNopLogger(), client, name, bus, 0).Run(ctx)
+}
+
+// PublishFrom publishes the events of all blocks from the given height before
+// following new blocks. Waits on context shutdown signals to exit.
+func PublishFrom(ctx context.Context, client Client, name string, bus pubsub.Bus, height int64) error {
+	if height < 1 {
+		height = 1
+	}
+	return NewPublisher(log.NewNopLogger(), client, name, bus, height-1).Run(ctx)
+}
+
+// Backfill publishes the events of all blocks from the given height up to the latest one.
+// It returns the height of the last block published.
+func Backfill(ctx context.Context, client Client, height int64, bus pubsub.Bus) (int64, error) {
+	status, err := client.Status()
+	if err != nil {
+		return 0, err
+	}
+	if height < 1 {
+		height = 1
+	}
+	return publishBlocks(ctx, client, bus, height, status.SyncInfo.LatestBlockHeight)
+}
+
+// Status returns the current publisher status
+func (p *Publisher) Status() *Status {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	return &Status{Height: p.height, Connected: p.connected}
+}
+
+// Run publishes events until the context is done, reconnecting with backoff
+// whenever the connection to the node fails.
+func (p *Publisher) Run(ctx context.Context) error {
+	delay := minReconnectDelay
+
+	for {
+		height := p.Status().Height
+
+		err := p.follow(ctx)
+		p.setConnected(false)
+
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		// reset the backoff once blocks were published again
+		if p.Status().Height > height {
+			delay = minReconnectDelay
+		}
+
+		p.log.Error("following blocks", "err", err, "height", p.Status().Height, "retry-in", delay)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
 
+		if delay *= 2; delay > maxReconnectDelay {
+			delay = maxReconnectDelay
+		}
+	}
+}
+
+func (p *Publisher) follow(ctx context.Context) error {
 	const (
 		queuesz = 100
 	)
 	var (
-		txname  = name + "-tx"
-		blkname = name + "-blk"
+		blkname = p.name + "-blk"
 	)
 
-	txch, err := tmbus.Subscribe(ctx, txname, txQuery().String(), queuesz)
+	ch, err := p.client.Subscribe(ctx, blkname, blkQuery().String(), queuesz)
 	if err != nil {
 		return err
 	}
-	defer tmbus.UnsubscribeAll(ctx, txname)
+	defer p.client.UnsubscribeAll(context.Background(), blkname) // nolint: errcheck
 
-	blkch, err := tmbus.Subscribe(ctx, blkname, blkQuery().String(), queuesz)
+	status, err := p.client.Status()
 	if err != nil {
 		return err
 	}
-	defer tmbus.UnsubscribeAll(ctx, blkname)
-
-	g, ctx := errgroup.WithContext(ctx)
-
-	g.Go(func() error {
-		return publishEvents(ctx, txch, bus)
-	})
 
-	g.Go(func() error {
-		return publishEvents(ctx, blkch, bus)
-	})
+	p.setConnected(true)
 
-	return g.Wait()
-}
+	// replay the blocks missed before subscribing
+	if p.Status().Height == 0 {
+		p.setHeight(status.SyncInfo.LatestBlockHeight)
+	} else if err := p.publishTo(ctx, status.SyncInfo.LatestBlockHeight); err != nil {
+		return err
+	}
 
-func publishEvents(ctx context.Context, ch <-chan ctypes.ResultEvent, bus pubsub.Bus) error {
-	var err error
+	timer := time.NewTimer(p.blockTimeout)
+	defer timer.Stop()
 
-loop:
 	for {
 		select {
 		case <-ctx.Done():
-			break loop
+			return nil
+		case <-timer.C:
+			return fmt.Errorf("%w (%v)", ErrBlockTimeout, p.blockTimeout)
 		case ed := <-ch:
-			switch evt := ed.Data.(type) {
-			case tmtmtypes.EventDataTx:
-				if !evt.Result.IsOK() {
-					continue
-				}
-				processEvents(bus, evt.Result.GetEvents())
-			case tmtmtypes.EventDataNewBlockHeader:
-				processEvents(bus, evt.ResultEndBlock.GetEvents())
+			evt, ok := ed.Data.(tmtmtypes.EventDataNewBlockHeader)
+			if !ok {
+				continue
+			}
+
+			// publishing up to the new height also fills gaps left by missed headers
+			if err := p.publishTo(ctx, evt.Header.Height); err != nil {
+				return err
 			}
+
+			if !timer.Stop() {
+				<-timer.C
+			}
+			timer.Reset(p.blockTimeout)
 		}
 	}
+}
+
+// publishTo publishes the events of all blocks after the last published one up to height
+func (p *Publisher) publishTo(ctx context.Context, height int64) error {
+	from := p.Status().Height + 1
+	if from > height {
+		return nil
+	}
+	if from < height {
+		p.log.Info("replaying blocks", "from", from, "to", height)
+	}
 
+	last, err := publishBlocks(ctx, p.client, p.bus, from, height)
+	if last >= from {
+		p.setHeight(last)
+	}
 	return err
 }
 
+func (p *Publisher) setHeight(height int64) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.height = height
+}
+
+func (p *Publisher) setConnected(connected bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.connected = connected
+}
+
+// publishBlocks publishes the events of the blocks from, to (inclusive) and
+// returns the height of the last block published.
+func publishBlocks(ctx context.Context, client Client, bus pubsub.Bus, from, to int64) (int64, error) {
+	for height := from; height <= to; height++ {
+		select {
+		case <-ctx.Done():
+			return height - 1, ctx.Err()
+		default:
+		}
+
+		h := height
+		res, err := client.BlockResults(&h)
+		if err != nil {
+			return height - 1, err
+		}
+		processBlockResults(bus, res)
+		bus.Publish(EventBlockEnd{Height: height})
+	}
+	return to, nil
+}
+
+func processBlockResults(bus pubsub.Bus, res *ctypes.ResultBlockResults) {
+	for _, tx := range res.TxsResults {
+		if tx == nil || !tx.IsOK() {
+			continue
+		}
+		processEvents(bus, tx.GetEvents())
+	}
+	processEvents(bus, res.EndBlockEvents)
+}
+
 func processEvents(bus pubsub.Bus, events []abci.Event) {
 	for _, ev := range events {
 		if mev, ok := processEvent(ev); ok {
@@ -104,5 +296,13 @@ func processEvent(bev abci.Event) (interface{}, bool) {
 		return mev, true
 	}
 
+	if mev, err := atypes.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
+	if mev, err := certtypes.ParseEvent(ev); err == nil {
+		return mev, true
+	}
+
 	return nil, false
 }
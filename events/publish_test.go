@@ -1,15 +1,24 @@
 package events
 
 import (
+	"context"
+	"sync"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/testutil"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtmtypes "github.com/tendermint/tendermint/types"
 )
 
 func Test_processEvent(t *testing.T) {
@@ -46,3 +55,128 @@ func Test_processEvent(t *testing.T) {
 		assert.Equal(t, test, ev, test)
 	}
 }
+
+type blockResultsClient struct {
+	tmclient.EventsClient
+
+	mtx    sync.Mutex
+	blocks []*ctypes.ResultBlockResults
+	subs   chan chan ctypes.ResultEvent
+}
+
+func (c *blockResultsClient) addBlock(events ...sdkutil.ModuleEvent) int64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	sdkevs := make(sdk.Events, 0, len(events))
+	for _, ev := range events {
+		sdkevs = append(sdkevs, ev.ToSDKEvent())
+	}
+	height := int64(len(c.blocks) + 1)
+	c.blocks = append(c.blocks, &ctypes.ResultBlockResults{Height: height, EndBlockEvents: sdkevs.ToABCIEvents()})
+	return height
+}
+
+func (c *blockResultsClient) Subscribe(_ context.Context, _, _ string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	ch := make(chan ctypes.ResultEvent, 10)
+	c.subs <- ch
+	return ch, nil
+}
+
+func (c *blockResultsClient) UnsubscribeAll(context.Context, string) error {
+	return nil
+}
+
+func (c *blockResultsClient) Status() (*ctypes.ResultStatus, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: int64(len(c.blocks))},
+	}, nil
+}
+
+func (c *blockResultsClient) BlockResults(height *int64) (*ctypes.ResultBlockResults, error) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.blocks[*height-1], nil
+}
+
+func Test_Backfill(t *testing.T) {
+	created := mtypes.EventOrderCreated{ID: testutil.OrderID(t)}
+	failed := mtypes.EventOrderClosed{ID: testutil.OrderID(t)}
+	closed := dtypes.EventDeploymentClose{ID: testutil.DeploymentID(t)}
+
+	client := &blockResultsClient{
+		blocks: []*ctypes.ResultBlockResults{
+			{Height: 1, TxsResults: []*abci.ResponseDeliverTx{
+				{Events: sdk.Events{failed.ToSDKEvent()}.ToABCIEvents(), Code: 1},
+			}},
+			{Height: 2, TxsResults: []*abci.ResponseDeliverTx{
+				{Events: sdk.Events{created.ToSDKEvent()}.ToABCIEvents()},
+			}},
+			{Height: 3, EndBlockEvents: sdk.Events{closed.ToSDKEvent()}.ToABCIEvents()},
+		},
+	}
+
+	bus := pubsub.NewBus()
+	defer bus.Close()
+
+	sub, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	last, err := Backfill(context.Background(), client, 1, bus)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), last)
+
+	// events of failed transactions are skipped
+	assert.Equal(t, EventBlockEnd{Height: 1}, <-sub.Events())
+	assert.Equal(t, created, <-sub.Events())
+	assert.Equal(t, EventBlockEnd{Height: 2}, <-sub.Events())
+	assert.Equal(t, closed, <-sub.Events())
+	assert.Equal(t, EventBlockEnd{Height: 3}, <-sub.Events())
+}
+
+func Test_PublisherReconnect(t *testing.T) {
+	client := &blockResultsClient{subs: make(chan chan ctypes.ResultEvent, 10)}
+	client.addBlock(mtypes.EventOrderCreated{ID: testutil.OrderID(t)})
+
+	bus := pubsub.NewBus()
+	defer bus.Close()
+
+	sub, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	publisher := NewPublisher(testutil.Logger(t), client, "test", bus, 0)
+	publisher.blockTimeout = 100 * time.Millisecond
+
+	ctx, cancel := context.WithCancel(context.Background())
+	donech := make(chan error, 1)
+	go func() { donech <- publisher.Run(ctx) }()
+
+	ch := <-client.subs
+
+	// a header for block 3 publishes the missed block 2 as well
+	ev2 := mtypes.EventOrderCreated{ID: testutil.OrderID(t)}
+	ev3 := mtypes.EventOrderClosed{ID: testutil.OrderID(t)}
+	client.addBlock(ev2)
+	height := client.addBlock(ev3)
+	ch <- ctypes.ResultEvent{Data: tmtmtypes.EventDataNewBlockHeader{Header: tmtmtypes.Header{Height: height}}}
+
+	assert.Equal(t, ev2, <-sub.Events())
+	assert.Equal(t, EventBlockEnd{Height: 2}, <-sub.Events())
+	assert.Equal(t, ev3, <-sub.Events())
+	assert.Equal(t, EventBlockEnd{Height: 3}, <-sub.Events())
+
+	// no new headers: the publisher times out, reconnects and replays block 4
+	ev4 := dtypes.EventDeploymentClose{ID: testutil.DeploymentID(t)}
+	client.addBlock(ev4)
+
+	<-client.subs
+	assert.Equal(t, ev4, <-sub.Events())
+	assert.Equal(t, EventBlockEnd{Height: 4}, <-sub.Events())
+	assert.Eventually(t, func() bool {
+		return publisher.Status().Height == 4
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.NoError(t, <-donech)
+}
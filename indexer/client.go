@@ -0,0 +1,99 @@
+package indexer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// ErrServerResponse represents the server returning a 4xx or 5xx response code.
+var ErrServerResponse = errors.New("server response")
+
+// Client queries the history served by an indexer
+type Client interface {
+	Status(ctx context.Context) (*Status, error)
+	DeploymentHistory(ctx context.Context, id dtypes.DeploymentID, q Query) ([]Record, error)
+	OrderHistory(ctx context.Context, id mtypes.OrderID, q Query) ([]Record, error)
+	ProviderHistory(ctx context.Context, provider sdk.AccAddress, q Query) ([]Record, error)
+}
+
+// NewClient returns a client of the indexer listening at the given URL
+func NewClient(host string) Client {
+	return &client{
+		hclient: http.DefaultClient,
+		host:    strings.TrimSuffix(host, "/"),
+	}
+}
+
+type client struct {
+	hclient *http.Client
+	host    string
+}
+
+func (c *client) Status(ctx context.Context) (*Status, error) {
+	var obj Status
+	if err := c.get(ctx, "/status", &obj); err != nil {
+		return nil, err
+	}
+	return &obj, nil
+}
+
+func (c *client) DeploymentHistory(ctx context.Context, id dtypes.DeploymentID, q Query) ([]Record, error) {
+	path := fmt.Sprintf("/deployment/%s/%v/history?%v", id.Owner, id.DSeq, q.values().Encode())
+	return c.history(ctx, path)
+}
+
+func (c *client) OrderHistory(ctx context.Context, id mtypes.OrderID, q Query) ([]Record, error) {
+	path := fmt.Sprintf("/order/%s/%v/%v/%v/history?%v", id.Owner, id.DSeq, id.GSeq, id.OSeq, q.values().Encode())
+	return c.history(ctx, path)
+}
+
+func (c *client) ProviderHistory(ctx context.Context, provider sdk.AccAddress, q Query) ([]Record, error) {
+	path := fmt.Sprintf("/provider/%s/history?%v", provider, q.values().Encode())
+	return c.history(ctx, path)
+}
+
+func (c *client) history(ctx context.Context, path string) ([]Record, error) {
+	var records []Record
+	if err := c.get(ctx, path, &records); err != nil {
+		return nil, err
+	}
+	return records, nil
+}
+
+func (c *client) get(ctx context.Context, path string, obj interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", c.host+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeJSON)
+
+	resp, err := c.hclient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return fmt.Errorf("%w: %v: %v", ErrServerResponse, resp.Status, msg)
+		}
+		return fmt.Errorf("%w: %v", ErrServerResponse, resp.Status)
+	}
+
+	return json.Unmarshal(body, obj)
+}
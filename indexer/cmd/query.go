@@ -0,0 +1,205 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/ovrclk/akash/indexer"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+const (
+	flagModule   = "module"
+	flagType     = "type"
+	flagToHeight = "to-height"
+	flagLimit    = "limit"
+)
+
+func queryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "query",
+		Short: "Query the history recorded by an indexer",
+	}
+
+	cmd.PersistentFlags().String(flagIndexerURL, "http://"+defaultListenAddress, "Indexer query API URL")
+
+	cmd.AddCommand(
+		queryStatusCmd(),
+		queryDeploymentCmd(),
+		queryOrderCmd(),
+		queryProviderCmd(),
+	)
+
+	return cmd
+}
+
+func queryStatusCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Query the last height indexed",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			client, err := clientFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			status, err := client.Status(context.Background())
+			if err != nil {
+				return err
+			}
+			return printJSON(cmd, status)
+		},
+	}
+}
+
+func queryDeploymentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deployment [owner] [dseq]",
+		Short: "Query the history of a deployment, its orders, bids and leases",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			id, err := deploymentIDFromArgs(args)
+			if err != nil {
+				return err
+			}
+			return runHistoryQuery(cmd, func(client indexer.Client, q indexer.Query) ([]indexer.Record, error) {
+				return client.DeploymentHistory(context.Background(), id, q)
+			})
+		},
+	}
+	addQueryFlags(cmd.Flags())
+	return cmd
+}
+
+func queryOrderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "order [owner] [dseq] [gseq] [oseq]",
+		Short: "Query the history of an order, its bids and leases",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			did, err := deploymentIDFromArgs(args)
+			if err != nil {
+				return err
+			}
+			gseq, err := strconv.ParseUint(args[2], 10, 32)
+			if err != nil {
+				return err
+			}
+			oseq, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return err
+			}
+			id := mtypes.MakeOrderID(dtypes.MakeGroupID(did, uint32(gseq)), uint32(oseq))
+			return runHistoryQuery(cmd, func(client indexer.Client, q indexer.Query) ([]indexer.Record, error) {
+				return client.OrderHistory(context.Background(), id, q)
+			})
+		},
+	}
+	addQueryFlags(cmd.Flags())
+	return cmd
+}
+
+func queryProviderCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "provider [address]",
+		Short: "Query the history of a provider, its bids and leases",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			provider, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+			return runHistoryQuery(cmd, func(client indexer.Client, q indexer.Query) ([]indexer.Record, error) {
+				return client.ProviderHistory(context.Background(), provider, q)
+			})
+		},
+	}
+	addQueryFlags(cmd.Flags())
+	return cmd
+}
+
+func addQueryFlags(flags *pflag.FlagSet) {
+	flags.StringSlice(flagModule, nil, "Only return events of these modules (deployment, market, provider, audit)")
+	flags.StringSlice(flagType, nil, "Only return events of these types (e.g. bid-created, lease-closed)")
+	flags.Int64(flagFromHeight, 0, "Only return events at or above this height")
+	flags.Int64(flagToHeight, 0, "Only return events at or below this height")
+	flags.Int(flagLimit, 0, "Maximum number of events to return")
+}
+
+func queryFromFlags(flags *pflag.FlagSet) (indexer.Query, error) {
+	var (
+		q   indexer.Query
+		err error
+	)
+
+	if q.Modules, err = flags.GetStringSlice(flagModule); err != nil {
+		return q, err
+	}
+	if q.Types, err = flags.GetStringSlice(flagType); err != nil {
+		return q, err
+	}
+	if q.FromHeight, err = flags.GetInt64(flagFromHeight); err != nil {
+		return q, err
+	}
+	if q.ToHeight, err = flags.GetInt64(flagToHeight); err != nil {
+		return q, err
+	}
+	if q.Limit, err = flags.GetInt(flagLimit); err != nil {
+		return q, err
+	}
+	return q, nil
+}
+
+func runHistoryQuery(cmd *cobra.Command, fn func(indexer.Client, indexer.Query) ([]indexer.Record, error)) error {
+	client, err := clientFromFlags(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	q, err := queryFromFlags(cmd.Flags())
+	if err != nil {
+		return err
+	}
+
+	records, err := fn(client, q)
+	if err != nil {
+		return err
+	}
+	return printJSON(cmd, records)
+}
+
+func clientFromFlags(flags *pflag.FlagSet) (indexer.Client, error) {
+	url, err := flags.GetString(flagIndexerURL)
+	if err != nil {
+		return nil, err
+	}
+	return indexer.NewClient(url), nil
+}
+
+func deploymentIDFromArgs(args []string) (dtypes.DeploymentID, error) {
+	owner, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return dtypes.DeploymentID{}, err
+	}
+	dseq, err := strconv.ParseUint(args[1], 10, 64)
+	if err != nil {
+		return dtypes.DeploymentID{}, err
+	}
+	return dtypes.DeploymentID{Owner: owner, DSeq: dseq}, nil
+}
+
+func printJSON(cmd *cobra.Command, obj interface{}) error {
+	buf, err := json.MarshalIndent(obj, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.OutOrStdout(), string(buf))
+	return err
+}
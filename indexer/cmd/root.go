@@ -0,0 +1,156 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	ccontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	dbm "github.com/tendermint/tm-db"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/ovrclk/akash/cmd/common"
+	"github.com/ovrclk/akash/events"
+	"github.com/ovrclk/akash/indexer"
+	"github.com/ovrclk/akash/pubsub"
+)
+
+const (
+	flagDBDir         = "db-dir"
+	flagListenAddress = "listen-address"
+	flagFromHeight    = "from-height"
+	flagIndexerURL    = "indexer-url"
+
+	defaultListenAddress = "127.0.0.1:3002"
+)
+
+// RootCmd returns the indexer command with its start and query subcommands
+func RootCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "indexer",
+		Short: "Index market history into a local database and query it",
+	}
+
+	cmd.AddCommand(
+		startCmd(cdc),
+		queryCmd(),
+	)
+
+	return cmd
+}
+
+func startCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "start",
+		Short: "Index events of new blocks and serve the history query API",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return common.RunForever(func(ctx context.Context) error {
+				return doStartCmd(ctx, cdc, cmd)
+			})
+		},
+	}
+
+	cmd.Flags().String(flagDBDir, "", "Indexer database directory (default: <home>/indexer)")
+	cmd.Flags().String(flagListenAddress, defaultListenAddress, "Query API listen address")
+	cmd.Flags().Int64(flagFromHeight, 1, "Height to start indexing at when the database is empty")
+
+	return cmd
+}
+
+func doStartCmd(ctx context.Context, cdc *codec.Codec, cmd *cobra.Command) error {
+	cctx := ccontext.NewCLIContext().WithCodec(cdc)
+	log := common.NewLogger(os.Stdout).With("cmp", "indexer")
+
+	dir, err := cmd.Flags().GetString(flagDBDir)
+	if err != nil {
+		return err
+	}
+	if dir == "" {
+		dir = filepath.Join(viper.GetString(flags.FlagHome), "indexer")
+	}
+
+	address, err := cmd.Flags().GetString(flagListenAddress)
+	if err != nil {
+		return err
+	}
+
+	height, err := cmd.Flags().GetInt64(flagFromHeight)
+	if err != nil {
+		return err
+	}
+
+	db, err := dbm.NewGoLevelDB("indexer", dir)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	store := indexer.NewStore(db)
+
+	// resume after the last block indexed
+	last, err := store.Height()
+	if err != nil {
+		return err
+	}
+	if last > 0 {
+		height = last + 1
+	}
+	if height < 1 {
+		height = 1
+	}
+
+	if err := cctx.Client.Start(); err != nil {
+		return err
+	}
+
+	bus := pubsub.NewBus()
+	defer bus.Close()
+
+	sub, err := bus.Subscribe()
+	if err != nil {
+		return err
+	}
+
+	log.Info("starting indexer", "db", dir, "height", height, "listen", address)
+
+	publisher := events.NewPublisher(log, cctx.Client, "akash-indexer", bus, height-1)
+
+	server := &http.Server{
+		Addr:    address,
+		Handler: indexer.NewRouter(log, store),
+		BaseContext: func(_ net.Listener) context.Context {
+			return ctx
+		},
+	}
+
+	group, ctx := errgroup.WithContext(ctx)
+
+	group.Go(func() error {
+		return publisher.Run(ctx)
+	})
+
+	group.Go(func() error {
+		return indexer.Index(ctx, log, store, sub)
+	})
+
+	group.Go(func() error {
+		if err := server.ListenAndServe(); !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	})
+
+	group.Go(func() error {
+		<-ctx.Done()
+		return server.Close()
+	})
+
+	return group.Wait()
+}
@@ -0,0 +1,49 @@
+package indexer
+
+import (
+	"context"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/ovrclk/akash/events"
+	"github.com/ovrclk/akash/pubsub"
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+// Index stores the events published on the bus, committing the events of
+// each block once its events.EventBlockEnd is received. It runs until the
+// context is done or the subscription is closed.
+func Index(ctx context.Context, log log.Logger, store *Store, sub pubsub.Subscriber) error {
+	var records []Record
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Done():
+			return nil
+		case ev := <-sub.Events():
+			switch ev := ev.(type) {
+			case sdkutil.ModuleEvent:
+				rec, err := newRecord(ev)
+				if err != nil {
+					log.Error("parsing event", "err", err)
+					continue
+				}
+				records = append(records, rec)
+
+			case events.EventBlockEnd:
+				for idx := range records {
+					records[idx].Height = ev.Height
+				}
+				if err := store.Commit(ev.Height, records); err != nil {
+					return err
+				}
+				if len(records) > 0 {
+					log.Debug("indexed block", "height", ev.Height, "records", len(records))
+				}
+				records = nil
+			}
+		}
+	}
+}
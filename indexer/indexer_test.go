@@ -0,0 +1,132 @@
+package indexer_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/events"
+	"github.com/ovrclk/akash/indexer"
+	"github.com/ovrclk/akash/pubsub"
+	"github.com/ovrclk/akash/testutil"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+func TestIndexHistory(t *testing.T) {
+	store := indexer.NewStore(dbm.NewMemDB())
+
+	order := testutil.OrderID(t)
+	bid := mtypes.MakeBidID(order, testutil.AccAddress(t))
+	other := mtypes.MakeBidID(order, testutil.AccAddress(t))
+	price := testutil.Coin(t)
+
+	index(t, store,
+		dtypes.EventDeploymentCreate{ID: order.GroupID().DeploymentID()},
+		mtypes.EventOrderCreated{ID: order},
+		events.EventBlockEnd{Height: 1},
+		mtypes.EventBidCreated{ID: bid, Price: price},
+		mtypes.EventBidCreated{ID: other, Price: price},
+		ptypes.EventProviderUpdate{Owner: bid.Provider},
+		// unrelated order
+		mtypes.EventOrderCreated{ID: testutil.OrderID(t)},
+		events.EventBlockEnd{Height: 2},
+		events.EventBlockEnd{Height: 3},
+		mtypes.EventLeaseCreated{ID: mtypes.MakeLeaseID(bid), Price: price},
+		events.EventBlockEnd{Height: 4},
+	)
+
+	height, err := store.Height()
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), height)
+
+	srv := httptest.NewServer(indexer.NewRouter(testutil.Logger(t), store))
+	defer srv.Close()
+	client := indexer.NewClient(srv.URL)
+	ctx := context.Background()
+
+	status, err := client.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(4), status.Height)
+
+	// all bids on an order
+	records, err := client.OrderHistory(ctx, order, indexer.Query{Types: []string{"bid-created"}})
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	assert.Equal(t, int64(2), records[0].Height)
+	assert.Equal(t, bid.Provider.String(), records[0].Attributes["provider"])
+	assert.Equal(t, price.Amount.String(), records[0].Attributes["price-amount"])
+	assert.Equal(t, other.Provider.String(), records[1].Attributes["provider"])
+
+	records, err = client.OrderHistory(ctx, order, indexer.Query{})
+	require.NoError(t, err)
+	assert.Len(t, records, 4)
+
+	records, err = client.DeploymentHistory(ctx, order.GroupID().DeploymentID(), indexer.Query{Modules: []string{"deployment"}})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "deployment-create", records[0].Type)
+
+	// a provider's history includes its own events
+	records, err = client.ProviderHistory(ctx, bid.Provider, indexer.Query{})
+	require.NoError(t, err)
+	require.Len(t, records, 3)
+	assert.Equal(t, "provider-update", records[1].Type)
+
+	// lease history by height range
+	records, err = client.ProviderHistory(ctx, bid.Provider, indexer.Query{FromHeight: 3, Types: []string{"lease-created"}})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, int64(4), records[0].Height)
+
+	records, err = client.ProviderHistory(ctx, bid.Provider, indexer.Query{ToHeight: 2, Limit: 1})
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.Equal(t, "bid-created", records[0].Type)
+}
+
+func TestHistoryInvalidRequest(t *testing.T) {
+	srv := httptest.NewServer(indexer.NewRouter(testutil.Logger(t), indexer.NewStore(dbm.NewMemDB())))
+	defer srv.Close()
+
+	_, err := indexer.NewClient(srv.URL).DeploymentHistory(context.Background(),
+		dtypes.DeploymentID{DSeq: 1}, indexer.Query{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), indexer.ErrServerResponse.Error())
+}
+
+// index runs the indexer over the given events until the last block is committed
+func index(t *testing.T, store *indexer.Store, evs ...pubsub.Event) {
+	t.Helper()
+
+	bus := pubsub.NewBus()
+	defer bus.Close()
+
+	sub, err := bus.Subscribe()
+	require.NoError(t, err)
+
+	for _, ev := range evs {
+		require.NoError(t, bus.Publish(ev))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	donech := make(chan error, 1)
+	go func() { donech <- indexer.Index(ctx, testutil.Logger(t), store, sub) }()
+
+	last := evs[len(evs)-1].(events.EventBlockEnd).Height
+	assert.Eventually(t, func() bool {
+		height, err := store.Height()
+		return err == nil && height == last
+	}, time.Second, 10*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-donech)
+}
@@ -0,0 +1,126 @@
+package indexer
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+const (
+	attrOwnerKey    = "owner"
+	attrProviderKey = "provider"
+	attrDSeqKey     = "dseq"
+	attrGSeqKey     = "gseq"
+	attrOSeqKey     = "oseq"
+)
+
+// providerModules identify providers by their owner attribute
+var providerModules = []string{"provider", "audit"}
+
+// Record is an indexed event along with the height of the block it was emitted in
+type Record struct {
+	Height     int64             `json:"height"`
+	Module     string            `json:"module"`
+	Type       string            `json:"type"`
+	Attributes map[string]string `json:"attributes"`
+}
+
+// Query filters the records returned by history queries.
+// Empty fields match every record.
+type Query struct {
+	Modules    []string `json:"modules,omitempty"`
+	Types      []string `json:"types,omitempty"`
+	FromHeight int64    `json:"from_height,omitempty"`
+	ToHeight   int64    `json:"to_height,omitempty"`
+	Limit      int      `json:"limit,omitempty"`
+}
+
+func (q Query) accept(rec Record) bool {
+	if len(q.Modules) > 0 && !contains(q.Modules, rec.Module) {
+		return false
+	}
+	if len(q.Types) > 0 && !contains(q.Types, rec.Type) {
+		return false
+	}
+	return true
+}
+
+// newRecord converts a module event; the height is set once the block ends
+func newRecord(mev sdkutil.ModuleEvent) (Record, error) {
+	ev, err := sdkutil.ParseEvent(sdk.StringifyEvent(sdk.Events{mev.ToSDKEvent()}.ToABCIEvents()[0]))
+	if err != nil {
+		return Record{}, err
+	}
+
+	rec := Record{
+		Module:     ev.Module,
+		Type:       ev.Action,
+		Attributes: make(map[string]string, len(ev.Attributes)),
+	}
+	for _, attr := range ev.Attributes {
+		if attr.Key == sdk.AttributeKeyModule || attr.Key == sdk.AttributeKeyAction {
+			continue
+		}
+		rec.Attributes[attr.Key] = attr.Value
+	}
+	return rec, nil
+}
+
+// deploymentID returns the ID of the deployment the record refers to, if any
+func (rec Record) deploymentID() (dtypes.DeploymentID, bool) {
+	if contains(providerModules, rec.Module) {
+		return dtypes.DeploymentID{}, false
+	}
+	owner, err := sdk.AccAddressFromBech32(rec.Attributes[attrOwnerKey])
+	if err != nil {
+		return dtypes.DeploymentID{}, false
+	}
+	dseq, err := strconv.ParseUint(rec.Attributes[attrDSeqKey], 10, 64)
+	if err != nil {
+		return dtypes.DeploymentID{}, false
+	}
+	return dtypes.DeploymentID{Owner: owner, DSeq: dseq}, true
+}
+
+// orderID returns the ID of the order the record refers to, if any
+func (rec Record) orderID() (mtypes.OrderID, bool) {
+	did, ok := rec.deploymentID()
+	if !ok {
+		return mtypes.OrderID{}, false
+	}
+	gseq, err := strconv.ParseUint(rec.Attributes[attrGSeqKey], 10, 32)
+	if err != nil {
+		return mtypes.OrderID{}, false
+	}
+	oseq, err := strconv.ParseUint(rec.Attributes[attrOSeqKey], 10, 32)
+	if err != nil {
+		return mtypes.OrderID{}, false
+	}
+	return mtypes.MakeOrderID(dtypes.MakeGroupID(did, uint32(gseq)), uint32(oseq)), true
+}
+
+// provider returns the provider the record refers to, if any
+func (rec Record) provider() (sdk.AccAddress, bool) {
+	key := attrProviderKey
+	if contains(providerModules, rec.Module) {
+		key = attrOwnerKey
+	}
+	provider, err := sdk.AccAddressFromBech32(rec.Attributes[key])
+	if err != nil {
+		return nil, false
+	}
+	return provider, true
+}
+
+func contains(vals []string, val string) bool {
+	for _, v := range vals {
+		if v == val {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,178 @@
+package indexer
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+	"github.com/tendermint/tendermint/libs/log"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+const (
+	contentTypeJSON = "application/json; charset=UTF-8"
+
+	paramModule     = "module"
+	paramType       = "type"
+	paramFromHeight = "from-height"
+	paramToHeight   = "to-height"
+	paramLimit      = "limit"
+)
+
+// Status is the progress of the indexer
+type Status struct {
+	// Height of the last block indexed
+	Height int64 `json:"height"`
+}
+
+// NewRouter returns the handler of the indexer query API
+func NewRouter(log log.Logger, store *Store) http.Handler {
+	router := mux.NewRouter()
+
+	// GET /status
+	router.HandleFunc("/status", func(w http.ResponseWriter, req *http.Request) {
+		height, err := store.Height()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		writeJSON(log, w, Status{Height: height})
+	}).Methods("GET")
+
+	// GET /deployment/<owner>/<dseq>/history
+	router.HandleFunc("/deployment/{owner}/{dseq}/history", historyHandler(log, func(req *http.Request, q Query) ([]Record, error) {
+		id, err := deploymentIDFromVars(mux.Vars(req))
+		if err != nil {
+			return nil, err
+		}
+		return store.DeploymentHistory(id, q)
+	})).Methods("GET")
+
+	// GET /order/<owner>/<dseq>/<gseq>/<oseq>/history
+	router.HandleFunc("/order/{owner}/{dseq}/{gseq}/{oseq}/history", historyHandler(log, func(req *http.Request, q Query) ([]Record, error) {
+		id, err := orderIDFromVars(mux.Vars(req))
+		if err != nil {
+			return nil, err
+		}
+		return store.OrderHistory(id, q)
+	})).Methods("GET")
+
+	// GET /provider/<provider>/history
+	router.HandleFunc("/provider/{provider}/history", historyHandler(log, func(req *http.Request, q Query) ([]Record, error) {
+		provider, err := sdk.AccAddressFromBech32(mux.Vars(req)["provider"])
+		if err != nil {
+			return nil, err
+		}
+		return store.ProviderHistory(provider, q)
+	})).Methods("GET")
+
+	return router
+}
+
+func historyHandler(log log.Logger, fn func(*http.Request, Query) ([]Record, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		q, err := queryFromValues(req.URL.Query())
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		records, err := fn(req, q)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		writeJSON(log, w, records)
+	}
+}
+
+func queryFromValues(vals url.Values) (Query, error) {
+	var (
+		q   = Query{Modules: vals[paramModule], Types: vals[paramType]}
+		err error
+	)
+
+	if v := vals.Get(paramFromHeight); v != "" {
+		if q.FromHeight, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return q, err
+		}
+	}
+	if v := vals.Get(paramToHeight); v != "" {
+		if q.ToHeight, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return q, err
+		}
+	}
+	if v := vals.Get(paramLimit); v != "" {
+		if q.Limit, err = strconv.Atoi(v); err != nil {
+			return q, err
+		}
+	}
+	return q, nil
+}
+
+func (q Query) values() url.Values {
+	vals := url.Values{}
+	for _, module := range q.Modules {
+		vals.Add(paramModule, module)
+	}
+	for _, typ := range q.Types {
+		vals.Add(paramType, typ)
+	}
+	if q.FromHeight > 0 {
+		vals.Set(paramFromHeight, strconv.FormatInt(q.FromHeight, 10))
+	}
+	if q.ToHeight > 0 {
+		vals.Set(paramToHeight, strconv.FormatInt(q.ToHeight, 10))
+	}
+	if q.Limit > 0 {
+		vals.Set(paramLimit, strconv.Itoa(q.Limit))
+	}
+	return vals
+}
+
+func deploymentIDFromVars(vars map[string]string) (dtypes.DeploymentID, error) {
+	owner, err := sdk.AccAddressFromBech32(vars["owner"])
+	if err != nil {
+		return dtypes.DeploymentID{}, err
+	}
+	dseq, err := strconv.ParseUint(vars["dseq"], 10, 64)
+	if err != nil {
+		return dtypes.DeploymentID{}, err
+	}
+	return dtypes.DeploymentID{Owner: owner, DSeq: dseq}, nil
+}
+
+func orderIDFromVars(vars map[string]string) (mtypes.OrderID, error) {
+	did, err := deploymentIDFromVars(vars)
+	if err != nil {
+		return mtypes.OrderID{}, err
+	}
+	gseq, err := strconv.ParseUint(vars["gseq"], 10, 32)
+	if err != nil {
+		return mtypes.OrderID{}, err
+	}
+	oseq, err := strconv.ParseUint(vars["oseq"], 10, 32)
+	if err != nil {
+		return mtypes.OrderID{}, err
+	}
+	return mtypes.MakeOrderID(dtypes.MakeGroupID(did, uint32(gseq)), uint32(oseq)), nil
+}
+
+func writeJSON(log log.Logger, w http.ResponseWriter, obj interface{}) {
+	bytes, err := json.Marshal(obj)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", contentTypeJSON)
+
+	if _, err := w.Write(bytes); err != nil {
+		log.Error("writing response", "err", err)
+	}
+}
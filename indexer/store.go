@@ -0,0 +1,172 @@
+package indexer
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dbm "github.com/tendermint/tm-db"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+var (
+	heightKey = []byte{0x00}
+
+	recordPrefix          = []byte{0x01}
+	deploymentIndexPrefix = []byte{0x02}
+	orderIndexPrefix      = []byte{0x03}
+	providerIndexPrefix   = []byte{0x04}
+)
+
+// Store persists records and indexes them by deployment, order and provider.
+// Records are keyed by height and position within the block so history
+// queries return them in the order they were emitted.
+type Store struct {
+	db dbm.DB
+}
+
+// NewStore returns a store backed by the given database
+func NewStore(db dbm.DB) *Store {
+	return &Store{db: db}
+}
+
+// Height returns the height of the last block committed; zero if none
+func (s *Store) Height() (int64, error) {
+	buf, err := s.db.Get(heightKey)
+	if err != nil || len(buf) == 0 {
+		return 0, err
+	}
+	return int64(binary.BigEndian.Uint64(buf)), nil
+}
+
+// Commit atomically stores the records of a block and advances the store height
+func (s *Store) Commit(height int64, records []Record) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	for idx, rec := range records {
+		rkey := recordKey(height, uint32(idx))
+
+		buf, err := json.Marshal(rec)
+		if err != nil {
+			return err
+		}
+		batch.Set(rkey, buf)
+
+		suffix := rkey[len(recordPrefix):]
+		if id, ok := rec.deploymentID(); ok {
+			batch.Set(indexKey(deploymentIndexPrefix, deploymentKey(id), suffix), []byte{})
+		}
+		if id, ok := rec.orderID(); ok {
+			batch.Set(indexKey(orderIndexPrefix, orderKey(id), suffix), []byte{})
+		}
+		if provider, ok := rec.provider(); ok {
+			batch.Set(indexKey(providerIndexPrefix, provider.String(), suffix), []byte{})
+		}
+	}
+
+	batch.Set(heightKey, heightBytes(height))
+
+	return batch.WriteSync()
+}
+
+// DeploymentHistory returns the records of a deployment, its groups, orders, bids and leases
+func (s *Store) DeploymentHistory(id dtypes.DeploymentID, q Query) ([]Record, error) {
+	return s.history(indexKey(deploymentIndexPrefix, deploymentKey(id), nil), q)
+}
+
+// OrderHistory returns the records of an order and its bids and leases
+func (s *Store) OrderHistory(id mtypes.OrderID, q Query) ([]Record, error) {
+	return s.history(indexKey(orderIndexPrefix, orderKey(id), nil), q)
+}
+
+// ProviderHistory returns the records of a provider and its bids and leases
+func (s *Store) ProviderHistory(provider sdk.AccAddress, q Query) ([]Record, error) {
+	return s.history(indexKey(providerIndexPrefix, provider.String(), nil), q)
+}
+
+func (s *Store) history(pfx []byte, q Query) ([]Record, error) {
+	start := pfx
+	if q.FromHeight > 0 {
+		start = append(append([]byte{}, pfx...), heightBytes(q.FromHeight)...)
+	}
+
+	iter, err := s.db.Iterator(start, prefixEnd(pfx))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	records := make([]Record, 0)
+	for ; iter.Valid(); iter.Next() {
+		if q.Limit > 0 && len(records) == q.Limit {
+			break
+		}
+
+		suffix := iter.Key()[len(pfx):]
+		if q.ToHeight > 0 && int64(binary.BigEndian.Uint64(suffix)) > q.ToHeight {
+			break
+		}
+
+		buf, err := s.db.Get(append(append([]byte{}, recordPrefix...), suffix...))
+		if err != nil {
+			return nil, err
+		}
+
+		var rec Record
+		if err := json.Unmarshal(buf, &rec); err != nil {
+			return nil, err
+		}
+		if q.accept(rec) {
+			records = append(records, rec)
+		}
+	}
+	return records, nil
+}
+
+func heightBytes(height int64) []byte {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, uint64(height))
+	return buf
+}
+
+func recordKey(height int64, idx uint32) []byte {
+	buf := make([]byte, 0, len(recordPrefix)+12)
+	buf = append(buf, recordPrefix...)
+	buf = append(buf, heightBytes(height)...)
+	return append(buf, byte(idx>>24), byte(idx>>16), byte(idx>>8), byte(idx))
+}
+
+// indexKey returns prefix | id | '/' | suffix; the separator keeps IDs that
+// are prefixes of each other apart.
+func indexKey(pfx []byte, id string, suffix []byte) []byte {
+	buf := make([]byte, 0, len(pfx)+len(id)+1+len(suffix))
+	buf = append(buf, pfx...)
+	buf = append(buf, id...)
+	buf = append(buf, '/')
+	return append(buf, suffix...)
+}
+
+func deploymentKey(id dtypes.DeploymentID) string {
+	return id.Owner.String() + "/" + strconv.FormatUint(id.DSeq, 10)
+}
+
+func orderKey(id mtypes.OrderID) string {
+	return deploymentKey(id.GroupID().DeploymentID()) + "/" +
+		strconv.FormatUint(uint64(id.GSeq), 10) + "/" +
+		strconv.FormatUint(uint64(id.OSeq), 10)
+}
+
+func prefixEnd(pfx []byte) []byte {
+	end := append([]byte{}, pfx...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -369,12 +369,12 @@ func (f *Fixtures) QueryDeployments(flags ...string) (dquery.Deployments, error)
 	cmd := fmt.Sprintf("%s query deployment list %v", f.AkashBinary, f.Flags())
 	out, _ := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
 
-	var deployments dquery.Deployments
+	var res dquery.DeploymentsResponse
 
 	cdc := app.MakeCodec()
-	err := cdc.UnmarshalJSON([]byte(out), &deployments)
+	err := cdc.UnmarshalJSON([]byte(out), &res)
 
-	return deployments, err
+	return res.Deployments, err
 }
 
 // QueryDeployment is akash query deployment
@@ -424,12 +424,14 @@ func (f *Fixtures) QueryOrders(flags ...string) ([]mtypes.Order, error) {
 	cmd := fmt.Sprintf("%s query market order list %v", f.AkashBinary, f.Flags())
 	out, _ := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
 
-	var orders []mtypes.Order
+	var res struct {
+		Orders []mtypes.Order `json:"orders"`
+	}
 
 	cdc := app.MakeCodec()
-	err := cdc.UnmarshalJSON([]byte(out), &orders)
+	err := cdc.UnmarshalJSON([]byte(out), &res)
 
-	return orders, err
+	return res.Orders, err
 }
 
 // QueryOrder is akash query order
@@ -452,12 +454,14 @@ func (f *Fixtures) QueryBids(flags ...string) ([]mtypes.Bid, error) {
 	cmd := fmt.Sprintf("%s query market bid list %v", f.AkashBinary, f.Flags())
 	out, _ := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
 
-	var bids []mtypes.Bid
+	var res struct {
+		Bids []mtypes.Bid `json:"bids"`
+	}
 
 	cdc := app.MakeCodec()
-	err := cdc.UnmarshalJSON([]byte(out), &bids)
+	err := cdc.UnmarshalJSON([]byte(out), &res)
 
-	return bids, err
+	return res.Bids, err
 }
 
 // QueryBid is akash query bid
@@ -482,12 +486,14 @@ func (f *Fixtures) QueryLeases(flags ...string) ([]mtypes.Lease, error) {
 	cmd := fmt.Sprintf("%s query market lease list %v", f.AkashBinary, f.Flags())
 	out, _ := tests.ExecuteT(f.T, addFlags(cmd, flags), "")
 
-	var leases []mtypes.Lease
+	var res struct {
+		Leases []mtypes.Lease `json:"leases"`
+	}
 
 	cdc := app.MakeCodec()
-	err := cdc.UnmarshalJSON([]byte(out), &leases)
+	err := cdc.UnmarshalJSON([]byte(out), &res)
 
-	return leases, err
+	return res.Leases, err
 }
 
 // QueryLease is akash query lease
@@ -30,6 +30,14 @@ type ManifestStatus struct {
 	Message string `json:"message,omitempty"`
 }
 
+const (
+	// ManifestStateDeployed is the state of a manifest whose resources match its spec
+	ManifestStateDeployed = "deployed"
+	// ManifestStateFailed is the state of a manifest whose resources could not be applied;
+	// the status message holds the error
+	ManifestStateFailed = "failed"
+)
+
 // ManifestSpec stores LeaseID, Group and metadata details
 type ManifestSpec struct {
 	LeaseID LeaseID       `json:"lease-id"`
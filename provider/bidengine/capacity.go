@@ -0,0 +1,96 @@
+package bidengine
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/provider/cluster"
+	atypes "github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/util/runner"
+	"github.com/ovrclk/akash/validation"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+// publishCapacity advertises the capacity of the cluster on chain
+func (s *service) publishCapacity(ctx context.Context) <-chan runner.Result {
+	return runner.Do(func() runner.Result {
+		status, err := s.cluster.Status(ctx)
+		if err != nil {
+			return runner.NewResult(nil, err)
+		}
+
+		return runner.NewResult(nil, s.session.Client().Tx().Broadcast(ptypes.MsgUpdateProviderCapacity{
+			Owner:    s.session.Provider().Address(),
+			Capacity: capacityFromInventory(status.Inventory, s.config.priceHints()),
+		}))
+	})
+}
+
+// capacityFromInventory sums the resources available on all nodes, less those
+// reserved for orders that have not been leased yet
+func capacityFromInventory(inventory cluster.InventoryStatus, prices sdk.Coins) ptypes.Capacity {
+	capacity := ptypes.Capacity{Prices: prices}
+
+	for _, node := range inventory.Available {
+		capacity.Available.CPU += node.CPU
+		capacity.Available.Memory += node.Memory
+		capacity.Available.Storage += node.Storage
+
+		if node.CPU > capacity.Largest.CPU {
+			capacity.Largest.CPU = node.CPU
+		}
+		if node.Memory > capacity.Largest.Memory {
+			capacity.Largest.Memory = node.Memory
+		}
+		if node.Storage > capacity.Largest.Storage {
+			capacity.Largest.Storage = node.Storage
+		}
+	}
+
+	for _, pending := range inventory.Pending {
+		capacity.Available = subtractUnit(capacity.Available, pending)
+	}
+
+	capacity.Largest = minUnit(capacity.Largest, capacity.Available)
+
+	return capacity
+}
+
+func subtractUnit(a, b atypes.Unit) atypes.Unit {
+	result := atypes.Unit{}
+	if a.CPU > b.CPU {
+		result.CPU = a.CPU - b.CPU
+	}
+	if a.Memory > b.Memory {
+		result.Memory = a.Memory - b.Memory
+	}
+	if a.Storage > b.Storage {
+		result.Storage = a.Storage - b.Storage
+	}
+	return result
+}
+
+func minUnit(a, b atypes.Unit) atypes.Unit {
+	if b.CPU < a.CPU {
+		a.CPU = b.CPU
+	}
+	if b.Memory < a.Memory {
+		a.Memory = b.Memory
+	}
+	if b.Storage < a.Storage {
+		a.Storage = b.Storage
+	}
+	return a
+}
+
+// priceHints returns the lowest price the provider bids per GiB of memory in each denomination
+func (c config) priceHints() sdk.Coins {
+	price := validation.Config().MinGroupMemPrice
+
+	coins := make(sdk.Coins, 0, len(c.BidDenoms))
+	for _, denom := range c.BidDenoms {
+		coins = append(coins, sdk.NewInt64Coin(denom, price))
+	}
+	return coins.Sort()
+}
@@ -0,0 +1,20 @@
+package bidengine
+
+import "time"
+
+type config struct {
+	BidDenoms []string `env:"AKASH_BID_DENOMS" envSeparator:"," envDefault:"akash"`
+
+	// CapacityUpdatePeriod is how often the provider advertises its capacity on chain; zero disables it
+	CapacityUpdatePeriod time.Duration `env:"AKASH_CAPACITY_UPDATE_PERIOD" envDefault:"10m"`
+}
+
+// acceptsDenom returns true if the provider is willing to bid in denom
+func (c config) acceptsDenom(denom string) bool {
+	for _, d := range c.BidDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
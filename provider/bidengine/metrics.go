@@ -0,0 +1,24 @@
+package bidengine
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	ordersSeen = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "akash_provider_orders_seen_total",
+		Help: "Orders the bid engine considered bidding on",
+	})
+
+	bidsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "akash_provider_bids_total",
+		Help: "Bids by outcome: placed, won or lost",
+	}, []string{"result"})
+)
+
+const (
+	bidPlaced = "placed"
+	bidWon    = "won"
+	bidLost   = "lost"
+)
@@ -8,6 +8,7 @@ import (
 	"github.com/ovrclk/akash/pubsub"
 	"github.com/ovrclk/akash/util/runner"
 	"github.com/ovrclk/akash/validation"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dquery "github.com/ovrclk/akash/x/deployment/query"
 	mquery "github.com/ovrclk/akash/x/market/query"
 	mtypes "github.com/ovrclk/akash/x/market/types"
@@ -19,6 +20,7 @@ type order struct {
 	order mtypes.OrderID
 	bid   *mquery.Bid
 
+	config  config
 	session session.Session
 	cluster cluster.Cluster
 	bus     pubsub.Bus
@@ -43,6 +45,7 @@ func newOrder(e *service, oid mtypes.OrderID, bid *mquery.Bid) (*order, error) {
 	order := &order{
 		order:   oid,
 		bid:     bid,
+		config:  e.config,
 		session: session,
 		cluster: e.cluster,
 		bus:     e.bus,
@@ -51,6 +54,8 @@ func newOrder(e *service, oid mtypes.OrderID, bid *mquery.Bid) (*order, error) {
 		lc:      lifecycle.New(),
 	}
 
+	ordersSeen.Inc()
+
 	// Shut down when parent begins shutting down
 	go order.lc.WatchChannel(e.lc.ShuttingDown())
 
@@ -71,6 +76,7 @@ func (o *order) run() {
 
 	var (
 		// channels for async operations.
+		orderch   <-chan runner.Result
 		groupch   <-chan runner.Result
 		clusterch <-chan runner.Result
 		bidch     <-chan runner.Result
@@ -78,13 +84,18 @@ func (o *order) run() {
 		group       *dquery.Group
 		reservation cluster.Reservation
 
+		// lease amended by the order; nil for orders of new leases
+		amends *mtypes.LeaseID
+
 		won bool
+		// bidding is set once the provider has a bid on the order
+		bidding = o.bid != nil
 	)
 
-	// Begin fetching group details immediately.
-	groupch = runner.Do(func() runner.Result {
+	// Begin fetching order details immediately.
+	orderch = runner.Do(func() runner.Result {
 		return runner.NewResult(
-			o.session.Client().Query().Group(o.order.GroupID()))
+			o.session.Client().Query().Order(o.order))
 	})
 
 loop:
@@ -106,6 +117,9 @@ loop:
 				// check winning provider
 				if !ev.ID.Provider.Equals(o.session.Provider().Address()) {
 					o.log.Info("lease lost", "lease", ev.ID)
+					if bidding {
+						bidsCounter.WithLabelValues(bidLost).Inc()
+					}
 					break loop
 				}
 
@@ -119,6 +133,20 @@ loop:
 					Price:   ev.Price,
 				})
 				won = true
+				bidsCounter.WithLabelValues(bidWon).Inc()
+
+				break loop
+
+			case mtypes.EventLeaseAmended:
+
+				// different lease
+				if amends == nil || !ev.ID.Equals(*amends) {
+					break
+				}
+
+				o.log.Info("lease amended", "lease", ev.ID, "price", ev.Price)
+				won = true
+				bidsCounter.WithLabelValues(bidWon).Inc()
 
 				break loop
 
@@ -133,6 +161,31 @@ loop:
 				break loop
 			}
 
+		case result := <-orderch:
+			// Order details fetched.
+
+			orderch = nil
+			o.log.Info("order fetched")
+
+			if result.Error() != nil {
+				o.log.Error("fetching order", "err", result.Error())
+				break loop
+			}
+
+			amends = result.Value().(mquery.Order).Amends
+
+			// amendments are bid on by the provider of the amended lease only
+			if amends != nil && !amends.Provider.Equals(o.session.Provider().Address()) {
+				o.log.Debug("ignoring amendment", "lease", *amends)
+				break loop
+			}
+
+			// Begin fetching group details.
+			groupch = runner.Do(func() runner.Result {
+				return runner.NewResult(
+					o.session.Client().Query().Group(o.order.GroupID()))
+			})
+
 		case result := <-groupch:
 			// Group details fetched.
 
@@ -152,6 +205,13 @@ loop:
 			}
 
 			// Begin reserving resources from cluster.
+			if amends != nil {
+				lease := amends.OrderID()
+				clusterch = runner.Do(func() runner.Result {
+					return runner.NewResult(o.cluster.ReserveAmendment(o.order, lease, group))
+				})
+				break
+			}
 			clusterch = runner.Do(func() runner.Result {
 				return runner.NewResult(o.cluster.Reserve(o.order, group))
 			})
@@ -201,6 +261,8 @@ loop:
 			}
 
 			// Fulfillment placed.  All done.
+			bidding = true
+			bidsCounter.WithLabelValues(bidPlaced).Inc()
 		}
 	}
 
@@ -208,6 +270,14 @@ loop:
 	o.lc.ShutdownInitiated(nil)
 	o.sub.Close()
 
+	// the reservation of an accepted amendment becomes that of its lease
+	if won && amends != nil && reservation != nil {
+		o.log.Debug("committing amendment reservation")
+		if err := o.cluster.CommitAmendment(reservation.OrderID(), amends.OrderID(), group); err != nil {
+			o.log.Error("error committing amendment reservation", "err", err)
+		}
+	}
+
 	// cancel reservation
 	if !won && reservation != nil {
 		o.log.Debug("unreserving reservation")
@@ -217,6 +287,9 @@ loop:
 	}
 
 	// Wait for all runners to complete.
+	if orderch != nil {
+		<-orderch
+	}
 	if groupch != nil {
 		<-groupch
 	}
@@ -230,8 +303,24 @@ loop:
 
 func (o *order) shouldBid(group *dquery.Group) bool {
 
+	// draining providers keep their leases but take on no new work
+	if o.session.Provider().Draining {
+		o.log.Debug("unable to fulfill: provider is draining")
+		return false
+	}
+
 	// does provider have required attributes?
-	if !group.MatchAttributes(o.session.Provider().Attributes) {
+	var audited atypes.Providers
+	if !group.SignedBy.Empty() {
+		result, err := o.session.Client().Query().ProviderAttributes(o.session.Provider().Address())
+		if err != nil {
+			o.log.Error("unable to fulfill: audited attributes query failed", "err", err)
+			return false
+		}
+		audited = result.ToTypes()
+	}
+
+	if !group.MatchRequirements(o.session.Provider().Attributes, audited) {
 		o.log.Debug("unable to fulfill: incompatible attributes")
 		return false
 	}
@@ -241,5 +330,16 @@ func (o *order) shouldBid(group *dquery.Group) bool {
 			"err", err)
 		return false
 	}
+
+	// bids carry the denomination the group is priced in
+	price, err := group.Price()
+	if err != nil {
+		o.log.Error("unable to fulfill: group price error", "err", err)
+		return false
+	}
+	if denom := price.Denom; !o.config.acceptsDenom(denom) {
+		o.log.Debug("unable to fulfill: unaccepted price denomination", "denom", denom)
+		return false
+	}
 	return true
 }
@@ -11,7 +11,10 @@ import (
 
 func calculatePrice(gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 
-	min, max := calculatePriceRange(gspec)
+	min, max, err := calculatePriceRange(gspec)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
 
 	if min.IsEqual(max) {
 		return max, nil
@@ -27,13 +30,12 @@ func calculatePrice(gspec *dtypes.GroupSpec) (sdk.Coin, error) {
 	return sdk.NewCoin(min.Denom, min.Amount.Add(sdk.NewIntFromBigInt(val))), nil
 }
 
-func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
+func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin, error) {
 	// memory-based pricing:
 	//   min: requested memory * configured min price per Gi
 	//   max: requested memory * configured max price per Gi
 
 	// assumption: group.Count > 0
-	// assumption: all same denom (returned by gspec.Price())
 	// assumption: gspec.Price() > 0
 
 	mem := sdk.NewInt(0)
@@ -46,7 +48,10 @@ func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
 				MulRaw(int64(group.Count)))
 	}
 
-	rmax := gspec.Price()
+	rmax, err := gspec.Price()
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, err
+	}
 
 	cmin := mem.MulRaw(
 		cfg.MinGroupMemPrice).
@@ -64,5 +69,5 @@ func calculatePriceRange(gspec *dtypes.GroupSpec) (sdk.Coin, sdk.Coin) {
 		cmax = sdk.NewInt(1)
 	}
 
-	return sdk.NewCoin(rmax.Denom, cmin), sdk.NewCoin(rmax.Denom, cmax)
+	return sdk.NewCoin(rmax.Denom, cmin), sdk.NewCoin(rmax.Denom, cmax), nil
 }
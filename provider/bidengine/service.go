@@ -2,13 +2,16 @@ package bidengine
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	lifecycle "github.com/boz/go-lifecycle"
+	"github.com/caarlos0/env"
+	"github.com/pkg/errors"
 
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
+	"github.com/ovrclk/akash/util/runner"
 	mquery "github.com/ovrclk/akash/x/market/query"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
@@ -29,10 +32,16 @@ type Service interface {
 }
 
 // NewService creates new service instance and returns error incase of failure
-func NewService(ctx context.Context, session session.Session, cluster cluster.Cluster, bus pubsub.Bus) (Service, error) {
+func NewService(ctx context.Context, session session.Session, cluster cluster.Service, bus pubsub.Bus) (Service, error) {
 
 	session = session.ForModule("bidengine-service")
 
+	config := config{}
+	if err := env.Parse(&config); err != nil {
+		session.Log().Error("parsing config", "err", err)
+		return nil, errors.Wrap(err, "parsing config")
+	}
+
 	sub, err := bus.Subscribe()
 	if err != nil {
 		return nil, err
@@ -47,6 +56,7 @@ func NewService(ctx context.Context, session session.Session, cluster cluster.Cl
 	session.Log().Info("found orders", "count", len(existingOrders))
 
 	s := &service{
+		config:   config,
 		session:  session,
 		cluster:  cluster,
 		bus:      bus,
@@ -64,8 +74,9 @@ func NewService(ctx context.Context, session session.Session, cluster cluster.Cl
 }
 
 type service struct {
+	config  config
 	session session.Session
-	cluster cluster.Cluster
+	cluster cluster.Service
 
 	bus pubsub.Bus
 	sub pubsub.Subscriber
@@ -121,6 +132,19 @@ func (s *service) run(existingOrders []existingOrder) {
 		s.orders[key] = order
 	}
 
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var capacitych <-chan runner.Result
+	var tickch <-chan time.Time
+
+	if s.config.CapacityUpdatePeriod > 0 {
+		ticker := time.NewTicker(s.config.CapacityUpdatePeriod)
+		defer ticker.Stop()
+		tickch = ticker.C
+
+		capacitych = s.publishCapacity(ctx)
+	}
+
 loop:
 	for {
 		select {
@@ -128,6 +152,19 @@ loop:
 			s.lc.ShutdownInitiated(nil)
 			break loop
 
+		case <-tickch:
+			if capacitych == nil {
+				capacitych = s.publishCapacity(ctx)
+			}
+
+		case result := <-capacitych:
+			capacitych = nil
+			if err := result.Error(); err != nil {
+				s.session.Log().Error("advertising capacity", "err", err)
+				break
+			}
+			s.session.Log().Debug("capacity advertised")
+
 		case ev := <-s.sub.Events():
 			switch ev := ev.(type) { // nolint: gocritic
 			case mtypes.EventOrderCreated:
@@ -162,6 +199,12 @@ loop:
 		}
 	}
 
+	cancel()
+
+	if capacitych != nil {
+		<-capacitych
+	}
+
 	// drain: wait for all order monitors to complete.
 	for len(s.orders) > 0 {
 		key := mquery.OrderPath((<-s.drainch).order)
@@ -175,11 +218,12 @@ type existingOrder struct {
 }
 
 func queryExistingOrders(_ context.Context, session session.Session) ([]existingOrder, error) {
-	orders, err := session.Client().Query().Orders(mquery.OrderFilters{})
+	res, err := session.Client().Query().Orders(mquery.OrderFilters{StateFlagVal: "open"})
 	if err != nil {
 		session.Log().Error("error querying open orders:", "err", err)
 		return nil, err
 	}
+	orders := res.Orders
 
 	existingOrders := make([]existingOrder, 0)
 	for i := range orders {
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/ovrclk/akash/manifest"
+	"github.com/ovrclk/akash/pubsub"
 	atypes "github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/types/unit"
 	mquery "github.com/ovrclk/akash/x/market/query"
@@ -29,9 +30,18 @@ type ReadClient interface {
 type Client interface {
 	ReadClient
 	Deploy(context.Context, mtypes.LeaseID, *manifest.Group) error
+	// Rollback deploys a previous revision of the lease's manifest group and
+	// reports the reason in the lease status until the next Deploy
+	Rollback(context.Context, mtypes.LeaseID, *manifest.Group, string) error
+	// TransferLease moves what runs under the first lease to the second one
+	// without redeploying it
+	TransferLease(context.Context, mtypes.LeaseID, mtypes.LeaseID) error
 	TeardownLease(context.Context, mtypes.LeaseID) error
 	Deployments(context.Context) ([]Deployment, error)
 	Inventory(context.Context) ([]Node, error)
+	// Watch returns a stream of event.ClusterLeaseChanged and event.ClusterInventoryChanged
+	// events. The stream is closed when the context is done.
+	Watch(context.Context) (<-chan pubsub.Event, error)
 }
 
 // Node interface predefined with ID and Available methods
@@ -81,8 +91,9 @@ const (
 )
 
 type nullClient struct {
-	leases map[string]*manifest.Group
-	mtx    sync.Mutex
+	leases    map[string]*manifest.Group
+	rollbacks map[string]string
+	mtx       sync.Mutex
 }
 
 // NewServiceLog creates and returns a service log with provided details
@@ -97,8 +108,9 @@ func NewServiceLog(name string, stream io.ReadCloser) *ServiceLog {
 // NullClient returns nullClient instance
 func NullClient() Client {
 	return &nullClient{
-		leases: make(map[string]*manifest.Group),
-		mtx:    sync.Mutex{},
+		leases:    make(map[string]*manifest.Group),
+		rollbacks: make(map[string]string),
+		mtx:       sync.Mutex{},
 	}
 }
 
@@ -106,6 +118,15 @@ func (c *nullClient) Deploy(ctx context.Context, lid mtypes.LeaseID, mgroup *man
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 	c.leases[mquery.LeasePath(lid)] = mgroup
+	delete(c.rollbacks, mquery.LeasePath(lid))
+	return nil
+}
+
+func (c *nullClient) Rollback(ctx context.Context, lid mtypes.LeaseID, mgroup *manifest.Group, reason string) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	c.leases[mquery.LeasePath(lid)] = mgroup
+	c.rollbacks[mquery.LeasePath(lid)] = reason
 	return nil
 }
 
@@ -118,7 +139,7 @@ func (c *nullClient) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*Leas
 		return nil, nil
 	}
 
-	resp := &LeaseStatus{}
+	resp := &LeaseStatus{RollbackReason: c.rollbacks[mquery.LeasePath(lid)]}
 	for _, svc := range mgroup.Services {
 		resp.Services = append(resp.Services, &ServiceStatus{
 			Name:      svc.Name,
@@ -138,11 +159,27 @@ func (c *nullClient) ServiceLogs(_ context.Context, _ mtypes.LeaseID, _ int64, _
 	return nil, nil
 }
 
+func (c *nullClient) TransferLease(ctx context.Context, from, to mtypes.LeaseID) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if mgroup, ok := c.leases[mquery.LeasePath(from)]; ok {
+		c.leases[mquery.LeasePath(to)] = mgroup
+		delete(c.leases, mquery.LeasePath(from))
+	}
+	if reason, ok := c.rollbacks[mquery.LeasePath(from)]; ok {
+		c.rollbacks[mquery.LeasePath(to)] = reason
+		delete(c.rollbacks, mquery.LeasePath(from))
+	}
+	return nil
+}
+
 func (c *nullClient) TeardownLease(ctx context.Context, lid mtypes.LeaseID) error {
 	c.mtx.Lock()
 	defer c.mtx.Unlock()
 
 	delete(c.leases, mquery.LeasePath(lid))
+	delete(c.rollbacks, mquery.LeasePath(lid))
 	return nil
 }
 
@@ -159,3 +196,12 @@ func (c *nullClient) Inventory(ctx context.Context) ([]Node, error) {
 		}),
 	}, nil
 }
+
+func (c *nullClient) Watch(ctx context.Context) (<-chan pubsub.Event, error) {
+	ch := make(chan pubsub.Event)
+	go func() {
+		<-ctx.Done()
+		close(ch)
+	}()
+	return ch, nil
+}
@@ -3,6 +3,7 @@ package cluster
 import "time"
 
 type config struct {
-	InventoryResourcePollPeriod     time.Duration `env:"AKASH_INVENTORY_RESOURCE_POLL_PERIOD" envDefault:"5s"`
+	// inventory is also refreshed whenever the cluster reports a change in node capacity
+	InventoryResourcePollPeriod     time.Duration `env:"AKASH_INVENTORY_RESOURCE_POLL_PERIOD" envDefault:"1m"`
 	InventoryResourceDebugFrequency uint          `env:"AKASH_INVENTORY_RESOURCE_DEBUG_FREQUENCY" envDefault:"10"`
 }
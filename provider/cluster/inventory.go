@@ -12,6 +12,7 @@ import (
 	"github.com/ovrclk/akash/pubsub"
 	atypes "github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/util/runner"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -31,6 +32,8 @@ type inventoryService struct {
 	lookupch    chan inventoryRequest
 	reservech   chan inventoryRequest
 	unreservech chan inventoryRequest
+	amendch     chan inventoryRequest
+	commitch    chan inventoryRequest
 
 	readych chan struct{}
 
@@ -60,6 +63,8 @@ func newInventoryService(
 		lookupch:    make(chan inventoryRequest),
 		reservech:   make(chan inventoryRequest),
 		unreservech: make(chan inventoryRequest),
+		amendch:     make(chan inventoryRequest),
+		commitch:    make(chan inventoryRequest),
 		readych:     make(chan struct{}),
 		log:         log.With("cmp", "inventory-service"),
 		lc:          lifecycle.New(),
@@ -136,6 +141,42 @@ func (is *inventoryService) unreserve(order mtypes.OrderID, resources atypes.Res
 	}
 }
 
+func (is *inventoryService) reserveAmendment(order, lease mtypes.OrderID, resources atypes.ResourceGroup) (Reservation, error) {
+	ch := make(chan inventoryResponse, 1)
+	req := inventoryRequest{
+		order:     order,
+		lease:     lease,
+		resources: resources,
+		ch:        ch,
+	}
+
+	select {
+	case is.amendch <- req:
+		response := <-ch
+		return response.value, response.err
+	case <-is.lc.ShuttingDown():
+		return nil, ErrNotRunning
+	}
+}
+
+func (is *inventoryService) commitAmendment(order, lease mtypes.OrderID, resources atypes.ResourceGroup) (Reservation, error) {
+	ch := make(chan inventoryResponse, 1)
+	req := inventoryRequest{
+		order:     order,
+		lease:     lease,
+		resources: resources,
+		ch:        ch,
+	}
+
+	select {
+	case is.commitch <- req:
+		response := <-ch
+		return response.value, response.err
+	case <-is.lc.ShuttingDown():
+		return nil, ErrNotRunning
+	}
+}
+
 func (is *inventoryService) status(ctx context.Context) (InventoryStatus, error) {
 	ch := make(chan InventoryStatus, 1)
 
@@ -158,7 +199,9 @@ func (is *inventoryService) status(ctx context.Context) (InventoryStatus, error)
 }
 
 type inventoryRequest struct {
-	order     mtypes.OrderID
+	order mtypes.OrderID
+	// lease is the order of the lease amended by order; amendments only
+	lease     mtypes.OrderID
 	resources atypes.ResourceGroup
 	ch        chan<- inventoryResponse
 }
@@ -185,13 +228,22 @@ func (is *inventoryService) run(reservations []*reservation) {
 
 loop:
 	for {
+		observeReservations(is.getStatus(nil, reservations))
+
 		select {
 		case err := <-is.lc.ShutdownRequest():
 			is.lc.ShutdownInitiated(err)
 			break loop
 
 		case ev := <-is.sub.Events():
-			switch ev := ev.(type) { // nolint: gocritic
+			switch ev := ev.(type) {
+			case event.ClusterInventoryChanged:
+				// refresh now rather than at the next poll
+				if runch == nil {
+					t.Stop()
+					runch = is.runCheck(ctx)
+				}
+
 			case event.ClusterDeployment:
 				// mark reservation allocated if deployment successful
 				for _, res := range reservations {
@@ -211,6 +263,17 @@ loop:
 
 					break
 				}
+
+			case dtypes.EventDeploymentTransfer:
+				// reservations follow the orders of the transferred deployment
+				for _, res := range reservations {
+					if !res.OrderID().GroupID().DeploymentID().Equals(ev.ID) {
+						continue
+					}
+					res.order.Owner = ev.NewOwner
+
+					is.log.Debug("reservation transferred", "order", res.OrderID())
+				}
 			}
 
 		case req := <-is.reservech:
@@ -230,6 +293,50 @@ loop:
 
 			req.ch <- inventoryResponse{err: ErrInsufficientCapacity}
 
+		case req := <-is.amendch:
+			// reserve the resources an amendment adds to the reservation of its lease
+
+			prev := findReservation(reservations, req.lease, req.resources.GetName())
+			if prev == nil {
+				req.ch <- inventoryResponse{err: errNotFound}
+				break
+			}
+
+			reservation := newReservation(req.order, amendmentResources(prev.Resources(), req.resources))
+
+			is.log.Debug("amendment reservation requested", "order", req.order, "lease", req.lease, "resources", req.resources)
+
+			if reservationAllocateable(inventory, reservations, reservation) {
+				reservations = append(reservations, reservation)
+				req.ch <- inventoryResponse{value: reservation}
+				break
+			}
+
+			is.log.Info("insufficient capacity for amendment", "order", req.order, "lease", req.lease)
+
+			req.ch <- inventoryResponse{err: ErrInsufficientCapacity}
+
+		case req := <-is.commitch:
+			// fold an amendment reservation into the reservation of its lease
+
+			prev := findReservation(reservations, req.lease, req.resources.GetName())
+			if prev == nil {
+				req.ch <- inventoryResponse{err: errNotFound}
+				break
+			}
+
+			for idx, res := range reservations {
+				if res.OrderID().Equals(req.order) && res.Resources().GetName() == req.resources.GetName() {
+					reservations = append(reservations[:idx], reservations[idx+1:]...)
+					break
+				}
+			}
+			prev.resources = req.resources
+
+			is.log.Debug("amendment committed", "order", req.order, "lease", req.lease)
+
+			req.ch <- inventoryResponse{value: prev}
+
 		case req := <-is.lookupch:
 			// lookup registration
 
@@ -352,6 +459,51 @@ func (is *inventoryService) getStatus(
 	return status
 }
 
+func findReservation(reservations []*reservation, order mtypes.OrderID, name string) *reservation {
+	for _, res := range reservations {
+		if res.OrderID().Equals(order) && res.Resources().GetName() == name {
+			return res
+		}
+	}
+	return nil
+}
+
+// amendmentResources returns the resources of next in excess of those of prev
+func amendmentResources(prev, next atypes.ResourceGroup) atypes.ResourceGroup {
+	group := resourceGroup{name: next.GetName()}
+
+	for _, resource := range next.GetResources() {
+		for _, existing := range prev.GetResources() {
+			if !existing.Unit.Equals(resource.Unit) {
+				continue
+			}
+			if existing.Count >= resource.Count {
+				resource.Count = 0
+			} else {
+				resource.Count -= existing.Count
+			}
+			break
+		}
+		if resource.Count > 0 {
+			group.resources = append(group.resources, resource)
+		}
+	}
+	return group
+}
+
+type resourceGroup struct {
+	name      string
+	resources []atypes.Resource
+}
+
+func (g resourceGroup) GetName() string {
+	return g.name
+}
+
+func (g resourceGroup) GetResources() []atypes.Resource {
+	return g.resources
+}
+
 func reservationAllocateable(inventory []Node, reservations []*reservation, newReservation *reservation) bool {
 
 	// 1. for each unallocated reservation, subtract its resources
@@ -61,3 +61,42 @@ func TestInventory_reservationAllocateable(t *testing.T) {
 	}
 
 }
+
+func TestInventory_amendmentResources(t *testing.T) {
+
+	mkrg := func(cpu uint32, memory uint64, count uint32) dtypes.Resource {
+		return dtypes.Resource{
+			Unit: types.Unit{
+				CPU:    cpu,
+				Memory: memory,
+			},
+			Count: count,
+		}
+	}
+
+	prev := &dtypes.GroupSpec{
+		Name: "group",
+		Resources: []dtypes.Resource{
+			mkrg(100, 1*unit.Gi, 2),
+			mkrg(250, 2*unit.Gi, 1),
+		},
+	}
+	next := &dtypes.GroupSpec{
+		Name: "group",
+		Resources: []dtypes.Resource{
+			mkrg(100, 1*unit.Gi, 3),
+			mkrg(250, 2*unit.Gi, 1),
+			mkrg(500, 4*unit.Gi, 1),
+		},
+	}
+
+	delta := amendmentResources(prev, next)
+	assert.Equal(t, "group", delta.GetName())
+	assert.Equal(t, []types.Resource{
+		{Unit: types.Unit{CPU: 100, Memory: 1 * unit.Gi}, Count: 1},
+		{Unit: types.Unit{CPU: 500, Memory: 4 * unit.Gi}, Count: 1},
+	}, delta.GetResources())
+
+	// shrinking groups need no further resources
+	assert.Empty(t, amendmentResources(next, prev).GetResources())
+}
@@ -98,7 +98,15 @@ func applyManifest(ctx context.Context, kc akashv1.Interface, b *manifestBuilder
 	obj, err := kc.AkashV1().Manifests(b.ns()).Get(ctx, b.name(), metav1.GetOptions{})
 	switch {
 	case err == nil:
-		obj, err = b.update(obj)
+		var held bool
+		held, err = heldByLease(obj, b.lid)
+		if err == nil && !held {
+			// the namespace was transferred to another lease
+			err = ErrNamespaceHeld
+		}
+		if err == nil {
+			obj, err = b.update(obj)
+		}
 		if err == nil {
 			_, err = kc.AkashV1().Manifests(b.ns()).Update(ctx, obj, metav1.UpdateOptions{})
 		}
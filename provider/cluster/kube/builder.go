@@ -23,17 +23,27 @@ import (
 const (
 	akashManagedLabelName         = "akash.network"
 	akashManifestServiceLabelName = "akash.network/manifest-service"
+
+	// akashRollbackAnnotationName annotates manifests rolled back to a previous revision with the reason
+	akashRollbackAnnotationName = "akash.network/rollback-reason"
+
+	// lease labels identify the lease a manifest currently belongs to
+	akashLeaseOwnerLabelName    = "akash.network/lease.id.owner"
+	akashLeaseDSeqLabelName     = "akash.network/lease.id.dseq"
+	akashLeaseGSeqLabelName     = "akash.network/lease.id.gseq"
+	akashLeaseOSeqLabelName     = "akash.network/lease.id.oseq"
+	akashLeaseProviderLabelName = "akash.network/lease.id.provider"
 )
 
 type builder struct {
-	log      log.Logger
-	settings settings
-	lid      mtypes.LeaseID
-	group    *manifest.Group
+	log       log.Logger
+	settings  settings
+	namespace string
+	group     *manifest.Group
 }
 
 func (b *builder) ns() string {
-	return lidNS(b.lid)
+	return b.namespace
 }
 
 func (b *builder) labels() map[string]string {
@@ -46,8 +56,8 @@ type nsBuilder struct {
 	builder
 }
 
-func newNSBuilder(settings settings, lid mtypes.LeaseID, group *manifest.Group) *nsBuilder {
-	return &nsBuilder{builder: builder{settings: settings, lid: lid, group: group}}
+func newNSBuilder(settings settings, ns string, group *manifest.Group) *nsBuilder {
+	return &nsBuilder{builder: builder{settings: settings, namespace: ns, group: group}}
 }
 
 func (b *nsBuilder) name() string {
@@ -75,13 +85,13 @@ type deploymentBuilder struct {
 	service *manifest.Service
 }
 
-func newDeploymentBuilder(log log.Logger, settings settings, lid mtypes.LeaseID, group *manifest.Group, service *manifest.Service) *deploymentBuilder {
+func newDeploymentBuilder(log log.Logger, settings settings, ns string, group *manifest.Group, service *manifest.Service) *deploymentBuilder {
 	return &deploymentBuilder{
 		builder: builder{
-			settings: settings,
-			log:      log.With("module", "kube-builder"),
-			lid:      lid,
-			group:    group,
+			settings:  settings,
+			log:       log.With("module", "kube-builder"),
+			namespace: ns,
+			group:     group,
 		},
 		service: service,
 	}
@@ -109,6 +119,7 @@ func (b *deploymentBuilder) create() (*appsv1.Deployment, error) { // nolint:gol
 				MatchLabels: b.labels(),
 			},
 			Replicas: &replicas,
+			Strategy: b.strategy(),
 			Template: corev1.PodTemplateSpec{
 				ObjectMeta: metav1.ObjectMeta{
 					Labels: b.labels(),
@@ -128,11 +139,34 @@ func (b *deploymentBuilder) update(obj *appsv1.Deployment) (*appsv1.Deployment,
 	obj.Labels = b.labels()
 	obj.Spec.Selector.MatchLabels = b.labels()
 	obj.Spec.Replicas = &replicas
+	obj.Spec.Strategy = b.strategy()
 	obj.Spec.Template.Labels = b.labels()
 	obj.Spec.Template.Spec.Containers = []corev1.Container{b.container()}
 	return obj, nil
 }
 
+// strategy returns the rolling update strategy of the settings; the cluster default if unset
+func (b *deploymentBuilder) strategy() appsv1.DeploymentStrategy {
+	if b.settings.DeploymentMaxSurge == "" && b.settings.DeploymentMaxUnavailable == "" {
+		return appsv1.DeploymentStrategy{}
+	}
+
+	update := &appsv1.RollingUpdateDeployment{}
+	if val := b.settings.DeploymentMaxSurge; val != "" {
+		surge := intstr.Parse(val)
+		update.MaxSurge = &surge
+	}
+	if val := b.settings.DeploymentMaxUnavailable; val != "" {
+		unavailable := intstr.Parse(val)
+		update.MaxUnavailable = &unavailable
+	}
+
+	return appsv1.DeploymentStrategy{
+		Type:          appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: update,
+	}
+}
+
 func (b *deploymentBuilder) container() corev1.Container {
 	qcpu := resource.NewScaledQuantity(int64(b.service.Unit.CPU), resource.Milli)
 	qmem := resource.NewQuantity(int64(b.service.Unit.Memory), resource.DecimalSI)
@@ -178,14 +212,14 @@ type serviceBuilder struct {
 	deploymentBuilder
 }
 
-func newServiceBuilder(log log.Logger, settings settings, lid mtypes.LeaseID, group *manifest.Group, service *manifest.Service) *serviceBuilder {
+func newServiceBuilder(log log.Logger, settings settings, ns string, group *manifest.Group, service *manifest.Service) *serviceBuilder {
 	return &serviceBuilder{
 		deploymentBuilder: deploymentBuilder{
 			builder: builder{
-				log:      log.With("module", "kube-builder"),
-				settings: settings,
-				lid:      lid,
-				group:    group,
+				log:       log.With("module", "kube-builder"),
+				settings:  settings,
+				namespace: ns,
+				group:     group,
 			},
 			service: service,
 		},
@@ -233,7 +267,7 @@ type ingressBuilder struct {
 	expose *manifest.ServiceExpose
 }
 
-func newIngressBuilder(log log.Logger, settings settings, host string, lid mtypes.LeaseID, group *manifest.Group, service *manifest.Service, expose *manifest.ServiceExpose) *ingressBuilder {
+func newIngressBuilder(log log.Logger, settings settings, host string, ns string, group *manifest.Group, service *manifest.Service, expose *manifest.ServiceExpose) *ingressBuilder {
 	if settings.DeploymentIngressStaticHosts {
 		uid := strings.ToLower(shortuuid.New())
 		h := fmt.Sprintf("%s.%s", uid, settings.DeploymentIngressDomain)
@@ -243,10 +277,10 @@ func newIngressBuilder(log log.Logger, settings settings, host string, lid mtype
 	return &ingressBuilder{
 		deploymentBuilder: deploymentBuilder{
 			builder: builder{
-				log:      log.With("module", "kube-builder"),
-				settings: settings,
-				lid:      lid,
-				group:    group,
+				log:       log.With("module", "kube-builder"),
+				settings:  settings,
+				namespace: ns,
+				group:     group,
 			},
 			service: service,
 		},
@@ -302,7 +336,16 @@ func exposeExternalPort(expose *manifest.ServiceExpose) int32 {
 
 // lidNS generates a unique sha256 sum for identifying a provider's object name.
 func lidNS(lid mtypes.LeaseID) string {
+	return lidNSN(lid, 0)
+}
+
+// lidNSN returns the n-th namespace derived from the lease; the first is the
+// one derived from the lease alone.
+func lidNSN(lid mtypes.LeaseID, n int) string {
 	path := mtypes.BidIDString(lid.BidID())
+	if n > 0 {
+		path = fmt.Sprintf("%s/%d", path, n)
+	}
 	// DNS-1123 label must consist of lower case alphanumeric characters or '-',
 	// and must start and end with an alphanumeric character
 	// (e.g. 'my-name',  or '123-abc', regex used for validation
@@ -312,21 +355,26 @@ func lidNS(lid mtypes.LeaseID) string {
 }
 
 // manifestBuilder composes the k8s akashv1.Manifest type from LeaseID and
-// manifest.Group data.
+// manifest.Group data. The manifest is named after the lease's namespace.
 type manifestBuilder struct {
 	builder
-	mns string // Q: is this supposed to be the k8s Namespace? It's the Object name now.
+	mns string // namespace of the manifest object itself
+	lid mtypes.LeaseID
+
+	// rollback is why the group is a rolled back revision; empty if it is not
+	rollback string
 }
 
-func newManifestBuilder(log log.Logger, settings settings, ns string, lid mtypes.LeaseID, group *manifest.Group) *manifestBuilder {
+func newManifestBuilder(log log.Logger, settings settings, mns string, ns string, lid mtypes.LeaseID, group *manifest.Group) *manifestBuilder {
 	return &manifestBuilder{
 		builder: builder{
-			log:      log.With("module", "kube-builder"),
-			settings: settings,
-			lid:      lid,
-			group:    group,
+			log:       log.With("module", "kube-builder"),
+			settings:  settings,
+			namespace: ns,
+			group:     group,
 		},
-		mns: ns,
+		mns: mns,
+		lid: lid,
 	}
 }
 
@@ -334,12 +382,21 @@ func (b *manifestBuilder) ns() string {
 	return b.mns
 }
 
+func (b *manifestBuilder) labels() map[string]string {
+	obj := b.builder.labels()
+	for key, val := range leaseLabels(b.lid) {
+		obj[key] = val
+	}
+	return obj
+}
+
 func (b *manifestBuilder) create() (*akashv1.Manifest, error) {
 	obj, err := akashv1.NewManifest(b.name(), b.lid, b.group)
 	if err != nil {
 		return nil, err
 	}
 	obj.Labels = b.labels()
+	obj.Annotations = b.annotations(nil)
 	return obj, nil
 }
 
@@ -350,9 +407,38 @@ func (b *manifestBuilder) update(obj *akashv1.Manifest) (*akashv1.Manifest, erro
 	}
 	obj.Spec = m.Spec
 	obj.Labels = b.labels()
+	obj.Annotations = b.annotations(obj.Annotations)
 	return obj, nil
 }
 
+func (b *manifestBuilder) annotations(prev map[string]string) map[string]string {
+	obj := make(map[string]string, len(prev)+1)
+	for key, val := range prev {
+		obj[key] = val
+	}
+	delete(obj, akashRollbackAnnotationName)
+	if b.rollback != "" {
+		obj[akashRollbackAnnotationName] = b.rollback
+	}
+	if len(obj) == 0 {
+		return nil
+	}
+	return obj
+}
+
 func (b *manifestBuilder) name() string {
-	return lidNS(b.lid)
+	return b.builder.ns()
+}
+
+// leaseLabels identify the lease of a manifest. A manifest keeps its name,
+// and so its namespace, when its deployment is transferred; the labels are
+// how it is found under the new lease.
+func leaseLabels(lid mtypes.LeaseID) map[string]string {
+	return map[string]string{
+		akashLeaseOwnerLabelName:    lid.Owner.String(),
+		akashLeaseDSeqLabelName:     strconv.FormatUint(lid.DSeq, 10),
+		akashLeaseGSeqLabelName:     strconv.FormatUint(uint64(lid.GSeq), 10),
+		akashLeaseOSeqLabelName:     strconv.FormatUint(uint64(lid.OSeq), 10),
+		akashLeaseProviderLabelName: lid.Provider.String(),
+	}
 }
@@ -6,6 +6,8 @@ import (
 	"github.com/ovrclk/akash/manifest"
 	"github.com/ovrclk/akash/testutil"
 	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 func TestLidNsSanity(t *testing.T) {
@@ -21,10 +23,51 @@ func TestLidNsSanity(t *testing.T) {
 	assert.Less(t, len(ns), int(64))
 
 	g := &manifest.Group{}
-	mb := newManifestBuilder(log, settings{}, ns, leaseID, g)
+	mb := newManifestBuilder(log, settings{}, "lease", ns, leaseID, g)
 
 	m, err := mb.create()
 	assert.NoError(t, err)
 
 	assert.Equal(t, ns, m.Name)
+	assert.Equal(t, leaseID.Owner.String(), m.Labels[akashLeaseOwnerLabelName])
+}
+
+func TestDeploymentStrategy(t *testing.T) {
+	log := testutil.Logger(t)
+	group := testutil.AppManifestGenerator.Group(t)
+
+	b := newDeploymentBuilder(log, settings{}, lidNS(testutil.LeaseID(t)), &group, &group.Services[0])
+	obj, err := b.create()
+	assert.NoError(t, err)
+	assert.Empty(t, obj.Spec.Strategy.Type)
+
+	b.settings = settings{DeploymentMaxSurge: "25%", DeploymentMaxUnavailable: "0"}
+	obj, err = b.update(obj)
+	assert.NoError(t, err)
+	assert.Equal(t, appsv1.RollingUpdateDeploymentStrategyType, obj.Spec.Strategy.Type)
+	assert.Equal(t, intstr.FromString("25%"), *obj.Spec.Strategy.RollingUpdate.MaxSurge)
+	assert.Equal(t, intstr.FromInt(0), *obj.Spec.Strategy.RollingUpdate.MaxUnavailable)
+
+	assert.NoError(t, validateIntOrPercent("25%"))
+	assert.NoError(t, validateIntOrPercent("1"))
+	assert.Error(t, validateIntOrPercent("-1"))
+	assert.Error(t, validateIntOrPercent("many"))
+}
+
+func TestManifestRollbackAnnotation(t *testing.T) {
+	log := testutil.Logger(t)
+	lid := testutil.LeaseID(t)
+	group := testutil.AppManifestGenerator.Group(t)
+
+	mb := newManifestBuilder(log, settings{}, "lease", lidNS(lid), lid, &group)
+	mb.rollback = "service web: 0 of 1 replicas available"
+
+	obj, err := mb.create()
+	assert.NoError(t, err)
+	assert.Equal(t, mb.rollback, obj.Annotations[akashRollbackAnnotationName])
+
+	// deploying a new revision clears the reason
+	obj, err = newManifestBuilder(log, settings{}, "lease", lidNS(lid), lid, &group).update(obj)
+	assert.NoError(t, err)
+	assert.NotContains(t, obj.Annotations, akashRollbackAnnotationName)
 }
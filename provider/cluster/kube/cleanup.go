@@ -4,16 +4,13 @@ import (
 	"context"
 
 	"github.com/ovrclk/akash/manifest"
-	mtypes "github.com/ovrclk/akash/x/market/types"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/client-go/kubernetes"
 )
 
-func cleanupStaleResources(ctx context.Context, kc kubernetes.Interface, lid mtypes.LeaseID, group *manifest.Group) error {
-	ns := lidNS(lid)
-
+func cleanupStaleResources(ctx context.Context, kc kubernetes.Interface, ns string, group *manifest.Group) error {
 	// build label selector for objects not in current manifest group
 	svcnames := make([]string, 0, len(group.Services))
 	for _, svc := range group.Services {
@@ -9,7 +9,9 @@ import (
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -19,7 +21,10 @@ import (
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/ovrclk/akash/manifest"
+	akashv1 "github.com/ovrclk/akash/pkg/apis/akash.network/v1"
 	akashclient "github.com/ovrclk/akash/pkg/client/clientset/versioned"
+	akashinformers "github.com/ovrclk/akash/pkg/client/informers/externalversions"
+	akashlisters "github.com/ovrclk/akash/pkg/client/listers/akash.network/v1"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/validation"
@@ -30,6 +35,7 @@ var (
 	ErrNoDeploymentForLease = errors.New("kube: no deployments for lease")
 	ErrNoIngressForLease    = errors.New("kube: no ingress for lease")
 	ErrInternalError        = errors.New("kube: internal error")
+	ErrNamespaceHeld        = errors.New("kube: namespace held by another lease")
 )
 
 // Client interface includes cluster client
@@ -44,13 +50,15 @@ type client struct {
 	ac       akashclient.Interface
 	metc     metricsclient.Interface
 	ns       string
-	host     string
 	settings settings
 	log      log.Logger
+
+	// manifests caches the manifests of ns, which map leases to their namespaces
+	manifests akashlisters.ManifestLister
 }
 
-// NewClient returns new Client instance with provided logger, host and ns. Returns error incase of failure
-func NewClient(log log.Logger, host, ns string) (Client, error) {
+// NewClient returns new Client instance with provided logger and ns. Returns error incase of failure
+func NewClient(log log.Logger, ns string) (Client, error) {
 	var settings settings
 	if err := env.Parse(&settings); err != nil {
 		return nil, err
@@ -58,10 +66,10 @@ func NewClient(log log.Logger, host, ns string) (Client, error) {
 	if err := validateSettings(settings); err != nil {
 		return nil, err
 	}
-	return newClientWithSettings(log, host, ns, settings)
+	return newClientWithSettings(log, ns, settings)
 }
 
-func newClientWithSettings(log log.Logger, host, ns string, settings settings) (Client, error) {
+func newClientWithSettings(log log.Logger, ns string, settings settings) (Client, error) {
 	ctx := context.Background()
 	config, err := openKubeConfig(log)
 	if err != nil {
@@ -91,18 +99,38 @@ func newClientWithSettings(log log.Logger, host, ns string, settings settings) (
 		return nil, errors.Wrap(err, "kube: error connecting to kubernetes")
 	}
 
+	manifests, err := newManifestLister(ctx, mc, ns)
+	if err != nil {
+		return nil, err
+	}
+
 	return &client{
-		settings: settings,
-		kc:       kc,
-		ac:       mc,
-		metc:     metc,
-		ns:       ns,
-		host:     host,
-		log:      log.With("module", "provider-cluster-kube"),
+		settings:  settings,
+		kc:        kc,
+		ac:        mc,
+		metc:      metc,
+		ns:        ns,
+		log:       log.With("module", "provider-cluster-kube"),
+		manifests: manifests,
 	}, nil
 
 }
 
+// newManifestLister returns a lister of the manifests of ns, synced from an
+// informer that runs until the context is done
+func newManifestLister(ctx context.Context, ac akashclient.Interface, ns string) (akashlisters.ManifestLister, error) {
+	factory := akashinformers.NewSharedInformerFactoryWithOptions(ac, 0, akashinformers.WithNamespace(ns))
+	lister := factory.Akash().V1().Manifests().Lister()
+
+	factory.Start(ctx.Done())
+	for typ, ok := range factory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return nil, errors.Wrapf(ErrCacheSync, "%v", typ)
+		}
+	}
+	return lister, nil
+}
+
 func openKubeConfig(log log.Logger) (*rest.Config, error) {
 	cfgpath := path.Join(homedir.HomeDir(), ".kube", "config")
 
@@ -115,12 +143,6 @@ func openKubeConfig(log log.Logger) (*rest.Config, error) {
 	return rest.InClusterConfig()
 }
 
-func (c *client) shouldExpose(expose *manifest.ServiceExpose) bool {
-	return expose.Global &&
-		(expose.ExternalPort == 80 ||
-			(expose.ExternalPort == 0 && expose.Port == 80))
-}
-
 func (c *client) Deployments(ctx context.Context) ([]cluster.Deployment, error) {
 	manifests, err := c.ac.AkashV1().Manifests(c.ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
@@ -139,68 +161,169 @@ func (c *client) Deployments(ctx context.Context) ([]cluster.Deployment, error)
 	return deployments, nil
 }
 
+// leaseNS returns the namespace of the lease: that of the manifest labeled
+// with it, or for a lease not yet deployed, the first namespace derived from
+// the lease that no manifest of another lease holds. Transferred leases keep
+// their namespaces, so a lease created again with the ID of a transferred one
+// is deployed to a namespace of its own.
+func (c *client) leaseNS(ctx context.Context, lid mtypes.LeaseID) (string, error) {
+	selector := labels.SelectorFromSet(leaseLabels(lid))
+	cached, err := c.manifests.Manifests(c.ns).List(selector)
+	if err != nil {
+		c.log.Error(err.Error())
+		return "", errors.Wrap(err, ErrInternalError.Error())
+	}
+	if len(cached) > 0 {
+		return cached[0].Name, nil
+	}
+
+	// the cache lags behind manifests just written, so ask the API server
+	// before deriving a namespace
+	manifests, err := c.ac.AkashV1().Manifests(c.ns).List(ctx, metav1.ListOptions{
+		LabelSelector: selector.String(),
+	})
+	if err != nil {
+		c.log.Error(err.Error())
+		return "", errors.Wrap(err, ErrInternalError.Error())
+	}
+	if len(manifests.Items) > 0 {
+		return manifests.Items[0].Name, nil
+	}
+
+	for n := 0; ; n++ {
+		ns := lidNSN(lid, n)
+		obj, err := c.ac.AkashV1().Manifests(c.ns).Get(ctx, ns, metav1.GetOptions{})
+		if kerrors.IsNotFound(err) {
+			return ns, nil
+		}
+		if err != nil {
+			c.log.Error(err.Error())
+			return "", errors.Wrap(err, ErrInternalError.Error())
+		}
+		if held, err := heldByLease(obj, lid); err != nil || held {
+			return ns, err
+		}
+	}
+}
+
+// heldByLease returns whether the manifest was written for the lease
+func heldByLease(obj *akashv1.Manifest, lid mtypes.LeaseID) (bool, error) {
+	deployment, err := obj.Deployment()
+	if err != nil {
+		return false, err
+	}
+	return deployment.LeaseID().Equals(lid), nil
+}
+
+// Deploy writes the lease's akashv1.Manifest; the operator reconciles the
+// lease's namespace, deployments, services and ingresses from it.
 func (c *client) Deploy(ctx context.Context, lid mtypes.LeaseID, group *manifest.Group) error {
-	if err := applyNS(ctx, c.kc, newNSBuilder(c.settings, lid, group)); err != nil {
-		c.log.Error("applying namespace", "err", err, "lease", lid)
+	ns, err := c.leaseNS(ctx, lid)
+	if err != nil {
 		return err
 	}
-
-	if err := applyManifest(ctx, c.ac, newManifestBuilder(c.log, c.settings, c.ns, lid, group)); err != nil {
+	if err := applyManifest(ctx, c.ac, newManifestBuilder(c.log, c.settings, c.ns, ns, lid, group)); err != nil {
 		c.log.Error("applying manifest", "err", err, "lease", lid)
 		return err
 	}
+	return nil
+}
 
-	if err := cleanupStaleResources(ctx, c.kc, lid, group); err != nil {
-		c.log.Error("cleaning stale resources", "err", err, "lease", lid)
+// Rollback writes the lease's akashv1.Manifest with a previous revision of
+// its group, annotated with the reason
+func (c *client) Rollback(ctx context.Context, lid mtypes.LeaseID, group *manifest.Group, reason string) error {
+	ns, err := c.leaseNS(ctx, lid)
+	if err != nil {
 		return err
 	}
+	builder := newManifestBuilder(c.log, c.settings, c.ns, ns, lid, group)
+	builder.rollback = reason
 
-	for svcIdx := range group.Services {
-		service := &group.Services[svcIdx]
-		if err := applyDeployment(ctx, c.kc, newDeploymentBuilder(c.log, c.settings, lid, group, service)); err != nil {
-			c.log.Error("applying deployment", "err", err, "lease", lid, "service", service.Name)
-			return err
-		}
+	if err := applyManifest(ctx, c.ac, builder); err != nil {
+		c.log.Error("applying manifest", "err", err, "lease", lid)
+		return err
+	}
+	return nil
+}
 
-		if len(service.Expose) == 0 {
-			c.log.Debug("no services", "lease", lid, "service", service.Name)
-			continue
-		}
+// TransferLease moves the akashv1.Manifest of lease from to lease to. The
+// manifest keeps its name, so the lease keeps running in its namespace.
+func (c *client) TransferLease(ctx context.Context, from, to mtypes.LeaseID) error {
+	ns, err := c.leaseNS(ctx, from)
+	if err != nil {
+		return err
+	}
 
-		if err := applyService(ctx, c.kc, newServiceBuilder(c.log, c.settings, lid, group, service)); err != nil {
-			c.log.Error("applying service", "err", err, "lease", lid, "service", service.Name)
-			return err
-		}
+	obj, err := c.ac.AkashV1().Manifests(c.ns).Get(ctx, ns, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		// not deployed yet
+		return nil
+	}
+	if err != nil {
+		return err
+	}
 
-		for expIdx := range service.Expose {
-			expose := &service.Expose[expIdx]
-			if !c.shouldExpose(expose) {
-				continue
-			}
-			if err := applyIngress(ctx, c.kc, newIngressBuilder(c.log, c.settings, c.host, lid, group, service, expose)); err != nil {
-				c.log.Error("applying ingress", "err", err, "lease", lid, "service", service.Name, "expose", expose)
-				return err
-			}
-		}
+	deployment, err := obj.Deployment()
+	if err != nil {
+		return err
+	}
+	if !deployment.LeaseID().Equals(from) {
+		// already transferred
+		return nil
 	}
+	group := deployment.ManifestGroup()
+
+	builder := newManifestBuilder(c.log, c.settings, c.ns, ns, to, &group)
+	builder.rollback = obj.Annotations[akashRollbackAnnotationName]
 
+	obj, err = builder.update(obj)
+	if err != nil {
+		return err
+	}
+	if _, err := c.ac.AkashV1().Manifests(c.ns).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+		c.log.Error("transferring manifest", "err", err, "from", from, "to", to)
+		return err
+	}
 	return nil
 }
 
 func (c *client) TeardownLease(ctx context.Context, lid mtypes.LeaseID) error {
-	return c.kc.CoreV1().Namespaces().Delete(ctx, lidNS(lid), metav1.DeleteOptions{})
+	ns, err := c.leaseNS(ctx, lid)
+	if err != nil {
+		return err
+	}
+
+	obj, err := c.ac.AkashV1().Manifests(c.ns).Get(ctx, ns, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		if held, err := heldByLease(obj, lid); err != nil || !held {
+			// the namespace was transferred to another lease
+			return err
+		}
+		err = c.ac.AkashV1().Manifests(c.ns).Delete(ctx, ns, metav1.DeleteOptions{})
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	case !kerrors.IsNotFound(err):
+		return err
+	}
+	return c.kc.CoreV1().Namespaces().Delete(ctx, ns, metav1.DeleteOptions{})
 }
 
 func (c *client) ServiceLogs(ctx context.Context, lid mtypes.LeaseID,
 	tailLines int64, follow bool) ([]*cluster.ServiceLog, error) {
-	pods, err := c.kc.CoreV1().Pods(lidNS(lid)).List(ctx, metav1.ListOptions{})
+	ns, err := c.leaseNS(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := c.kc.CoreV1().Pods(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, errors.Wrap(err, ErrInternalError.Error())
 	}
 	streams := make([]*cluster.ServiceLog, len(pods.Items))
 	for i, pod := range pods.Items {
-		stream, err := c.kc.CoreV1().Pods(lidNS(lid)).GetLogs(pod.Name, &corev1.PodLogOptions{
+		stream, err := c.kc.CoreV1().Pods(ns).GetLogs(pod.Name, &corev1.PodLogOptions{
 			Follow:     follow,
 			TailLines:  &tailLines,
 			Timestamps: true,
@@ -216,7 +339,11 @@ func (c *client) ServiceLogs(ctx context.Context, lid mtypes.LeaseID,
 
 // todo: limit number of results and do pagination / streaming
 func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*cluster.LeaseStatus, error) {
-	deployments, err := c.deploymentsForLease(ctx, lid)
+	ns, err := c.leaseNS(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	deployments, err := c.deploymentsForLease(ctx, ns)
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, err
@@ -233,7 +360,7 @@ func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*cluster.
 		}
 		serviceStatus[deployment.Name] = status
 	}
-	ingress, err := c.kc.ExtensionsV1beta1().Ingresses(lidNS(lid)).List(ctx, metav1.ListOptions{})
+	ingress, err := c.kc.ExtensionsV1beta1().Ingresses(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, errors.Wrap(err, ErrInternalError.Error())
@@ -263,6 +390,16 @@ func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*cluster.
 		service.URIs = hosts
 	}
 	response := &cluster.LeaseStatus{}
+
+	mobj, err := c.ac.AkashV1().Manifests(c.ns).Get(ctx, ns, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		response.RollbackReason = mobj.Annotations[akashRollbackAnnotationName]
+	case !kerrors.IsNotFound(err):
+		c.log.Error(err.Error())
+		return nil, errors.Wrap(err, ErrInternalError.Error())
+	}
+
 	for _, status := range serviceStatus {
 		response.Services = append(response.Services, status)
 	}
@@ -270,7 +407,11 @@ func (c *client) LeaseStatus(ctx context.Context, lid mtypes.LeaseID) (*cluster.
 }
 
 func (c *client) ServiceStatus(ctx context.Context, lid mtypes.LeaseID, name string) (*cluster.ServiceStatus, error) {
-	deployment, err := c.kc.AppsV1().Deployments(lidNS(lid)).Get(ctx, name, metav1.GetOptions{})
+	ns, err := c.leaseNS(ctx, lid)
+	if err != nil {
+		return nil, err
+	}
+	deployment, err := c.kc.AppsV1().Deployments(ns).Get(ctx, name, metav1.GetOptions{})
 
 	if err != nil {
 		c.log.Error(err.Error())
@@ -404,8 +545,8 @@ func (c *client) nodeIsActive(node *corev1.Node) bool {
 	return ready && issues == 0
 }
 
-func (c *client) deploymentsForLease(ctx context.Context, lid mtypes.LeaseID) ([]appsv1.Deployment, error) {
-	deployments, err := c.kc.AppsV1().Deployments(lidNS(lid)).List(ctx, metav1.ListOptions{})
+func (c *client) deploymentsForLease(ctx context.Context, ns string) ([]appsv1.Deployment, error) {
+	deployments, err := c.kc.AppsV1().Deployments(ns).List(ctx, metav1.ListOptions{})
 	if err != nil {
 		c.log.Error(err.Error())
 		return nil, errors.Wrap(err, ErrInternalError.Error())
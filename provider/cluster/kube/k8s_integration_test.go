@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/testutil"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -27,9 +28,15 @@ func TestNewClient(t *testing.T) {
 		DeploymentIngressExposeLBHosts: false,
 	}
 
-	client, err := newClientWithSettings(testutil.Logger(t), "localhost", ns, settings)
+	client, err := newClientWithSettings(testutil.Logger(t), ns, settings)
 	require.NoError(t, err)
 
+	op := newTestOperator(t, client, settings, ns)
+
+	opctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go op.Run(opctx)
+
 	// check inventory
 	nodes, err := client.Inventory(ctx)
 	require.NoError(t, err)
@@ -63,8 +70,12 @@ func TestNewClient(t *testing.T) {
 
 	svcname := group.Services[0].Name
 
-	lstat, err := client.LeaseStatus(ctx, lid)
-	assert.NoError(t, err)
+	// the operator applies the lease's resources from its manifest
+	var lstat *cluster.LeaseStatus
+	require.Eventually(t, func() bool {
+		lstat, err = client.LeaseStatus(ctx, lid)
+		return err == nil
+	}, 30*time.Second, time.Second)
 	assert.Len(t, lstat.Services, 1)
 	assert.Equal(t, svcname, lstat.Services[0].Name)
 
@@ -102,3 +113,8 @@ func TestNewClient(t *testing.T) {
 	err = client.TeardownLease(ctx, lid)
 	assert.NoError(t, err)
 }
+
+func newTestOperator(t *testing.T, c Client, settings settings, ns string) *operator {
+	kc := c.(*client)
+	return newOperator(testutil.Logger(t), settings, kc.kc, kc.ac, "localhost", ns)
+}
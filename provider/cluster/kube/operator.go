@@ -0,0 +1,305 @@
+package kube
+
+import (
+	"context"
+	"time"
+
+	"github.com/caarlos0/env"
+	"github.com/pkg/errors"
+	"github.com/tendermint/tendermint/libs/log"
+	appsv1 "k8s.io/api/apps/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/ovrclk/akash/manifest"
+	akashv1 "github.com/ovrclk/akash/pkg/apis/akash.network/v1"
+	akashclient "github.com/ovrclk/akash/pkg/client/clientset/versioned"
+	akashinformers "github.com/ovrclk/akash/pkg/client/informers/externalversions"
+	akashlisters "github.com/ovrclk/akash/pkg/client/listers/akash.network/v1"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// operatorResyncPeriod is how often every manifest is reconciled, which
+// repairs drift that watches do not report
+const operatorResyncPeriod = 5 * time.Minute
+
+// ErrCacheSync is the error when the operator's informer caches fail to sync
+var ErrCacheSync = errors.New("kube: informer caches failed to sync")
+
+// Operator reconciles the namespaces, deployments, services and ingresses of
+// leases with the akashv1.Manifest objects written by Deploy
+type Operator interface {
+	Run(ctx context.Context) error
+}
+
+// NewOperator returns an Operator for the manifests of namespace ns
+func NewOperator(log log.Logger, host, ns string) (Operator, error) {
+	var settings settings
+	if err := env.Parse(&settings); err != nil {
+		return nil, err
+	}
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	config, err := openKubeConfig(log)
+	if err != nil {
+		return nil, errors.Wrap(err, "kube: error building config flags")
+	}
+
+	kc, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "kube: error creating kubernetes client")
+	}
+
+	ac, err := akashclient.NewForConfig(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "kube: error creating manifest client")
+	}
+
+	return newOperator(log, settings, kc, ac, host, ns), nil
+}
+
+type operator struct {
+	kc       kubernetes.Interface
+	ac       akashclient.Interface
+	ns       string
+	host     string
+	settings settings
+
+	afactory akashinformers.SharedInformerFactory
+	kfactory informers.SharedInformerFactory
+	lister   akashlisters.ManifestLister
+	queue    workqueue.RateLimitingInterface
+
+	log log.Logger
+}
+
+func newOperator(log log.Logger, settings settings, kc kubernetes.Interface, ac akashclient.Interface, host, ns string) *operator {
+	op := &operator{
+		kc:       kc,
+		ac:       ac,
+		ns:       ns,
+		host:     host,
+		settings: settings,
+		afactory: akashinformers.NewSharedInformerFactoryWithOptions(ac, operatorResyncPeriod,
+			akashinformers.WithNamespace(ns)),
+		kfactory: informers.NewSharedInformerFactoryWithOptions(kc, operatorResyncPeriod,
+			informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+				opts.LabelSelector = akashManagedLabelName + "=true"
+			})),
+		queue: workqueue.NewNamedRateLimitingQueue(workqueue.DefaultControllerRateLimiter(), "manifests"),
+		log:   log.With("module", "provider-cluster-kube-operator"),
+	}
+
+	minformer := op.afactory.Akash().V1().Manifests()
+	op.lister = minformer.Lister()
+
+	minformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: op.enqueueManifest,
+		UpdateFunc: func(prev, obj interface{}) {
+			pm, m := prev.(*akashv1.Manifest), obj.(*akashv1.Manifest)
+			// skip status updates; resyncs carry the same resource version
+			if pm.Generation != m.Generation || pm.ResourceVersion == m.ResourceVersion {
+				op.enqueueManifest(obj)
+			}
+		},
+		DeleteFunc: op.enqueueManifest,
+	})
+
+	// a lease's manifest and namespace share a name, so changes made to
+	// the deployments of a namespace are repaired by reconciling its manifest
+	op.kfactory.Apps().V1().Deployments().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(prev, obj interface{}) {
+			if prev.(*appsv1.Deployment).Generation != obj.(*appsv1.Deployment).Generation {
+				op.enqueueNamespace(obj)
+			}
+		},
+		DeleteFunc: op.enqueueNamespace,
+	})
+
+	return op
+}
+
+// Run reconciles manifests until the context is done
+func (op *operator) Run(ctx context.Context) error {
+	defer op.queue.ShutDown()
+
+	op.afactory.Start(ctx.Done())
+	op.kfactory.Start(ctx.Done())
+
+	for typ, ok := range op.afactory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return errors.Wrapf(ErrCacheSync, "%v", typ)
+		}
+	}
+	for typ, ok := range op.kfactory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return errors.Wrapf(ErrCacheSync, "%v", typ)
+		}
+	}
+
+	op.log.Info("reconciling manifests", "ns", op.ns)
+
+	go func() {
+		<-ctx.Done()
+		op.queue.ShutDown()
+	}()
+
+	for op.processNext(ctx) {
+	}
+
+	return ctx.Err()
+}
+
+func (op *operator) processNext(ctx context.Context) bool {
+	item, shutdown := op.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer op.queue.Done(item)
+
+	key := item.(string)
+	if err := op.reconcile(ctx, key); err != nil {
+		op.log.Error("reconciling manifest", "err", err, "key", key)
+		op.queue.AddRateLimited(key)
+		return true
+	}
+
+	op.queue.Forget(key)
+	return true
+}
+
+// reconcile applies the resources of the manifest with the given key and
+// records the outcome in its status. The namespace of a deleted manifest
+// is deleted along with everything in it.
+func (op *operator) reconcile(ctx context.Context, key string) error {
+	ns, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		return err
+	}
+
+	obj, err := op.lister.Manifests(ns).Get(name)
+	if kerrors.IsNotFound(err) {
+		op.log.Info("manifest deleted", "name", name)
+		err = op.kc.CoreV1().Namespaces().Delete(ctx, name, metav1.DeleteOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	deployment, err := obj.Deployment()
+	if err != nil {
+		// retrying does not fix an invalid spec; wait for the next update
+		return op.updateStatus(ctx, obj, akashv1.ManifestStatus{
+			State:   akashv1.ManifestStateFailed,
+			Message: err.Error(),
+		})
+	}
+
+	group := deployment.ManifestGroup()
+	derr := applyLease(ctx, op.kc, op.log, op.settings, op.host, obj.Name, deployment.LeaseID(), &group)
+
+	status := akashv1.ManifestStatus{State: akashv1.ManifestStateDeployed}
+	if derr != nil {
+		status = akashv1.ManifestStatus{State: akashv1.ManifestStateFailed, Message: derr.Error()}
+	}
+
+	if err := op.updateStatus(ctx, obj, status); err != nil {
+		return err
+	}
+	return derr
+}
+
+func (op *operator) updateStatus(ctx context.Context, obj *akashv1.Manifest, status akashv1.ManifestStatus) error {
+	if obj.Status == status {
+		return nil
+	}
+	obj = obj.DeepCopy()
+	obj.Status = status
+	_, err := op.ac.AkashV1().Manifests(obj.Namespace).UpdateStatus(ctx, obj, metav1.UpdateOptions{})
+	return err
+}
+
+func (op *operator) enqueueManifest(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		op.log.Error("manifest key", "err", err)
+		return
+	}
+	op.queue.Add(key)
+}
+
+func (op *operator) enqueueNamespace(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		op.log.Error("deployment key", "err", err)
+		return
+	}
+	ns, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		op.log.Error("deployment key", "err", err, "key", key)
+		return
+	}
+	op.queue.Add(op.ns + "/" + ns)
+}
+
+// applyLease creates or updates the namespace ns, and the deployments, services
+// and ingresses in it, of a lease and deletes those of services no longer in the group
+func applyLease(ctx context.Context, kc kubernetes.Interface, log log.Logger, settings settings, host string,
+	ns string, lid mtypes.LeaseID, group *manifest.Group) error {
+	if err := applyNS(ctx, kc, newNSBuilder(settings, ns, group)); err != nil {
+		log.Error("applying namespace", "err", err, "lease", lid)
+		return err
+	}
+
+	if err := cleanupStaleResources(ctx, kc, ns, group); err != nil {
+		log.Error("cleaning stale resources", "err", err, "lease", lid)
+		return err
+	}
+
+	for svcIdx := range group.Services {
+		service := &group.Services[svcIdx]
+		if err := applyDeployment(ctx, kc, newDeploymentBuilder(log, settings, ns, group, service)); err != nil {
+			log.Error("applying deployment", "err", err, "lease", lid, "service", service.Name)
+			return err
+		}
+
+		if len(service.Expose) == 0 {
+			log.Debug("no services", "lease", lid, "service", service.Name)
+			continue
+		}
+
+		if err := applyService(ctx, kc, newServiceBuilder(log, settings, ns, group, service)); err != nil {
+			log.Error("applying service", "err", err, "lease", lid, "service", service.Name)
+			return err
+		}
+
+		for expIdx := range service.Expose {
+			expose := &service.Expose[expIdx]
+			if !shouldExpose(expose) {
+				continue
+			}
+			if err := applyIngress(ctx, kc, newIngressBuilder(log, settings, host, ns, group, service, expose)); err != nil {
+				log.Error("applying ingress", "err", err, "lease", lid, "service", service.Name, "expose", expose)
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func shouldExpose(expose *manifest.ServiceExpose) bool {
+	return expose.Global &&
+		(expose.ExternalPort == 80 ||
+			(expose.ExternalPort == 0 && expose.Port == 80))
+}
@@ -0,0 +1,202 @@
+package kube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	kfake "k8s.io/client-go/kubernetes/fake"
+
+	akashv1 "github.com/ovrclk/akash/pkg/apis/akash.network/v1"
+	akashclient "github.com/ovrclk/akash/pkg/client/clientset/versioned"
+	afake "github.com/ovrclk/akash/pkg/client/clientset/versioned/fake"
+	"github.com/ovrclk/akash/testutil"
+)
+
+func TestOperatorReconcile(t *testing.T) {
+	const ns = "lease"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := testutil.Logger(t)
+	settings := settings{DeploymentServiceType: corev1.ServiceTypeClusterIP}
+
+	kc := kfake.NewSimpleClientset()
+	ac := afake.NewSimpleClientset()
+
+	lid := testutil.LeaseID(t)
+	group := testutil.AppManifestGenerator.Group(t)
+
+	// Deploy only writes the manifest
+	c := newTestClient(ctx, t, kc, ac, ns, settings)
+	require.NoError(t, c.Deploy(ctx, lid, &group))
+
+	_, err := kc.CoreV1().Namespaces().Get(ctx, lidNS(lid), metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+
+	op := newOperator(log, settings, kc, ac, "localhost", ns)
+	op.afactory.Start(ctx.Done())
+	op.afactory.WaitForCacheSync(ctx.Done())
+
+	key := ns + "/" + lidNS(lid)
+	require.NoError(t, op.reconcile(ctx, key))
+
+	_, err = kc.CoreV1().Namespaces().Get(ctx, lidNS(lid), metav1.GetOptions{})
+	require.NoError(t, err)
+
+	deployments, err := kc.AppsV1().Deployments(lidNS(lid)).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	require.Len(t, deployments.Items, len(group.Services))
+	assert.Equal(t, group.Services[0].Name, deployments.Items[0].Name)
+
+	obj, err := ac.AkashV1().Manifests(ns).Get(ctx, lidNS(lid), metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, akashv1.ManifestStateDeployed, obj.Status.State)
+
+	// deleted deployments are recreated
+	require.NoError(t, kc.AppsV1().Deployments(lidNS(lid)).Delete(ctx, group.Services[0].Name, metav1.DeleteOptions{}))
+	require.NoError(t, op.reconcile(ctx, key))
+	_, err = kc.AppsV1().Deployments(lidNS(lid)).Get(ctx, group.Services[0].Name, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	// the namespace of a deleted manifest is deleted
+	require.NoError(t, ac.AkashV1().Manifests(ns).Delete(ctx, lidNS(lid), metav1.DeleteOptions{}))
+	require.Eventually(t, func() bool {
+		_, err := op.lister.Manifests(ns).Get(lidNS(lid))
+		return kerrors.IsNotFound(err)
+	}, 5*time.Second, 10*time.Millisecond)
+
+	require.NoError(t, op.reconcile(ctx, key))
+	_, err = kc.CoreV1().Namespaces().Get(ctx, lidNS(lid), metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+
+	// reconciling again is a no-op
+	require.NoError(t, op.reconcile(ctx, key))
+}
+
+func TestClientTransferLease(t *testing.T) {
+	const ns = "lease"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	log := testutil.Logger(t)
+	settings := settings{DeploymentServiceType: corev1.ServiceTypeClusterIP}
+
+	kc := kfake.NewSimpleClientset()
+	ac := afake.NewSimpleClientset()
+
+	lid := testutil.LeaseID(t)
+	group := testutil.AppManifestGenerator.Group(t)
+
+	c := newTestClient(ctx, t, kc, ac, ns, settings)
+	require.NoError(t, c.Deploy(ctx, lid, &group))
+	require.NoError(t, c.Rollback(ctx, lid, &group, "unhealthy"))
+
+	op := newOperator(log, settings, kc, ac, "localhost", ns)
+	op.afactory.Start(ctx.Done())
+	op.afactory.WaitForCacheSync(ctx.Done())
+
+	key := ns + "/" + lidNS(lid)
+	require.NoError(t, op.reconcile(ctx, key))
+
+	to := lid
+	to.Owner = testutil.AccAddress(t)
+	require.NotEqual(t, lidNS(lid), lidNS(to))
+	require.NoError(t, c.TransferLease(ctx, lid, to))
+
+	// the manifest keeps its name and rollback reason under the new lease
+	obj, err := ac.AkashV1().Manifests(ns).Get(ctx, lidNS(lid), metav1.GetOptions{})
+	require.NoError(t, err)
+	deployment, err := obj.Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, to, deployment.LeaseID())
+	assert.Equal(t, to.Owner.String(), obj.Labels[akashLeaseOwnerLabelName])
+	assert.Equal(t, "unhealthy", obj.Annotations[akashRollbackAnnotationName])
+
+	_, err = ac.AkashV1().Manifests(ns).Get(ctx, lidNS(to), metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+
+	// transferring again is a no-op
+	require.NoError(t, c.TransferLease(ctx, lid, to))
+
+	// the new lease is served from the original namespace
+	require.Eventually(t, func() bool {
+		obj, err := op.lister.Manifests(ns).Get(lidNS(lid))
+		return err == nil && obj.Labels[akashLeaseOwnerLabelName] == to.Owner.String()
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, op.reconcile(ctx, key))
+
+	_, err = c.ServiceStatus(ctx, to, group.Services[0].Name)
+	require.NoError(t, err)
+
+	require.NoError(t, c.Deploy(ctx, to, &group))
+	manifests, err := ac.AkashV1().Manifests(ns).List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Len(t, manifests.Items, 1)
+
+	// cached leases are found without asking the API server
+	require.Eventually(t, func() bool {
+		cached, err := c.manifests.Manifests(ns).Get(lidNS(lid))
+		return err == nil && cached.Labels[akashLeaseOwnerLabelName] == to.Owner.String()
+	}, 5*time.Second, 10*time.Millisecond)
+	ac.ClearActions()
+	leaseNS, err := c.leaseNS(ctx, to)
+	require.NoError(t, err)
+	assert.Equal(t, lidNS(lid), leaseNS)
+	assert.Empty(t, ac.Actions())
+
+	// a lease created again with the transferred ID gets a namespace of its own
+	require.NoError(t, c.Deploy(ctx, lid, &group))
+	require.NoError(t, c.Rollback(ctx, lid, &group, "unhealthy"))
+
+	obj, err = ac.AkashV1().Manifests(ns).Get(ctx, lidNS(lid), metav1.GetOptions{})
+	require.NoError(t, err)
+	deployment, err = obj.Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, to, deployment.LeaseID())
+	assert.Empty(t, obj.Annotations[akashRollbackAnnotationName])
+
+	require.NotEqual(t, lidNS(lid), lidNSN(lid, 1))
+	obj, err = ac.AkashV1().Manifests(ns).Get(ctx, lidNSN(lid, 1), metav1.GetOptions{})
+	require.NoError(t, err)
+	deployment, err = obj.Deployment()
+	require.NoError(t, err)
+	assert.Equal(t, lid, deployment.LeaseID())
+	assert.Equal(t, "unhealthy", obj.Annotations[akashRollbackAnnotationName])
+
+	// a manifest of another lease is not written over
+	err = applyManifest(ctx, ac, newManifestBuilder(log, settings, ns, lidNS(lid), lid, &group))
+	assert.Equal(t, ErrNamespaceHeld, err)
+
+	require.Eventually(t, func() bool {
+		_, err := op.lister.Manifests(ns).Get(lidNSN(lid, 1))
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, op.reconcile(ctx, ns+"/"+lidNSN(lid, 1)))
+
+	require.NoError(t, c.TeardownLease(ctx, lid))
+	_, err = ac.AkashV1().Manifests(ns).Get(ctx, lidNSN(lid, 1), metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+	_, err = ac.AkashV1().Manifests(ns).Get(ctx, lidNS(lid), metav1.GetOptions{})
+	require.NoError(t, err)
+
+	require.NoError(t, c.TeardownLease(ctx, to))
+	_, err = kc.CoreV1().Namespaces().Get(ctx, lidNS(lid), metav1.GetOptions{})
+	assert.True(t, kerrors.IsNotFound(err))
+}
+
+func newTestClient(ctx context.Context, t *testing.T, kc kubernetes.Interface, ac akashclient.Interface,
+	ns string, settings settings) *client {
+	t.Helper()
+	manifests, err := newManifestLister(ctx, ac, ns)
+	require.NoError(t, err)
+	return &client{kc: kc, ac: ac, ns: ns, settings: settings, log: testutil.Logger(t), manifests: manifests}
+}
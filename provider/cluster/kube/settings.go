@@ -3,6 +3,7 @@ package kube
 import (
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // settings configures k8s object generation such that it is customized to the
@@ -20,6 +21,12 @@ type settings struct {
 	DeploymentIngressDomain string `env:"AKASH_DEPLOYMENT_INGRESS_DOMAIN"`
 
 	DeploymentIngressExposeLBHosts bool `env:"AKASH_DEPLOYMENT_INGRESS_EXPOSE_LB_HOSTS" envDefault:"true"`
+
+	// Rolling update strategy of service deployments: the number or percentage of replicas
+	// created above, and taken down below, the desired count while an update rolls out.
+	// The defaults keep every working replica until its replacement is available.
+	DeploymentMaxSurge       string `env:"AKASH_DEPLOYMENT_MAX_SURGE" envDefault:"25%"`
+	DeploymentMaxUnavailable string `env:"AKASH_DEPLOYMENT_MAX_UNAVAILABLE" envDefault:"0"`
 }
 
 var errSettingsValidation = errors.New("settings validation")
@@ -28,5 +35,28 @@ func validateSettings(settings settings) error {
 	if settings.DeploymentIngressStaticHosts && settings.DeploymentIngressDomain == "" {
 		return errors.Wrap(errSettingsValidation, "empty ingress domain")
 	}
+	if err := validateIntOrPercent(settings.DeploymentMaxSurge); err != nil {
+		return errors.Wrapf(errSettingsValidation, "max surge: %v", err)
+	}
+	if err := validateIntOrPercent(settings.DeploymentMaxUnavailable); err != nil {
+		return errors.Wrapf(errSettingsValidation, "max unavailable: %v", err)
+	}
+	return nil
+}
+
+// validateIntOrPercent checks that an empty value, or one parsed by intstr.Parse,
+// is a non-negative count or percentage
+func validateIntOrPercent(value string) error {
+	if value == "" {
+		return nil
+	}
+	val := intstr.Parse(value)
+	n, err := intstr.GetValueFromIntOrPercent(&val, 100, false)
+	if err != nil {
+		return err
+	}
+	if n < 0 {
+		return errors.Errorf("negative value %v", value)
+	}
 	return nil
 }
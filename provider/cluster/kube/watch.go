@@ -0,0 +1,202 @@
+package kube
+
+import (
+	"context"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+
+	akashclient "github.com/ovrclk/akash/pkg/client/clientset/versioned"
+	akashinformers "github.com/ovrclk/akash/pkg/client/informers/externalversions"
+	akashlisters "github.com/ovrclk/akash/pkg/client/listers/akash.network/v1"
+	"github.com/ovrclk/akash/provider/event"
+	"github.com/ovrclk/akash/pubsub"
+)
+
+// watchBufferSize is the number of changes buffered for a slow reader;
+// further changes are dropped until it catches up
+const watchBufferSize = 100
+
+// Watch reports changes to the deployments, pods and warning events of lease
+// namespaces and to the capacity of nodes as they happen
+func (c *client) Watch(ctx context.Context) (<-chan pubsub.Event, error) {
+	w := newWatcher(c.log, c.kc, c.ac, c.ns)
+	return w.run(ctx)
+}
+
+type watcher struct {
+	ns string
+
+	// deployments and pods of leases
+	lfactory informers.SharedInformerFactory
+	// nodes and warning events
+	cfactory informers.SharedInformerFactory
+	// manifests, which map lease namespaces to leases
+	afactory  akashinformers.SharedInformerFactory
+	manifests akashlisters.ManifestLister
+
+	ch     chan pubsub.Event
+	mtx    sync.Mutex
+	closed bool
+
+	log log.Logger
+}
+
+func newWatcher(log log.Logger, kc kubernetes.Interface, ac akashclient.Interface, ns string) *watcher {
+	w := &watcher{
+		ns: ns,
+		lfactory: informers.NewSharedInformerFactoryWithOptions(kc, 0,
+			informers.WithTweakListOptions(func(opts *metav1.ListOptions) {
+				opts.LabelSelector = akashManagedLabelName + "=true"
+			})),
+		cfactory: informers.NewSharedInformerFactory(kc, 0),
+		afactory: akashinformers.NewSharedInformerFactoryWithOptions(ac, 0, akashinformers.WithNamespace(ns)),
+		ch:       make(chan pubsub.Event, watchBufferSize),
+		log:      log.With("cmp", "watcher"),
+	}
+
+	w.manifests = w.afactory.Akash().V1().Manifests().Lister()
+
+	leaseHandler := cache.ResourceEventHandlerFuncs{
+		AddFunc: w.leaseChanged,
+		UpdateFunc: func(prev, obj interface{}) {
+			if prev.(metav1.Object).GetResourceVersion() != obj.(metav1.Object).GetResourceVersion() {
+				w.leaseChanged(obj)
+			}
+		},
+		DeleteFunc: w.leaseChanged,
+	}
+
+	w.lfactory.Apps().V1().Deployments().Informer().AddEventHandler(leaseHandler)
+	w.lfactory.Core().V1().Pods().Informer().AddEventHandler(leaseHandler)
+
+	w.cfactory.Core().V1().Events().Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: func(obj interface{}) bool {
+			ev, ok := obj.(*corev1.Event)
+			return ok && ev.Type == corev1.EventTypeWarning
+		},
+		Handler: cache.ResourceEventHandlerFuncs{
+			AddFunc: w.leaseChanged,
+			UpdateFunc: func(_, obj interface{}) {
+				w.leaseChanged(obj)
+			},
+		},
+	})
+
+	w.cfactory.Core().V1().Nodes().Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: w.inventoryChanged,
+		UpdateFunc: func(prev, obj interface{}) {
+			// node status heartbeats do not change capacity
+			if nodeCapacityChanged(prev.(*corev1.Node), obj.(*corev1.Node)) {
+				w.inventoryChanged(obj)
+			}
+		},
+		DeleteFunc: w.inventoryChanged,
+	})
+
+	return w
+}
+
+func (w *watcher) run(ctx context.Context) (<-chan pubsub.Event, error) {
+	w.afactory.Start(ctx.Done())
+	w.lfactory.Start(ctx.Done())
+	w.cfactory.Start(ctx.Done())
+
+	// manifests must be known to map changes to leases
+	for typ, ok := range w.afactory.WaitForCacheSync(ctx.Done()) {
+		if !ok {
+			return nil, ErrCacheSync
+		}
+		w.log.Debug("cache synced", "type", typ)
+	}
+
+	go func() {
+		<-ctx.Done()
+		w.mtx.Lock()
+		defer w.mtx.Unlock()
+		w.closed = true
+		close(w.ch)
+	}()
+
+	return w.ch, nil
+}
+
+// leaseChanged publishes a change of the lease whose namespace holds obj
+func (w *watcher) leaseChanged(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+
+	mobj, ok := obj.(metav1.Object)
+	if !ok {
+		return
+	}
+
+	// a lease's manifest and namespace share a name
+	manifest, err := w.manifests.Manifests(w.ns).Get(mobj.GetNamespace())
+	if err != nil {
+		return
+	}
+
+	deployment, err := manifest.Deployment()
+	if err != nil {
+		w.log.Error("invalid manifest", "err", err, "name", manifest.Name)
+		return
+	}
+
+	w.send(event.ClusterLeaseChanged{LeaseID: deployment.LeaseID()})
+}
+
+func (w *watcher) inventoryChanged(_ interface{}) {
+	w.send(event.ClusterInventoryChanged{})
+}
+
+func (w *watcher) send(ev pubsub.Event) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.closed {
+		return
+	}
+
+	select {
+	case w.ch <- ev:
+	default:
+		w.log.Debug("dropping cluster change", "event", ev)
+	}
+}
+
+// nodeCapacityChanged returns true if a node's allocatable resources,
+// schedulability or condition statuses differ
+func nodeCapacityChanged(prev, node *corev1.Node) bool {
+	if prev.Spec.Unschedulable != node.Spec.Unschedulable {
+		return true
+	}
+
+	if len(prev.Status.Allocatable) != len(node.Status.Allocatable) {
+		return true
+	}
+	for name, quantity := range node.Status.Allocatable {
+		pquantity, ok := prev.Status.Allocatable[name]
+		if !ok || pquantity.Cmp(quantity) != 0 {
+			return true
+		}
+	}
+
+	conditions := make(map[corev1.NodeConditionType]corev1.ConditionStatus, len(prev.Status.Conditions))
+	for _, cond := range prev.Status.Conditions {
+		conditions[cond.Type] = cond.Status
+	}
+	for _, cond := range node.Status.Conditions {
+		if conditions[cond.Type] != cond.Status {
+			return true
+		}
+	}
+
+	return false
+}
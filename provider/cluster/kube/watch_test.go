@@ -0,0 +1,107 @@
+package kube
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kfake "k8s.io/client-go/kubernetes/fake"
+
+	afake "github.com/ovrclk/akash/pkg/client/clientset/versioned/fake"
+	"github.com/ovrclk/akash/provider/event"
+	"github.com/ovrclk/akash/pubsub"
+	"github.com/ovrclk/akash/testutil"
+)
+
+func TestWatch(t *testing.T) {
+	const ns = "lease"
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	kc := kfake.NewSimpleClientset()
+	ac := afake.NewSimpleClientset()
+
+	lid := testutil.LeaseID(t)
+	group := testutil.AppManifestGenerator.Group(t)
+
+	c := newTestClient(ctx, t, kc, ac, ns, settings{})
+	require.NoError(t, c.Deploy(ctx, lid, &group))
+
+	ch, err := c.Watch(ctx)
+	require.NoError(t, err)
+
+	// changes to the workloads of a lease are reported for the lease
+	_, err = kc.AppsV1().Deployments(lidNS(lid)).Create(ctx, &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   group.Services[0].Name,
+			Labels: map[string]string{akashManagedLabelName: "true"},
+		},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	ev := nextEvent(t, ch)
+	require.IsType(t, event.ClusterLeaseChanged{}, ev)
+	assert.Equal(t, lid, ev.(event.ClusterLeaseChanged).LeaseID)
+
+	// new nodes change the inventory
+	_, err = kc.CoreV1().Nodes().Create(ctx, &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node"},
+	}, metav1.CreateOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, event.ClusterInventoryChanged{}, nextEvent(t, ch))
+
+	// the stream is closed with the context
+	cancel()
+	require.Eventually(t, func() bool {
+		_, ok := <-ch
+		return !ok
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestNodeCapacityChanged(t *testing.T) {
+	node := &corev1.Node{
+		Status: corev1.NodeStatus{
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceCPU: resource.MustParse("2"),
+			},
+			Conditions: []corev1.NodeCondition{
+				{Type: corev1.NodeReady, Status: corev1.ConditionTrue},
+			},
+		},
+	}
+
+	heartbeat := node.DeepCopy()
+	heartbeat.Status.Conditions[0].LastHeartbeatTime = metav1.Now()
+	assert.False(t, nodeCapacityChanged(node, heartbeat))
+
+	allocatable := node.DeepCopy()
+	allocatable.Status.Allocatable[corev1.ResourceCPU] = resource.MustParse("4")
+	assert.True(t, nodeCapacityChanged(node, allocatable))
+
+	notReady := node.DeepCopy()
+	notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+	assert.True(t, nodeCapacityChanged(node, notReady))
+
+	cordoned := node.DeepCopy()
+	cordoned.Spec.Unschedulable = true
+	assert.True(t, nodeCapacityChanged(node, cordoned))
+}
+
+func nextEvent(t *testing.T, ch <-chan pubsub.Event) pubsub.Event {
+	t.Helper()
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "timed out waiting for event")
+		return nil
+	}
+}
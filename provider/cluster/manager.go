@@ -24,6 +24,12 @@ const (
 	dsTeardownComplete deploymentState = "teardown-complete"
 )
 
+// transferRequest moves a deployment manager to lease; donech is closed once it has
+type transferRequest struct {
+	lease  mtypes.LeaseID
+	donech chan<- struct{}
+}
+
 type deploymentManager struct {
 	bus     pubsub.Bus
 	client  Client
@@ -33,12 +39,22 @@ type deploymentManager struct {
 
 	lease  mtypes.LeaseID
 	mgroup *manifest.Group
+	// previous is the last revision found healthy; nil until one is
+	previous *manifest.Group
+	// rollback is why mgroup was rolled back to; empty if it was not
+	rollback string
+	// transferFrom is the lease the deployment runs under in the cluster
+	// until its transfer to lease is deployed; nil if it was not transferred
+	transferFrom *mtypes.LeaseID
 
 	monitor *deploymentMonitor
 	wg      sync.WaitGroup
 
 	updatech   chan *manifest.Group
 	teardownch chan struct{}
+	deployedch chan *manifest.Group
+	rollbackch chan string
+	transferch chan transferRequest
 
 	log log.Logger
 	lc  lifecycle.Lifecycle
@@ -57,6 +73,9 @@ func newDeploymentManager(s *service, lease mtypes.LeaseID, mgroup *manifest.Gro
 		wg:         sync.WaitGroup{},
 		updatech:   make(chan *manifest.Group),
 		teardownch: make(chan struct{}),
+		deployedch: make(chan *manifest.Group),
+		rollbackch: make(chan string),
+		transferch: make(chan transferRequest),
 		log:        log,
 		lc:         lifecycle.New(),
 	}
@@ -90,6 +109,17 @@ func (dm *deploymentManager) teardown() error {
 	}
 }
 
+func (dm *deploymentManager) transfer(lease mtypes.LeaseID) error {
+	donech := make(chan struct{})
+	select {
+	case dm.transferch <- transferRequest{lease: lease, donech: donech}:
+		<-donech
+		return nil
+	case <-dm.lc.ShuttingDown():
+		return ErrNotRunning
+	}
+}
+
 func (dm *deploymentManager) run() {
 	defer dm.lc.ShutdownCompleted()
 	runch := dm.startDeploy()
@@ -104,6 +134,7 @@ loop:
 
 		case mgroup := <-dm.updatech:
 			dm.mgroup = mgroup
+			dm.rollback = ""
 
 			switch dm.state {
 			case dsDeployActive:
@@ -119,6 +150,41 @@ loop:
 				// do nothing
 			}
 
+		case req := <-dm.transferch:
+			dm.log.Info("deployment transferred", "lease", req.lease)
+			if dm.transferFrom == nil {
+				from := dm.lease
+				dm.transferFrom = &from
+			}
+			healthcheckFailures.DeleteLabelValues(leaseLabel(dm.lease), dm.mgroup.Name)
+			dm.lease = req.lease
+			dm.log = dm.log.With("lease", req.lease)
+			close(req.donech)
+
+			switch dm.state {
+			case dsDeployActive:
+				dm.state = dsDeployPending
+			case dsDeployPending:
+			case dsDeployComplete:
+				// move the running deployment to the new lease
+				runch = dm.startDeploy()
+			case dsTeardownActive, dsTeardownPending, dsTeardownComplete:
+				// do nothing
+			}
+
+		case mgroup := <-dm.deployedch:
+			// the revision becomes the one rolled back to if an update fails
+			dm.previous = mgroup
+
+		case reason := <-dm.rollbackch:
+			if dm.state != dsDeployComplete || dm.previous == nil {
+				break
+			}
+			dm.log.Error("rolling back to previous revision", "reason", reason)
+			dm.mgroup = dm.previous
+			dm.rollback = reason
+			runch = dm.startDeploy()
+
 		case result := <-runch:
 			runch = nil
 			if result != nil {
@@ -127,6 +193,9 @@ loop:
 			switch dm.state {
 			case dsDeployActive:
 				dm.log.Debug("deploy complete")
+				if result == nil {
+					dm.transferFrom = nil
+				}
 				dm.state = dsDeployComplete
 				dm.startMonitor()
 			case dsDeployPending:
@@ -165,11 +234,19 @@ loop:
 	}
 
 	dm.wg.Wait()
+
+	healthcheckFailures.DeleteLabelValues(leaseLabel(dm.lease), dm.mgroup.Name)
 }
 
 func (dm *deploymentManager) startMonitor() {
+	monitor, err := newDeploymentMonitor(dm)
+	if err != nil {
+		dm.log.Error("starting deployment monitor", "err", err)
+		return
+	}
+
 	dm.wg.Add(1)
-	dm.monitor = newDeploymentMonitor(dm)
+	dm.monitor = monitor
 	go func(m *deploymentMonitor) {
 		defer dm.wg.Done()
 		<-m.done()
@@ -185,25 +262,41 @@ func (dm *deploymentManager) stopMonitor() {
 func (dm *deploymentManager) startDeploy() <-chan error {
 	dm.stopMonitor()
 	dm.state = dsDeployActive
-	return dm.do(dm.doDeploy)
+	return dm.do(instrumentDeploy(actionDeploy, dm.doDeploy))
 }
 
 func (dm *deploymentManager) startTeardown() <-chan error {
 	dm.stopMonitor()
 	dm.state = dsTeardownActive
-	return dm.do(dm.doTeardown)
+	return dm.do(instrumentDeploy(actionTeardown, dm.doTeardown))
 }
 
 func (dm *deploymentManager) doDeploy() error {
 	ctx := context.Background() // TODO: refactor management
+	if err := dm.doTransfer(ctx); err != nil {
+		return err
+	}
+	if dm.rollback != "" {
+		return dm.client.Rollback(ctx, dm.lease, dm.mgroup, dm.rollback)
+	}
 	return dm.client.Deploy(ctx, dm.lease, dm.mgroup)
 }
 
 func (dm *deploymentManager) doTeardown() error {
 	ctx := context.Background() // TODO: refactor management
+	if err := dm.doTransfer(ctx); err != nil {
+		return err
+	}
 	return dm.client.TeardownLease(ctx, dm.lease)
 }
 
+func (dm *deploymentManager) doTransfer(ctx context.Context) error {
+	if dm.transferFrom == nil {
+		return nil
+	}
+	return dm.client.TransferLease(ctx, *dm.transferFrom, dm.lease)
+}
+
 func (dm *deploymentManager) do(fn func() error) <-chan error {
 	ch := make(chan error, 1)
 	go func() {
@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	atypes "github.com/ovrclk/akash/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+var (
+	reservationsGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "akash_provider_reservations",
+		Help: "Reservations by state: pending reservations are not yet deployed",
+	}, []string{"state"})
+
+	reservedCPU = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "akash_provider_reserved_cpu_millis",
+		Help: "CPU reserved for orders and leases, in thousandths of a CPU",
+	}, []string{"state"})
+
+	reservedMemory = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "akash_provider_reserved_memory_bytes",
+		Help: "Memory reserved for orders and leases",
+	}, []string{"state"})
+
+	reservedStorage = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "akash_provider_reserved_storage_bytes",
+		Help: "Storage reserved for orders and leases",
+	}, []string{"state"})
+
+	deployDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "akash_provider_deploy_duration_seconds",
+		Help:    "Time taken to deploy or tear down a lease on the cluster",
+		Buckets: prometheus.ExponentialBuckets(0.1, 2, 12),
+	}, []string{"action"})
+
+	deployFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "akash_provider_deploy_failures_total",
+		Help: "Failed attempts to deploy or tear down a lease on the cluster",
+	}, []string{"action"})
+
+	healthcheckFailures = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "akash_provider_health_check_failures_total",
+		Help: "Failed health checks of the deployments of active leases",
+	}, []string{"lease", "group"})
+)
+
+const (
+	reservationPending   = "pending"
+	reservationAllocated = "allocated"
+
+	actionDeploy   = "deploy"
+	actionTeardown = "teardown"
+)
+
+// observeReservations sets the reservation gauges from the inventory status
+func observeReservations(status InventoryStatus) {
+	observeReserved(reservationPending, status.Pending)
+	observeReserved(reservationAllocated, status.Active)
+}
+
+func observeReserved(state string, units []atypes.Unit) {
+	total := atypes.Unit{}
+	for _, unit := range units {
+		total.CPU += unit.CPU
+		total.Memory += unit.Memory
+		total.Storage += unit.Storage
+	}
+
+	reservationsGauge.WithLabelValues(state).Set(float64(len(units)))
+	reservedCPU.WithLabelValues(state).Set(float64(total.CPU))
+	reservedMemory.WithLabelValues(state).Set(float64(total.Memory))
+	reservedStorage.WithLabelValues(state).Set(float64(total.Storage))
+}
+
+// instrumentDeploy records the duration and failures of the cluster action fn
+func instrumentDeploy(action string, fn func() error) func() error {
+	return func() error {
+		start := time.Now()
+		err := fn()
+		deployDuration.WithLabelValues(action).Observe(time.Since(start).Seconds())
+		if err != nil {
+			deployFailures.WithLabelValues(action).Inc()
+		}
+		return err
+	}
+}
+
+func leaseLabel(lease mtypes.LeaseID) string {
+	return mtypes.BidIDString(lease.BidID())
+}
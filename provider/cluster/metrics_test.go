@@ -0,0 +1,44 @@
+package cluster
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+
+	atypes "github.com/ovrclk/akash/types"
+)
+
+func TestMetrics_observeReservations(t *testing.T) {
+	observeReservations(InventoryStatus{
+		Pending: []atypes.Unit{
+			{CPU: 100, Memory: 1024, Storage: 2048},
+			{CPU: 200, Memory: 1024, Storage: 2048},
+		},
+		Active: []atypes.Unit{
+			{CPU: 500, Memory: 4096, Storage: 8192},
+		},
+	})
+
+	assert.Equal(t, float64(2), testutil.ToFloat64(reservationsGauge.WithLabelValues(reservationPending)))
+	assert.Equal(t, float64(300), testutil.ToFloat64(reservedCPU.WithLabelValues(reservationPending)))
+	assert.Equal(t, float64(2048), testutil.ToFloat64(reservedMemory.WithLabelValues(reservationPending)))
+	assert.Equal(t, float64(1), testutil.ToFloat64(reservationsGauge.WithLabelValues(reservationAllocated)))
+	assert.Equal(t, float64(8192), testutil.ToFloat64(reservedStorage.WithLabelValues(reservationAllocated)))
+
+	observeReservations(InventoryStatus{})
+	assert.Equal(t, float64(0), testutil.ToFloat64(reservationsGauge.WithLabelValues(reservationPending)))
+	assert.Equal(t, float64(0), testutil.ToFloat64(reservedCPU.WithLabelValues(reservationAllocated)))
+}
+
+func TestMetrics_instrumentDeploy(t *testing.T) {
+	failures := testutil.ToFloat64(deployFailures.WithLabelValues(actionTeardown))
+
+	assert.NoError(t, instrumentDeploy(actionTeardown, func() error { return nil })())
+	assert.Equal(t, failures, testutil.ToFloat64(deployFailures.WithLabelValues(actionTeardown)))
+
+	err := errors.New("oops") // nolint: goerr113
+	assert.Equal(t, err, instrumentDeploy(actionTeardown, func() error { return err })())
+	assert.Equal(t, failures+1, testutil.ToFloat64(deployFailures.WithLabelValues(actionTeardown)))
+}
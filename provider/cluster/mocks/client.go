@@ -11,6 +11,8 @@ import (
 
 	mock "github.com/stretchr/testify/mock"
 
+	pubsub "github.com/ovrclk/akash/pubsub"
+
 	types "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -102,6 +104,20 @@ func (_m *Client) LeaseStatus(_a0 context.Context, _a1 types.LeaseID) (*cluster.
 	return r0, r1
 }
 
+// Rollback provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *Client) Rollback(_a0 context.Context, _a1 types.LeaseID, _a2 *manifest.Group, _a3 string) error {
+	ret := _m.Called(_a0, _a1, _a2, _a3)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, *manifest.Group, string) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ServiceLogs provides a mock function with given fields: _a0, _a1, _a2, _a3
 func (_m *Client) ServiceLogs(_a0 context.Context, _a1 types.LeaseID, _a2 int64, _a3 bool) ([]*cluster.ServiceLog, error) {
 	ret := _m.Called(_a0, _a1, _a2, _a3)
@@ -161,3 +177,40 @@ func (_m *Client) TeardownLease(_a0 context.Context, _a1 types.LeaseID) error {
 
 	return r0
 }
+
+// TransferLease provides a mock function with given fields: _a0, _a1, _a2
+func (_m *Client) TransferLease(_a0 context.Context, _a1 types.LeaseID, _a2 types.LeaseID) error {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, types.LeaseID, types.LeaseID) error); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Watch provides a mock function with given fields: _a0
+func (_m *Client) Watch(_a0 context.Context) (<-chan pubsub.Event, error) {
+	ret := _m.Called(_a0)
+
+	var r0 <-chan pubsub.Event
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan pubsub.Event); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan pubsub.Event)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
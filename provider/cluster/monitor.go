@@ -2,7 +2,10 @@ package cluster
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
+	"reflect"
+	"strings"
 	"time"
 
 	lifecycle "github.com/boz/go-lifecycle"
@@ -16,42 +19,61 @@ import (
 )
 
 const (
-	monitorMaxRetries        = 20
-	monitorRetryPeriodMin    = time.Second * 2
-	monitorRetryPeriodJitter = time.Second * 5
-
-	monitorHealthcheckPeriodMin    = time.Second * 10
-	monitorHealthcheckPeriodJitter = time.Second * 5
+	// monitorDeployTimeout is how long a deployment may stay unhealthy before it is rolled
+	// back to the previous healthy revision, or its lease closed if there is none
+	monitorDeployTimeout = time.Second * 90
+
+	// deployments are checked whenever the cluster reports a change to their lease;
+	// periodic health checks catch changes that were not reported
+	monitorHealthcheckPeriodMin    = time.Minute
+	monitorHealthcheckPeriodJitter = time.Second * 30
 )
 
 type deploymentMonitor struct {
 	bus     pubsub.Bus
+	sub     pubsub.Subscriber
 	session session.Session
 	client  Client
 
 	lease  mtypes.LeaseID
 	mgroup *manifest.Group
+	// previous is the last revision found healthy; nil if none
+	previous *manifest.Group
+
+	deployedch chan<- *manifest.Group
+	rollbackch chan<- string
 
 	attempts int
 	log      log.Logger
 	lc       lifecycle.Lifecycle
 }
 
-func newDeploymentMonitor(dm *deploymentManager) *deploymentMonitor {
+func newDeploymentMonitor(dm *deploymentManager) (*deploymentMonitor, error) {
+	sub, err := dm.bus.Subscribe()
+	if err != nil {
+		return nil, err
+	}
+
 	m := &deploymentMonitor{
 		bus:     dm.bus,
+		sub:     sub,
 		session: dm.session,
 		client:  dm.client,
 		lease:   dm.lease,
 		mgroup:  dm.mgroup,
-		log:     dm.log.With("cmp", "deployment-monitor"),
+
+		previous:   dm.previous,
+		deployedch: dm.deployedch,
+		rollbackch: dm.rollbackch,
+
+		log: dm.log.With("cmp", "deployment-monitor"),
 		lc:      lifecycle.New(),
 	}
 
 	go m.lc.WatchChannel(dm.lc.ShuttingDown())
 	go m.run()
 
-	return m
+	return m, nil
 }
 
 func (m *deploymentMonitor) shutdown() {
@@ -64,13 +86,35 @@ func (m *deploymentMonitor) done() <-chan struct{} {
 
 func (m *deploymentMonitor) run() {
 	defer m.lc.ShutdownCompleted()
+	defer m.sub.Close()
 
 	var (
-		runch   <-chan runner.Result
-		closech <-chan runner.Result
+		runch      <-chan runner.Result
+		closech    <-chan runner.Result
+		tickch     <-chan time.Time
+		deadlinech <-chan time.Time
+
+		// recheck is set when a change arrives while a check is running
+		recheck bool
+		// expired is set once the deployment was unhealthy for monitorDeployTimeout
+		expired bool
+		// closing is set once the lease is being closed
+		closing bool
 	)
 
-	tickch := m.scheduleRetry()
+	check := func() {
+		if closing {
+			return
+		}
+		if runch != nil {
+			recheck = true
+			return
+		}
+		tickch = nil
+		runch = m.runCheck()
+	}
+
+	check()
 
 loop:
 	for {
@@ -80,38 +124,72 @@ loop:
 			m.lc.ShutdownInitiated(err)
 			break loop
 
+		case ev := <-m.sub.Events():
+			if ev, ok := ev.(event.ClusterLeaseChanged); ok && ev.LeaseID.Equals(m.lease) {
+				check()
+			}
+
 		case <-tickch:
-			tickch = nil
-			runch = m.runCheck()
+			check()
+
+		case <-deadlinech:
+			deadlinech = nil
+			expired = true
+			check()
 
 		case result := <-runch:
 			runch = nil
 
+			reason, _ := result.Value().(string)
 			if err := result.Error(); err != nil {
 				m.log.Error("monitor check", "err", err)
+				reason = err.Error()
 			}
 
-			ok := result.Value().(bool)
+			ok := reason == ""
 
 			m.log.Info("check result", "ok", ok, "attempt", m.attempts)
 
 			if ok {
 				// healthy
 				m.attempts = 0
+				deadlinech = nil
+				expired = false
+				recheck = false
 				tickch = m.scheduleHealthcheck()
 				m.publishStatus(event.ClusterDeploymentDeployed)
+				m.notifyDeployed()
 				break
 			}
 
 			m.publishStatus(event.ClusterDeploymentPending)
+			healthcheckFailures.WithLabelValues(leaseLabel(m.lease), m.mgroup.Name).Inc()
+
+			if recheck {
+				recheck = false
+				check()
+				break
+			}
+
+			if !expired {
+				// unhealthy.  wait for changes until the deadline
+				if deadlinech == nil {
+					deadlinech = time.After(monitorDeployTimeout)
+				}
+				tickch = m.scheduleHealthcheck()
+				break
+			}
 
-			if m.attempts <= monitorMaxRetries {
-				// unhealthy.  retry
-				tickch = m.scheduleRetry()
+			closing = true
+
+			if m.previous != nil && !reflect.DeepEqual(m.previous, m.mgroup) {
+				// keep the lease running on the revision that worked
+				m.log.Error("deployment failed.  rolling back.", "reason", reason)
+				m.notifyRollback(reason)
 				break
 			}
 
-			m.log.Error("deployment failed.  closing lease.")
+			m.log.Error("deployment failed.  closing lease.", "reason", reason)
 			closech = m.runCloseLease()
 
 		case <-closech:
@@ -136,16 +214,17 @@ func (m *deploymentMonitor) runCheck() <-chan runner.Result {
 	})
 }
 
-func (m *deploymentMonitor) doCheck() (bool, error) {
+// doCheck returns why the deployment is unhealthy; empty if it is healthy
+func (m *deploymentMonitor) doCheck() (string, error) {
 	ctx := context.Background() // TODO: manage context within the deploymentMonitor{}
 	status, err := m.client.LeaseStatus(ctx, m.lease)
 
 	if err != nil {
 		m.log.Error("lease status", "err", err)
-		return false, err
+		return "", err
 	}
 
-	badsvc := 0
+	var reasons []string
 
 	for _, spec := range m.mgroup.Services {
 		found := false
@@ -156,7 +235,8 @@ func (m *deploymentMonitor) doCheck() (bool, error) {
 			found = true
 
 			if uint32(svc.Available) < spec.Count {
-				badsvc++
+				reasons = append(reasons, fmt.Sprintf("service %v: %v of %v replicas available",
+					spec.Name, svc.Available, spec.Count))
 				m.log.Debug("service available replicas below target",
 					"service", spec.Name,
 					"available", svc.Available,
@@ -166,12 +246,28 @@ func (m *deploymentMonitor) doCheck() (bool, error) {
 		}
 
 		if !found {
-			badsvc++
+			reasons = append(reasons, fmt.Sprintf("service %v: not found", spec.Name))
 			m.log.Debug("service status found", "service", spec.Name)
 		}
 	}
 
-	return badsvc == 0, nil
+	return strings.Join(reasons, "; "), nil
+}
+
+// notifyDeployed tells the deployment manager that the revision is healthy
+func (m *deploymentMonitor) notifyDeployed() {
+	select {
+	case m.deployedch <- m.mgroup:
+	case <-m.lc.ShuttingDown():
+	}
+}
+
+// notifyRollback asks the deployment manager to roll back to the previous revision
+func (m *deploymentMonitor) notifyRollback(reason string) {
+	select {
+	case m.rollbackch <- reason:
+	case <-m.lc.ShuttingDown():
+	}
 }
 
 func (m *deploymentMonitor) runCloseLease() <-chan runner.Result {
@@ -199,10 +295,6 @@ func (m *deploymentMonitor) publishStatus(status event.ClusterDeploymentStatus)
 	}
 }
 
-func (m *deploymentMonitor) scheduleRetry() <-chan time.Time {
-	return m.schedule(monitorRetryPeriodMin, monitorRetryPeriodJitter)
-}
-
 func (m *deploymentMonitor) scheduleHealthcheck() <-chan time.Time {
 	return m.schedule(monitorHealthcheckPeriodMin, monitorHealthcheckPeriodJitter)
 }
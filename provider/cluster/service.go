@@ -11,6 +11,7 @@ import (
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
 	atypes "github.com/ovrclk/akash/types"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mquery "github.com/ovrclk/akash/x/market/query"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	"github.com/tendermint/tendermint/libs/log"
@@ -19,10 +20,15 @@ import (
 // ErrNotRunning is the error when service is not running
 var ErrNotRunning = errors.New("not running")
 
-// Cluster is the interface that wraps Reserve and Unreserve methods
+// Cluster is the interface that wraps Reserve and Unreserve methods along with
+// those reserving the resources of lease amendments
 type Cluster interface {
 	Reserve(mtypes.OrderID, atypes.ResourceGroup) (Reservation, error)
 	Unreserve(mtypes.OrderID, atypes.ResourceGroup) error
+	// ReserveAmendment reserves the resources the order amending the lease of the given order adds
+	ReserveAmendment(order, lease mtypes.OrderID, resources atypes.ResourceGroup) (Reservation, error)
+	// CommitAmendment moves the reservation of an amendment that was accepted to its lease
+	CommitAmendment(order, lease mtypes.OrderID, resources atypes.ResourceGroup) error
 }
 
 // StatusClient is the interface which includes status of service
@@ -62,6 +68,13 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cl
 		return nil, err
 	}
 
+	watchch, err := client.Watch(ctx)
+	if err != nil {
+		log.Error("watching cluster", "err", err)
+		sub.Close()
+		return nil, err
+	}
+
 	inventory, err := newInventoryService(config, log, lc.ShuttingDown(), sub, client, deployments)
 	if err != nil {
 		sub.Close()
@@ -82,7 +95,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cl
 	}
 
 	go s.lc.WatchContext(ctx)
-	go s.run(deployments)
+	go s.run(deployments, watchch)
 
 	return s, nil
 }
@@ -125,6 +138,15 @@ func (s *service) Unreserve(order mtypes.OrderID, resources atypes.ResourceGroup
 	return err
 }
 
+func (s *service) ReserveAmendment(order, lease mtypes.OrderID, resources atypes.ResourceGroup) (Reservation, error) {
+	return s.inventory.reserveAmendment(order, lease, resources)
+}
+
+func (s *service) CommitAmendment(order, lease mtypes.OrderID, resources atypes.ResourceGroup) error {
+	_, err := s.inventory.commitAmendment(order, lease, resources)
+	return err
+}
+
 func (s *service) Status(ctx context.Context) (*Status, error) {
 
 	istatus, err := s.inventory.status(ctx)
@@ -154,7 +176,7 @@ func (s *service) Status(ctx context.Context) (*Status, error) {
 
 }
 
-func (s *service) run(deployments []Deployment) {
+func (s *service) run(deployments []Deployment, watchch <-chan pubsub.Event) {
 	defer s.lc.ShutdownCompleted()
 	defer s.sub.Close()
 
@@ -203,6 +225,21 @@ loop:
 
 				s.teardownLease(ev.ID)
 
+			case dtypes.EventDeploymentTransfer:
+
+				s.transferDeployment(ev)
+
+			}
+
+		case ev, ok := <-watchch:
+			if !ok {
+				watchch = nil
+				break
+			}
+
+			// relay cluster changes to deployment monitors and the inventory
+			if err := s.bus.Publish(ev); err != nil {
+				s.log.Error("publishing cluster change", "err", err)
 			}
 
 		case ch := <-s.statusch:
@@ -251,6 +288,31 @@ func (s *service) teardownLease(lid mtypes.LeaseID) {
 	}
 }
 
+// transferDeployment moves the managers of the transferred deployment's leases
+// to the leases of the new owner, which redeploy them under their new leases
+func (s *service) transferDeployment(ev dtypes.EventDeploymentTransfer) {
+	var managers []*deploymentManager
+	for _, manager := range s.managers {
+		if manager.lease.DeploymentID().Equals(ev.ID) {
+			managers = append(managers, manager)
+		}
+	}
+
+	for _, manager := range managers {
+		key := mquery.LeasePath(manager.lease)
+		lease := manager.lease
+		lease.Owner = ev.NewOwner
+
+		if err := manager.transfer(lease); err != nil {
+			s.log.Error("transferring lease deployment", "err", err, "lease", manager.lease)
+			continue
+		}
+
+		delete(s.managers, key)
+		s.managers[mquery.LeasePath(lease)] = manager
+	}
+}
+
 func findDeployments(ctx context.Context, log log.Logger, client Client, session session.Session) ([]Deployment, error) {
 	deployments, err := client.Deployments(ctx)
 	if err != nil {
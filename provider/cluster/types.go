@@ -36,4 +36,7 @@ type ServiceLogs struct {
 // LeaseStatus includes list of services with their status
 type LeaseStatus struct {
 	Services []*ServiceStatus `json:"services"`
+	// RollbackReason is why the lease was rolled back to its previous manifest revision;
+	// empty unless the last update was rolled back
+	RollbackReason string `json:"rollback-reason,omitempty"`
 }
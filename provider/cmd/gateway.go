@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"crypto/tls"
+
+	ccontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ovrclk/akash/provider/gateway"
+	cmodule "github.com/ovrclk/akash/x/cert"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
+)
+
+const (
+	flagCert = "cert"
+)
+
+func addCertFlag(cmd *cobra.Command) {
+	cmd.Flags().String(flagCert, "", "Certificate and key file to authenticate to the provider gateway with")
+}
+
+// newGatewayClient returns a gateway client for provider which presents the
+// certificate given with --cert, if any.
+func newGatewayClient(cctx ccontext.CLIContext, cmd *cobra.Command, provider sdk.AccAddress) (gateway.Client, error) {
+	var certs []tls.Certificate
+
+	path, err := cmd.Flags().GetString(flagCert)
+	if err != nil {
+		return nil, err
+	}
+
+	if path != "" {
+		cert, err := cutils.LoadKeyPair(path)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+
+	return gateway.NewClient(cmodule.AppModuleBasic{}.GetQueryClient(cctx), provider, certs...), nil
+}
+
+// loadProviderCertificate loads the certificate the gateway serves, given with --cert
+// or generated for owner in the default location.
+func loadProviderCertificate(owner sdk.AccAddress) (tls.Certificate, error) {
+	path := viper.GetString(flagCert)
+	if path == "" {
+		path = cutils.DefaultPath(viper.GetString(flags.FlagHome), owner)
+	}
+	return cutils.LoadKeyPair(path)
+}
@@ -5,7 +5,6 @@ import (
 
 	ccontext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
-	"github.com/ovrclk/akash/provider/gateway"
 	"github.com/ovrclk/akash/provider/manifest"
 	"github.com/ovrclk/akash/sdl"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
@@ -25,6 +24,7 @@ func sendManifestCmd(codec *codec.Codec) *cobra.Command {
 	}
 	mcli.AddBidIDFlags(cmd.Flags())
 	mcli.MarkReqBidIDFlags(cmd)
+	addCertFlag(cmd)
 	return cmd
 }
 
@@ -54,7 +54,10 @@ func doSendManifest(codec *codec.Codec, cmd *cobra.Command, sdlpath string) erro
 		return err
 	}
 
-	gclient := gateway.NewClient()
+	gclient, err := newGatewayClient(cctx, cmd, lid.Provider)
+	if err != nil {
+		return err
+	}
 
 	return gclient.SubmitManifest(
 		context.Background(),
@@ -2,11 +2,15 @@ package cmd
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
+	"net/url"
 	"os"
+	"path/filepath"
 
 	ccontext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/keys"
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/x/auth"
@@ -19,14 +23,18 @@ import (
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/cluster/kube"
 	"github.com/ovrclk/akash/provider/gateway"
+	"github.com/ovrclk/akash/provider/manifest"
 	"github.com/ovrclk/akash/provider/session"
 	"github.com/ovrclk/akash/pubsub"
+	amodule "github.com/ovrclk/akash/x/audit"
+	cmodule "github.com/ovrclk/akash/x/cert"
 	dmodule "github.com/ovrclk/akash/x/deployment"
 	mmodule "github.com/ovrclk/akash/x/market"
 	pmodule "github.com/ovrclk/akash/x/provider"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -34,6 +42,8 @@ const (
 	flagClusterK8s           = "cluster-k8s"
 	flagK8sManifestNS        = "k8s-manifest-ns"
 	flagGatewayListenAddress = "gateway-listen-address"
+	flagManifestDBDir        = "manifest-db-dir"
+	flagMetricsListenAddress = "metrics-listen-address"
 )
 
 var (
@@ -60,6 +70,15 @@ func runCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(flagGatewayListenAddress, "0.0.0.0:8080", "Gateway listen address")
 	viper.BindPFlag(flagGatewayListenAddress, cmd.Flags().Lookup(flagGatewayListenAddress))
 
+	cmd.Flags().String(flagManifestDBDir, "", "Received manifests database directory (default: <home>/manifests)")
+	viper.BindPFlag(flagManifestDBDir, cmd.Flags().Lookup(flagManifestDBDir))
+
+	cmd.Flags().String(flagMetricsListenAddress, "", "Listen address of the metrics server; metrics are not exported if empty")
+	viper.BindPFlag(flagMetricsListenAddress, cmd.Flags().Lookup(flagMetricsListenAddress))
+
+	cmd.Flags().String(flagCert, "", "Gateway certificate and key file, used when the host URI is https (default: <home>/<address>.pem)")
+	viper.BindPFlag(flagCert, cmd.Flags().Lookup(flagCert))
+
 	return cmd
 }
 
@@ -92,6 +111,7 @@ func doRunCmd(ctx context.Context, cdc *codec.Codec, cmd *cobra.Command, _ []str
 			dmodule.AppModuleBasic{}.GetQueryClient(cctx),
 			mmodule.AppModuleBasic{}.GetQueryClient(cctx),
 			pmodule.AppModuleBasic{}.GetQueryClient(cctx),
+			amodule.AppModuleBasic{}.GetQueryClient(cctx),
 		),
 	)
 
@@ -100,14 +120,45 @@ func doRunCmd(ctx context.Context, cdc *codec.Codec, cmd *cobra.Command, _ []str
 		return err
 	}
 
+	hosturi, err := url.Parse(pinfo.HostURI)
+	if err != nil {
+		return err
+	}
+	secure := hosturi.Scheme == "https"
+
+	var certs []tls.Certificate
+	if secure {
+		cert, err := loadProviderCertificate(info.GetAddress())
+		if err != nil {
+			return err
+		}
+		certs = append(certs, cert)
+	}
+
 	// k8s client creation
-	cclient, err := createClusterClient(log, cmd, pinfo.HostURI)
+	cclient, err := createClusterClient(log, cmd)
+	if err != nil {
+		return err
+	}
+
+	operator, err := createClusterOperator(log, pinfo.HostURI)
 	if err != nil {
 		return err
 	}
 
 	session := session.New(log, aclient, pinfo)
 
+	mdir := viper.GetString(flagManifestDBDir)
+	if mdir == "" {
+		mdir = filepath.Join(viper.GetString(flags.FlagHome), "manifests")
+	}
+
+	mdb, err := dbm.NewGoLevelDB("manifests", mdir)
+	if err != nil {
+		return err
+	}
+	defer mdb.Close()
+
 	if err := cctx.Client.Start(); err != nil {
 		return err
 	}
@@ -117,24 +168,46 @@ func doRunCmd(ctx context.Context, cdc *codec.Codec, cmd *cobra.Command, _ []str
 
 	group, ctx := errgroup.WithContext(ctx)
 
-	service, err := provider.NewService(ctx, session, bus, cclient)
+	publisher := events.NewPublisher(log, cctx.Client, "provider-cli", bus, 0)
+
+	service, err := provider.NewService(ctx, session, bus, cclient, publisher, manifest.NewStore(mdb))
 	if err != nil {
 		group.Wait()
 		return err
 	}
 
-	gateway := gateway.NewServer(ctx, log, service, gwaddr)
+	if addr := viper.GetString(flagMetricsListenAddress); addr != "" {
+		metrics := gateway.NewMetricsServer(ctx, addr)
+		group.Go(metrics.ListenAndServe)
+		group.Go(func() error {
+			<-ctx.Done()
+			return metrics.Close()
+		})
+	}
+
+	gateway := gateway.NewServer(ctx, log, service, cmodule.AppModuleBasic{}.GetQueryClient(cctx), gwaddr, certs)
 
 	group.Go(func() error {
-		return events.Publish(ctx, cctx.Client, "provider-cli", bus)
+		return publisher.Run(ctx)
 	})
 
+	if operator != nil {
+		group.Go(func() error {
+			return operator.Run(ctx)
+		})
+	}
+
 	group.Go(func() error {
 		<-service.Done()
 		return nil
 	})
 
-	group.Go(gateway.ListenAndServe)
+	group.Go(func() error {
+		if secure {
+			return gateway.ListenAndServeTLS("", "")
+		}
+		return gateway.ListenAndServe()
+	})
 
 	group.Go(func() error {
 		<-ctx.Done()
@@ -151,7 +224,7 @@ func openLogger() log.Logger {
 	})
 }
 
-func createClusterClient(log log.Logger, _ *cobra.Command, host string) (cluster.Client, error) {
+func createClusterClient(log log.Logger, _ *cobra.Command) (cluster.Client, error) {
 	if !viper.GetBool(flagClusterK8s) {
 		// Condition that there is no Kubernetes API to work with.
 		return cluster.NullClient(), nil
@@ -160,5 +233,14 @@ func createClusterClient(log log.Logger, _ *cobra.Command, host string) (cluster
 	if ns == "" {
 		return nil, fmt.Errorf("%w: --%s required", errInvalidConfig, flagK8sManifestNS)
 	}
-	return kube.NewClient(log, host, ns)
+	return kube.NewClient(log, ns)
+}
+
+// createClusterOperator returns the operator that reconciles lease resources
+// with the manifests written by the cluster client; nil without Kubernetes.
+func createClusterOperator(log log.Logger, host string) (kube.Operator, error) {
+	if !viper.GetBool(flagClusterK8s) {
+		return nil, nil
+	}
+	return kube.NewOperator(log, host, viper.GetString(flagK8sManifestNS))
 }
@@ -7,7 +7,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 
-	"github.com/ovrclk/akash/provider/gateway"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	pmodule "github.com/ovrclk/akash/x/provider"
@@ -24,6 +23,7 @@ func serviceStatusCmd(codec *codec.Codec) *cobra.Command {
 
 	mcli.AddBidIDFlags(cmd.Flags())
 	mcli.MarkReqBidIDFlags(cmd)
+	addCertFlag(cmd)
 
 	return cmd
 }
@@ -47,7 +47,10 @@ func doServiceStatus(codec *codec.Codec, cmd *cobra.Command) error {
 		return err
 	}
 
-	gclient := gateway.NewClient()
+	gclient, err := newGatewayClient(cctx, cmd, addr)
+	if err != nil {
+		return err
+	}
 
 	bid, err := mcli.BidIDFromFlagsWithoutCtx(cmd.Flags())
 	if err != nil {
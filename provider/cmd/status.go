@@ -7,7 +7,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/spf13/cobra"
 
-	"github.com/ovrclk/akash/provider/gateway"
 	mcli "github.com/ovrclk/akash/x/market/client/cli"
 	pmodule "github.com/ovrclk/akash/x/provider"
 )
@@ -23,6 +22,7 @@ func statusCmd(codec *codec.Codec) *cobra.Command {
 
 	mcli.AddProviderFlag(cmd.Flags())
 	mcli.MarkReqProviderFlag(cmd)
+	addCertFlag(cmd)
 
 	return cmd
 }
@@ -42,7 +42,10 @@ func doStatus(codec *codec.Codec, cmd *cobra.Command) error {
 		return err
 	}
 
-	gclient := gateway.NewClient()
+	gclient, err := newGatewayClient(cctx, cmd, addr)
+	if err != nil {
+		return err
+	}
 
 	result, err := gclient.Status(context.Background(), provider.HostURI)
 	if err != nil {
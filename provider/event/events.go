@@ -48,3 +48,11 @@ type ClusterDeployment struct {
 	Group   *manifest.Group
 	Status  ClusterDeploymentStatus
 }
+
+// ClusterLeaseChanged is published when the workloads of a lease change in the cluster
+type ClusterLeaseChanged struct {
+	LeaseID mtypes.LeaseID
+}
+
+// ClusterInventoryChanged is published when the capacity of the cluster's nodes changes
+type ClusterInventoryChanged struct{}
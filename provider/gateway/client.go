@@ -3,6 +3,8 @@ package gateway
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,10 +12,14 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/ovrclk/akash/provider"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/manifest"
+	cquery "github.com/ovrclk/akash/x/cert/query"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 )
 
@@ -28,10 +34,28 @@ type Client interface {
 	ServiceStatus(ctx context.Context, host string, id mtypes.LeaseID, service string) (*cluster.ServiceStatus, error)
 }
 
-// NewClient returns a new Client
-func NewClient() Client {
+// NewClient returns a new Client for the gateway of provider. Servers reached over
+// https must present a certificate provider published on chain; certs, if given,
+// are presented to authenticate the client.
+func NewClient(qclient cquery.Client, provider sdk.AccAddress, certs ...tls.Certificate) Client {
+	tlsConfig := &tls.Config{
+		// the server certificate is self-signed and verified against the chain below
+		InsecureSkipVerify: true, // nolint: gosec
+		Certificates:       certs,
+		MinVersion:         tls.VersionTLS12,
+		VerifyPeerCertificate: func(raw [][]byte, _ [][]*x509.Certificate) error {
+			_, err := verifyCertificate(qclient, provider, raw)
+			return err
+		},
+	}
+
 	return &client{
-		hclient: http.DefaultClient,
+		hclient: &http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: tlsConfig,
+			},
+		},
 	}
 }
 
@@ -78,12 +102,18 @@ func (c *client) SubmitManifest(ctx context.Context, host string, mreq *manifest
 	if err != nil {
 		return err
 	}
-	io.Copy(ioutil.Discard, resp.Body)
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
 	if err := resp.Body.Close(); err != nil {
 		return err
 	}
 
 	if resp.StatusCode != http.StatusOK {
+		if msg := strings.TrimSpace(string(body)); msg != "" {
+			return fmt.Errorf("%w: %v: %v", ErrServerResponse, resp.Status, msg)
+		}
 		return fmt.Errorf("%w: %v", ErrServerResponse, resp.Status)
 	}
 
@@ -4,6 +4,9 @@ package gateway
 import (
 	"context"
 	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
 	"net/http/httptest"
 	"testing"
 
@@ -14,8 +17,10 @@ import (
 	pmmock "github.com/ovrclk/akash/provider/manifest/mocks"
 	pmock "github.com/ovrclk/akash/provider/mocks"
 	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/validation"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func Test_router_Status(t *testing.T) {
@@ -24,7 +29,7 @@ func Test_router_Status(t *testing.T) {
 		pclient, _, _ := createMocks()
 		pclient.On("Status", mock.Anything).Return(expected, nil)
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			result, err := client.Status(context.Background(), host)
 			assert.NoError(t, err)
 			assert.Equal(t, expected, result)
@@ -35,7 +40,7 @@ func Test_router_Status(t *testing.T) {
 		pclient, _, _ := createMocks()
 		pclient.On("Status", mock.Anything).Return(nil, errors.New("oops"))
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			_, err := client.Status(context.Background(), host)
 			assert.Error(t, err)
 		})
@@ -52,7 +57,7 @@ func Test_router_Manifest(t *testing.T) {
 		pclient, pmclient, _ := createMocks()
 		pmclient.On("Submit", mock.Anything, req).Return(nil)
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			err := client.SubmitManifest(context.Background(), host, req)
 			assert.NoError(t, err)
 		})
@@ -66,12 +71,29 @@ func Test_router_Manifest(t *testing.T) {
 		pclient, pmclient, _ := createMocks()
 		pmclient.On("Submit", mock.Anything, req).Return(errors.New("ded"))
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			err := client.SubmitManifest(context.Background(), host, req)
 			assert.Error(t, err)
 		})
 		pmclient.AssertExpectations(t)
 	})
+
+	t.Run("invalid", func(t *testing.T) {
+		req := &manifest.SubmitRequest{
+			Deployment: testutil.DeploymentID(t),
+		}
+		pclient, pmclient, _ := createMocks()
+		pmclient.On("Submit", mock.Anything, req).
+			Return(fmt.Errorf("%w: excess manifest resources ('foo')", validation.ErrInvalidManifest))
+		withServer(t, pclient, func(host string) {
+			client := NewClient(nil, nil)
+			err := client.SubmitManifest(context.Background(), host, req)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "422")
+			assert.Contains(t, err.Error(), "excess manifest resources ('foo')")
+		})
+		pmclient.AssertExpectations(t)
+	})
 }
 
 func Test_router_LeaseStatus(t *testing.T) {
@@ -83,7 +105,7 @@ func Test_router_LeaseStatus(t *testing.T) {
 
 		pcclient.On("LeaseStatus", mock.Anything, id).Return(expected, nil)
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			status, err := client.LeaseStatus(context.Background(), host, id)
 			assert.Equal(t, expected, status)
 			assert.NoError(t, err)
@@ -97,7 +119,7 @@ func Test_router_LeaseStatus(t *testing.T) {
 
 		pcclient.On("LeaseStatus", mock.Anything, id).Return(nil, errors.New("ded"))
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			status, err := client.LeaseStatus(context.Background(), host, id)
 			assert.Nil(t, status)
 			assert.Error(t, err)
@@ -116,7 +138,7 @@ func Test_router_ServiceStatus(t *testing.T) {
 
 		pcclient.On("ServiceStatus", mock.Anything, id, service).Return(expected, nil)
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			status, err := client.ServiceStatus(context.Background(), host, id, service)
 			assert.NoError(t, err)
 			assert.Equal(t, expected, status)
@@ -131,7 +153,7 @@ func Test_router_ServiceStatus(t *testing.T) {
 
 		pcclient.On("ServiceStatus", mock.Anything, id, service).Return(nil, errors.New("ded"))
 		withServer(t, pclient, func(host string) {
-			client := NewClient()
+			client := NewClient(nil, nil)
 			status, err := client.ServiceStatus(context.Background(), host, id, service)
 			assert.Nil(t, status)
 			assert.Error(t, err)
@@ -160,3 +182,25 @@ func withServer(t testing.TB, pclient provider.Client, fn func(string)) {
 	defer server.Close()
 	fn("http://" + server.Listener.Addr().String())
 }
+
+func Test_router_Metrics(t *testing.T) {
+	pclient, _, _ := createMocks()
+	withServer(t, pclient, func(host string) {
+		resp, err := http.Get(host + "/metrics")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+	})
+
+	server := httptest.NewServer(NewMetricsServer(context.Background(), "").Handler)
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/metrics")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Contains(t, string(body), "go_goroutines")
+}
@@ -3,6 +3,7 @@ package gateway
 import (
 	"net/http"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/gorilla/context"
 	"github.com/gorilla/mux"
 	dquery "github.com/ovrclk/akash/x/deployment/query"
@@ -35,6 +36,10 @@ func requireDeploymentID(log log.Logger) mux.MiddlewareFunc {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
+			if code, err := authorizeOwner(req, id.Owner); err != nil {
+				http.Error(w, err.Error(), code)
+				return
+			}
 			context.Set(req, deploymentContextKey, id)
 			next.ServeHTTP(w, req)
 		})
@@ -49,12 +54,33 @@ func requireLeaseID(log log.Logger) mux.MiddlewareFunc {
 				http.Error(w, err.Error(), http.StatusBadRequest)
 				return
 			}
+			if code, err := authorizeOwner(req, id.Owner); err != nil {
+				http.Error(w, err.Error(), code)
+				return
+			}
 			context.Set(req, leaseContextKey, id)
 			next.ServeHTTP(w, req)
 		})
 	}
 }
 
+// authorizeOwner requires requests made over TLS to present a client certificate
+// issued to owner. Presented certificates are verified against the chain during
+// the handshake. It returns the status code to reject the request with.
+func authorizeOwner(req *http.Request, owner sdk.AccAddress) (int, error) {
+	if req.TLS == nil {
+		return 0, nil
+	}
+	presented := requestOwner(req.TLS)
+	if presented == nil {
+		return http.StatusUnauthorized, ErrNoCertificate
+	}
+	if !presented.Equals(owner) {
+		return http.StatusForbidden, ErrCertificateNotOwner
+	}
+	return 0, nil
+}
+
 func parseDeploymentID(req *http.Request) (dtypes.DeploymentID, error) {
 	vars := mux.Vars(req)
 	return dquery.ParseDeploymentPath([]string{
@@ -2,6 +2,7 @@ package gateway
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 
 	"github.com/tendermint/tendermint/libs/log"
@@ -10,6 +11,7 @@ import (
 	"github.com/ovrclk/akash/provider"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/manifest"
+	"github.com/ovrclk/akash/validation"
 )
 
 const (
@@ -81,6 +83,10 @@ func createManifestHandler(_ log.Logger, mclient manifest.Client) http.HandlerFu
 		}
 
 		if err := mclient.Submit(req.Context(), &mreq); err != nil {
+			if errors.Is(err, validation.ErrInvalidManifest) {
+				http.Error(w, err.Error(), http.StatusUnprocessableEntity)
+				return
+			}
 			// TODO: surface unauthorized, etc...
 			http.Error(w, err.Error(), http.StatusInternalServerError)
 			return
@@ -2,19 +2,63 @@ package gateway
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"net"
 	"net/http"
 
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
 	"github.com/ovrclk/akash/provider"
+	cquery "github.com/ovrclk/akash/x/cert/query"
 	"github.com/tendermint/tendermint/libs/log"
 )
 
-func NewServer(ctx context.Context, log log.Logger, pclient provider.Client, address string) *http.Server {
-	return &http.Server{
+// NewServer returns the gateway server for pclient. When certs are given the server
+// is configured for TLS and requests client certificates, which are verified against
+// the certificates published on chain.
+func NewServer(ctx context.Context, log log.Logger, pclient provider.Client, qclient cquery.Client, address string, certs []tls.Certificate) *http.Server {
+	srv := &http.Server{
 		Addr:    address,
 		Handler: newRouter(log, pclient),
 		BaseContext: func(_ net.Listener) context.Context {
 			return ctx
 		},
 	}
+
+	if len(certs) > 0 {
+		srv.TLSConfig = &tls.Config{
+			Certificates: certs,
+			MinVersion:   tls.VersionTLS12,
+			ClientAuth:   tls.RequestClientCert,
+			VerifyPeerCertificate: func(raw [][]byte, _ [][]*x509.Certificate) error {
+				// client certificates are optional for the public status endpoint;
+				// deployment and lease routes reject requests without one
+				if len(raw) == 0 {
+					return nil
+				}
+				_, err := verifyCertificate(qclient, nil, raw)
+				return err
+			},
+		}
+	}
+
+	return srv
+}
+
+// NewMetricsServer returns a server exporting the provider metrics. They are
+// not served by the public gateway.
+func NewMetricsServer(ctx context.Context, address string) *http.Server {
+	router := mux.NewRouter()
+	router.Handle("/metrics", promhttp.Handler()).
+		Methods("GET")
+
+	return &http.Server{
+		Addr:    address,
+		Handler: router,
+		BaseContext: func(_ net.Listener) context.Context {
+			return ctx
+		},
+	}
 }
@@ -0,0 +1,67 @@
+package gateway
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cquery "github.com/ovrclk/akash/x/cert/query"
+	ctypes "github.com/ovrclk/akash/x/cert/types"
+)
+
+var (
+	// ErrNoCertificate is returned when the peer did not present a certificate
+	ErrNoCertificate = errors.New("no certificate presented")
+	// ErrCertificateNotOwner is returned when the peer certificate was issued to someone else
+	ErrCertificateNotOwner = errors.New("certificate not issued to owner")
+)
+
+// verifyCertificate checks that the leaf of the raw certificate chain matches a valid
+// certificate published on chain by owner. If owner is nil it is read from the common
+// name of the leaf. It returns the authenticated owner.
+func verifyCertificate(qclient cquery.Client, owner sdk.AccAddress, raw [][]byte) (sdk.AccAddress, error) {
+	if len(raw) == 0 {
+		return nil, ErrNoCertificate
+	}
+
+	leaf, err := x509.ParseCertificate(raw[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if owner == nil {
+		if owner, err = sdk.AccAddressFromBech32(leaf.Subject.CommonName); err != nil {
+			return nil, err
+		}
+	}
+
+	record, err := qclient.Certificate(ctypes.CertificateID{
+		Owner:       owner,
+		Fingerprint: ctypes.Fingerprint(leaf.RawSubjectPublicKeyInfo),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ctypes.Certificate(record).Authenticates(owner, leaf, time.Now()); err != nil {
+		return nil, err
+	}
+
+	return owner, nil
+}
+
+// requestOwner returns the owner of the certificate the client authenticated with,
+// or nil if the request was made without one.
+func requestOwner(state *tls.ConnectionState) sdk.AccAddress {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(state.PeerCertificates[0].Subject.CommonName)
+	if err != nil {
+		return nil
+	}
+	return owner
+}
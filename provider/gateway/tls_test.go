@@ -0,0 +1,187 @@
+package gateway
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/provider"
+	"github.com/ovrclk/akash/provider/cluster"
+	"github.com/ovrclk/akash/testutil"
+	cquery "github.com/ovrclk/akash/x/cert/query"
+	ctypes "github.com/ovrclk/akash/x/cert/types"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
+)
+
+type certStore map[string]cquery.Certificate
+
+func (s certStore) Certificates() (cquery.Certificates, error) {
+	var certs cquery.Certificates
+	for _, cert := range s {
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+func (s certStore) OwnerCertificates(owner sdk.AccAddress) (cquery.Certificates, error) {
+	var certs cquery.Certificates
+	for _, cert := range s {
+		if cert.Owner.Equals(owner) {
+			certs = append(certs, cert)
+		}
+	}
+	return certs, nil
+}
+
+func (s certStore) Certificate(id ctypes.CertificateID) (cquery.Certificate, error) {
+	cert, ok := s[id.Owner.String()+"/"+id.Fingerprint]
+	if !ok {
+		return cquery.Certificate{}, ctypes.ErrCertificateNotFound
+	}
+	return cert, nil
+}
+
+// publish generates a key pair for owner and records it in the store
+func (s certStore) publish(t testing.TB, owner sdk.AccAddress, state ctypes.CertificateState) tls.Certificate {
+	t.Helper()
+
+	pair, err := cutils.Generate(owner, []string{"127.0.0.1"}, time.Hour)
+	require.NoError(t, err)
+
+	cert, err := tls.X509KeyPair(pair, pair)
+	require.NoError(t, err)
+
+	pem, err := cutils.CertificatePEM(pair)
+	require.NoError(t, err)
+
+	pubkey, _, err := ctypes.ParsePEM(pem)
+	require.NoError(t, err)
+
+	id := ctypes.CertificateID{Owner: owner, Fingerprint: ctypes.Fingerprint(pubkey)}
+	s[owner.String()+"/"+id.Fingerprint] = cquery.Certificate{
+		CertificateID: id,
+		State:         state,
+		PEM:           pem,
+	}
+	return cert
+}
+
+func withTLSServer(t testing.TB, pclient provider.Client, qclient cquery.Client, cert tls.Certificate, fn func(string)) {
+	t.Helper()
+	srv := NewServer(context.Background(), testutil.Logger(t), pclient, qclient, "", []tls.Certificate{cert})
+	server := httptest.NewUnstartedServer(srv.Handler)
+	server.TLS = srv.TLSConfig
+	server.StartTLS()
+	defer server.Close()
+	fn("https://" + server.Listener.Addr().String())
+}
+
+func Test_TLS_ServerCertificate(t *testing.T) {
+	paddr := testutil.AccAddress(t)
+
+	t.Run("valid", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateValid)
+
+		expected := &provider.Status{}
+		pclient, _, _ := createMocks()
+		pclient.On("Status", mock.Anything).Return(expected, nil)
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			result, err := NewClient(store, paddr).Status(context.Background(), host)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, result)
+		})
+	})
+
+	t.Run("revoked", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateRevoked)
+		pclient, _, _ := createMocks()
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			_, err := NewClient(store, paddr).Status(context.Background(), host)
+			assert.True(t, errors.Is(err, ctypes.ErrCertificateRevoked))
+		})
+	})
+
+	t.Run("wrong provider", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateValid)
+		pclient, _, _ := createMocks()
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			_, err := NewClient(store, testutil.AccAddress(t)).Status(context.Background(), host)
+			assert.Error(t, err)
+		})
+	})
+}
+
+func Test_TLS_ClientCertificate(t *testing.T) {
+	paddr := testutil.AccAddress(t)
+	id := testutil.LeaseID(t)
+
+	t.Run("lease owner", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateValid)
+		tcert := store.publish(t, id.Owner, ctypes.CertificateValid)
+
+		expected := &cluster.LeaseStatus{}
+		pclient, _, pcclient := createMocks()
+		pcclient.On("LeaseStatus", mock.Anything, id).Return(expected, nil)
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			status, err := NewClient(store, paddr, tcert).LeaseStatus(context.Background(), host, id)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, status)
+		})
+		pcclient.AssertExpectations(t)
+	})
+
+	t.Run("not lease owner", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateValid)
+		tcert := store.publish(t, testutil.AccAddress(t), ctypes.CertificateValid)
+		pclient, _, pcclient := createMocks()
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			_, err := NewClient(store, paddr, tcert).LeaseStatus(context.Background(), host, id)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "403")
+		})
+		pcclient.AssertNotCalled(t, "LeaseStatus", mock.Anything, id)
+	})
+
+	t.Run("anonymous", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateValid)
+		pclient, _, pcclient := createMocks()
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			_, err := NewClient(store, paddr).LeaseStatus(context.Background(), host, id)
+			assert.True(t, errors.Is(err, ErrServerResponse))
+			assert.Contains(t, err.Error(), "401")
+		})
+		pcclient.AssertNotCalled(t, "LeaseStatus", mock.Anything, id)
+	})
+
+	t.Run("unpublished", func(t *testing.T) {
+		store := certStore{}
+		cert := store.publish(t, paddr, ctypes.CertificateValid)
+		tcert := certStore{}.publish(t, id.Owner, ctypes.CertificateValid)
+		pclient, _, _ := createMocks()
+
+		withTLSServer(t, pclient, store, cert, func(host string) {
+			_, err := NewClient(store, paddr, tcert).LeaseStatus(context.Background(), host, id)
+			assert.Error(t, err)
+		})
+	})
+}
@@ -1,6 +1,7 @@
 package manifest
 
 import (
+	"bytes"
 	"context"
 	"time"
 
@@ -21,6 +22,8 @@ import (
 
 var (
 	ErrShutdownTimerExpired = errors.New("shutdown timer expired")
+	// ErrManifestVersion is the error when a restored manifest was accepted for another deployment version
+	ErrManifestVersion = errors.New("manifest version mismatch")
 )
 
 func newManager(h *service, daddr dtypes.DeploymentID) (*manager, error) {
@@ -37,10 +40,13 @@ func newManager(h *service, daddr dtypes.DeploymentID) (*manager, error) {
 		session:    session,
 		bus:        h.bus,
 		sub:        sub,
+		store:      h.store,
 		leasech:    make(chan event.LeaseWon),
 		rmleasech:  make(chan mtypes.LeaseID),
 		manifestch: make(chan manifestRequest),
 		updatech:   make(chan []byte),
+		transferch: make(chan dtypes.DeploymentID),
+		awaiting:   make(map[leaseKey]time.Time),
 		log:        session.Log().With("deployment", daddr),
 		lc:         lifecycle.New(),
 	}
@@ -57,17 +63,23 @@ type manager struct {
 	session session.Session
 	bus     pubsub.Bus
 	sub     pubsub.Subscriber
+	store   Store
 
 	leasech    chan event.LeaseWon
 	rmleasech  chan mtypes.LeaseID
 	manifestch chan manifestRequest
 	updatech   chan []byte
+	transferch chan dtypes.DeploymentID
 
 	data      *dquery.Deployment
 	requests  []manifestRequest
 	leases    []event.LeaseWon
 	manifests []*manifest.Manifest
 	versions  [][]byte
+	// awaiting holds when leases still waiting for their manifest were won
+	awaiting map[leaseKey]time.Time
+	// refetch is set when the deployment was updated while its data was being fetched
+	refetch bool
 
 	stoptimer *time.Timer
 
@@ -112,6 +124,15 @@ func (m *manager) handleUpdate(version []byte) {
 	}
 }
 
+func (m *manager) transfer(daddr dtypes.DeploymentID) error {
+	select {
+	case m.transferch <- daddr:
+		return nil
+	case <-m.lc.ShuttingDown():
+		return ErrNotRunning
+	}
+}
+
 func (m *manager) run(donech chan<- *manager) {
 	defer m.lc.ShutdownCompleted()
 	defer func() { donech <- m }()
@@ -143,6 +164,7 @@ loop:
 			m.log.Info("new lease", "lease", ev.LeaseID)
 
 			m.leases = append(m.leases, ev)
+			m.awaiting[makeLeaseKey(ev.LeaseID)] = time.Now()
 			m.emitReceivedEvents()
 			m.maybeScheduleStop()
 			runch = m.maybeFetchData(ctx, runch)
@@ -155,13 +177,19 @@ loop:
 					m.leases = append(m.leases[:idx], m.leases[idx+1:]...)
 				}
 			}
+			delete(m.awaiting, makeLeaseKey(id))
 
 			m.maybeScheduleStop()
 
 		case req := <-m.manifestch:
 			m.log.Info("manifest received")
 
-			// TODO: fail fast if invalid request to prevent DoS
+			// fail fast on malformed manifests; resources are checked once deployment data is available
+			if err := validation.ValidateManifest(req.value.Manifest); err != nil {
+				m.log.Error("invalid manifest", "err", err)
+				req.ch <- err
+				break
+			}
 
 			m.requests = append(m.requests, req)
 			m.validateRequests()
@@ -173,19 +201,56 @@ loop:
 			m.log.Info("received version", "version", version)
 
 			m.versions = append(m.versions, version)
-			if m.data != nil {
-				m.data.Version = version
+
+			// groups may have been updated; manifests wait for their new specifications
+			m.data = nil
+			if runch != nil {
+				m.refetch = true
+				break
+			}
+			runch = m.fetchData(ctx)
+
+		case daddr := <-m.transferch:
+			m.log.Info("deployment transferred", "owner", daddr.Owner)
+
+			m.deleteStored()
+			m.daddr = daddr
+			m.log = m.session.Log().With("deployment", daddr)
+
+			for idx, lease := range m.leases {
+				group := *lease.Group
+				group.Owner = daddr.Owner
+				m.leases[idx].LeaseID.Owner = daddr.Owner
+				m.leases[idx].Group = &group
+			}
+			m.transferData()
+
+			if m.data != nil && len(m.manifests) > 0 {
+				m.saveStored(m.manifests[len(m.manifests)-1])
 			}
 
 		case result := <-runch:
 			runch = nil
 
+			// data fetched before an update is stale
+			if m.refetch {
+				m.refetch = false
+				runch = m.fetchData(ctx)
+				break
+			}
+
 			if err := result.Error(); err != nil {
 				m.log.Error("error fetching data", "err", err)
 				break
 			}
 
 			m.data = result.Value().(*dquery.Deployment)
+			if len(m.versions) > 0 {
+				m.data.Version = m.versions[len(m.versions)-1]
+			}
+			// data fetched before a transfer is owned by the previous owner
+			m.transferData()
+			m.updateLeaseGroups()
 
 			m.log.Info("data received", "version", m.data.Version)
 
@@ -222,14 +287,15 @@ func (m *manager) maybeFetchData(ctx context.Context, runch <-chan runner.Result
 }
 
 func (m *manager) fetchData(ctx context.Context) <-chan runner.Result {
+	daddr := m.daddr
 	return runner.Do(func() runner.Result {
 		// TODO: retry
-		return runner.NewResult(m.doFetchData(ctx))
+		return runner.NewResult(m.doFetchData(ctx, daddr))
 	})
 }
 
-func (m *manager) doFetchData(_ context.Context) (*dquery.Deployment, error) {
-	deployment, err := m.session.Client().Query().Deployment(m.daddr)
+func (m *manager) doFetchData(_ context.Context, daddr dtypes.DeploymentID) (*dquery.Deployment, error) {
+	deployment, err := m.session.Client().Query().Deployment(daddr)
 	if err != nil {
 		return nil, err
 	}
@@ -264,6 +330,12 @@ func (m *manager) emitReceivedEvents() {
 	m.log.Debug("publishing manifest received", "num-leases", len(m.leases))
 
 	for _, lease := range m.leases {
+		key := makeLeaseKey(lease.LeaseID)
+		if won, ok := m.awaiting[key]; ok {
+			manifestReceiveLatency.Observe(time.Since(won).Seconds())
+			delete(m.awaiting, key)
+		}
+
 		if err := m.bus.Publish(event.ManifestReceived{
 			LeaseID:    lease.LeaseID,
 			Group:      lease.Group,
@@ -284,6 +356,9 @@ func (m *manager) validateRequests() {
 	for _, req := range m.requests {
 		if err := m.validateRequest(req); err != nil {
 			m.log.Error("invalid manifest", "err", err)
+			if req.version != nil {
+				m.deleteStored()
+			}
 			req.ch <- err
 			continue
 		}
@@ -297,11 +372,63 @@ func (m *manager) validateRequests() {
 	if len(manifests) > 0 {
 		// XXX: only one version means only one valid manifest
 		m.manifests = append(m.manifests, manifests[0])
+		m.saveStored(manifests[0])
+	}
+}
+
+// transferData sets the owner of the deployment data to that of the deployment
+// address. The data is shared with published events and so is copied.
+func (m *manager) transferData() {
+	if m.data == nil || m.data.Owner.Equals(m.daddr.Owner) {
+		return
+	}
+
+	data := *m.data
+	data.Owner = m.daddr.Owner
+	data.Groups = make([]dtypes.Group, 0, len(m.data.Groups))
+	for _, group := range m.data.Groups {
+		group.Owner = m.daddr.Owner
+		data.Groups = append(data.Groups, group)
+	}
+	m.data = &data
+}
+
+// updateLeaseGroups sets the groups of the leases to those of the deployment data,
+// whose specifications change as the deployment is updated
+func (m *manager) updateLeaseGroups() {
+	for idx, lease := range m.leases {
+		for _, group := range m.data.Groups {
+			if group.GroupID.GSeq != lease.LeaseID.GSeq {
+				continue
+			}
+			group := dquery.Group(group)
+			m.leases[idx].Group = &group
+			break
+		}
+	}
+}
+
+func (m *manager) saveStored(mani *manifest.Manifest) {
+	if err := m.store.Save(Record{
+		Deployment: m.daddr,
+		Version:    m.data.Version,
+		Manifest:   *mani,
+	}); err != nil {
+		m.log.Error("storing manifest", "err", err)
+	}
+}
+
+func (m *manager) deleteStored() {
+	if err := m.store.Delete(m.daddr); err != nil {
+		m.log.Error("deleting stored manifest", "err", err)
 	}
 }
 
 func (m *manager) validateRequest(req manifestRequest) error {
 	// TODO: hash(manifest) == m.data.Version
+	if req.version != nil && !bytes.Equal(req.version, m.data.Version) {
+		return ErrManifestVersion
+	}
 	if err := validation.ValidateManifestWithDeployment(&req.value.Manifest, m.data.Groups); err != nil {
 		return err
 	}
@@ -0,0 +1,26 @@
+package manifest
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+var (
+	manifestReceiveLatency = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "akash_provider_manifest_receive_latency_seconds",
+		Help:    "Time between a lease being won and its manifest being received",
+		Buckets: prometheus.ExponentialBuckets(1, 2, 12),
+	})
+)
+
+// leaseKey identifies a lease within a deployment, across ownership transfers
+type leaseKey struct {
+	gseq uint32
+	oseq uint32
+}
+
+func makeLeaseKey(id mtypes.LeaseID) leaseKey {
+	return leaseKey{gseq: id.GSeq, oseq: id.OSeq}
+}
@@ -38,7 +38,8 @@ type Service interface {
 
 // NewHandler creates and returns new Service instance
 // Manage incoming leases and manifests and pair the two together to construct and emit a ManifestReceived event.
-func NewService(ctx context.Context, session session.Session, bus pubsub.Bus) (Service, error) {
+// Accepted manifests are persisted to the store and restored on startup for deployments that still have active leases.
+func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, store Store) (Service, error) {
 
 	session = session.ForModule("provider-manifest")
 
@@ -61,10 +62,19 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus) (S
 	}
 	session.Log().Info("found existing leases", "count", len(leases))
 
+	records, err := fetchStoredManifests(session, store, leases)
+	if err != nil {
+		session.Log().Error("fetching stored manifests", "err", err)
+		sub.Close()
+		return nil, err
+	}
+	session.Log().Info("found stored manifests", "count", len(records))
+
 	s := &service{
 		session:   session,
 		bus:       bus,
 		sub:       sub,
+		store:     store,
 		statusch:  make(chan chan<- *Status),
 		mreqch:    make(chan manifestRequest),
 		managers:  make(map[string]*manager),
@@ -73,7 +83,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus) (S
 	}
 
 	go s.lc.WatchContext(ctx)
-	go s.run(leases)
+	go s.run(leases, records)
 
 	return s, nil
 }
@@ -83,6 +93,7 @@ type service struct {
 	session session.Session
 	bus     pubsub.Bus
 	sub     pubsub.Subscriber
+	store   Store
 
 	statusch chan chan<- *Status
 	mreqch   chan manifestRequest
@@ -99,6 +110,9 @@ type manifestRequest struct {
 	value *SubmitRequest
 	ch    chan<- error
 	ctx   context.Context
+
+	// version of the deployment a restored manifest was accepted for; nil for submitted manifests
+	version []byte
 }
 
 // Send incoming manifest request.
@@ -140,11 +154,12 @@ func (s *service) Status(ctx context.Context) (*Status, error) {
 	}
 }
 
-func (s *service) run(leases []event.LeaseWon) {
+func (s *service) run(leases []event.LeaseWon, records []Record) {
 	defer s.lc.ShutdownCompleted()
 	defer s.sub.Close()
 
 	s.managePreExistingLease(leases)
+	s.restoreManifests(records)
 
 loop:
 	for {
@@ -174,12 +189,33 @@ loop:
 
 			case dtypes.EventDeploymentClose:
 
+				if err := s.store.Delete(ev.ID); err != nil {
+					s.session.Log().Error("deleting stored manifest", "err", err, "deployment", ev.ID)
+				}
+
 				key := dquery.DeploymentPath(ev.ID)
 				if manager := s.managers[key]; manager != nil {
 					s.session.Log().Info("deployment closed", "deployment", ev.ID)
 					manager.stop()
 				}
 
+			case dtypes.EventDeploymentTransfer:
+
+				key := dquery.DeploymentPath(ev.ID)
+				if manager := s.managers[key]; manager != nil {
+					s.session.Log().Info("deployment transferred", "deployment", ev.ID, "owner", ev.NewOwner)
+
+					// manifests for the deployment are submitted by its new owner
+					did := ev.ID
+					did.Owner = ev.NewOwner
+					if err := manager.transfer(did); err != nil {
+						s.session.Log().Error("transferring deployment", "err", err, "deployment", ev.ID)
+						break
+					}
+					delete(s.managers, key)
+					s.managers[dquery.DeploymentPath(did)] = manager
+				}
+
 			case mtypes.EventLeaseClosed:
 
 				if !bytes.Equal(ev.ID.Provider, s.session.Provider().Address()) {
@@ -239,6 +275,26 @@ func (s *service) managePreExistingLease(leases []event.LeaseWon) {
 	}
 }
 
+func (s *service) restoreManifests(records []Record) {
+	for _, rec := range records {
+		manager, err := s.ensureManger(rec.Deployment)
+		if err != nil {
+			s.session.Log().Error("error creating manager",
+				"err", err, "deployment", rec.Deployment)
+			continue
+		}
+
+		s.session.Log().Info("restoring manifest", "deployment", rec.Deployment, "version", rec.Version)
+
+		manager.handleManifest(manifestRequest{
+			value:   &SubmitRequest{Deployment: rec.Deployment, Manifest: rec.Manifest},
+			ch:      make(chan error, 1),
+			ctx:     context.Background(),
+			version: rec.Version,
+		})
+	}
+}
+
 func (s *service) handleLease(ev event.LeaseWon) {
 	manager, err := s.ensureManger(ev.LeaseID.DeploymentID())
 	if err != nil {
@@ -262,6 +318,34 @@ func (s *service) ensureManger(did dtypes.DeploymentID) (manager *manager, err e
 	return manager, nil
 }
 
+// fetchStoredManifests returns the stored manifests of deployments with active leases
+// and deletes the others, whose deployments were closed while the provider was down.
+func fetchStoredManifests(session session.Session, store Store, leases []event.LeaseWon) ([]Record, error) {
+	records, err := store.All()
+	if err != nil {
+		return nil, err
+	}
+
+	active := make(map[string]bool, len(leases))
+	for _, lease := range leases {
+		active[dquery.DeploymentPath(lease.LeaseID.DeploymentID())] = true
+	}
+
+	items := make([]Record, 0, len(records))
+	for _, rec := range records {
+		if active[dquery.DeploymentPath(rec.Deployment)] {
+			items = append(items, rec)
+			continue
+		}
+
+		session.Log().Info("dropping stored manifest", "deployment", rec.Deployment)
+		if err := store.Delete(rec.Deployment); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
 func fetchExistingLeases(_ context.Context, session session.Session) ([]event.LeaseWon, error) {
 	leases, err := session.Client().Query().ActiveLeasesForProvider(session.Provider().Address())
 	if err != nil {
@@ -0,0 +1,126 @@
+package manifest
+
+import (
+	"encoding/json"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/manifest"
+	dquery "github.com/ovrclk/akash/x/deployment/query"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+)
+
+var manifestPrefix = []byte{0x01}
+
+// Record is a manifest accepted for a version of a deployment
+type Record struct {
+	Deployment dtypes.DeploymentID `json:"deployment"`
+	Version    []byte              `json:"version"`
+	Manifest   manifest.Manifest   `json:"manifest"`
+}
+
+// Store persists the manifests received for deployments so that they can be
+// deployed again after a restart without the tenant resending them.
+type Store interface {
+	// Save stores the manifest of a deployment version, replacing those of other versions
+	Save(Record) error
+	// Delete removes the manifests of a deployment
+	Delete(dtypes.DeploymentID) error
+	// All returns every stored manifest
+	All() ([]Record, error)
+}
+
+// NewStore returns a store backed by the given database.
+// Manifests are keyed by deployment ID and version.
+func NewStore(db dbm.DB) Store {
+	return &store{db: db}
+}
+
+type store struct {
+	db dbm.DB
+}
+
+func (s *store) Save(rec Record) error {
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.deleteAll(batch, rec.Deployment); err != nil {
+		return err
+	}
+	batch.Set(manifestKey(rec.Deployment, rec.Version), buf)
+
+	return batch.WriteSync()
+}
+
+func (s *store) Delete(id dtypes.DeploymentID) error {
+	batch := s.db.NewBatch()
+	defer batch.Close()
+
+	if err := s.deleteAll(batch, id); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+func (s *store) All() ([]Record, error) {
+	iter, err := s.db.Iterator(manifestPrefix, prefixEnd(manifestPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+
+	records := make([]Record, 0)
+	for ; iter.Valid(); iter.Next() {
+		var rec Record
+		if err := json.Unmarshal(iter.Value(), &rec); err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+func (s *store) deleteAll(batch dbm.Batch, id dtypes.DeploymentID) error {
+	pfx := deploymentPrefix(id)
+
+	iter, err := s.db.Iterator(pfx, prefixEnd(pfx))
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		batch.Delete(append([]byte{}, iter.Key()...))
+	}
+	return nil
+}
+
+// deploymentPrefix returns prefix | deployment path | '/'; the separator keeps
+// deployment sequences that are prefixes of each other apart.
+func deploymentPrefix(id dtypes.DeploymentID) []byte {
+	path := dquery.DeploymentPath(id)
+	buf := make([]byte, 0, len(manifestPrefix)+len(path)+1)
+	buf = append(buf, manifestPrefix...)
+	buf = append(buf, path...)
+	return append(buf, '/')
+}
+
+func manifestKey(id dtypes.DeploymentID, version []byte) []byte {
+	return append(deploymentPrefix(id), version...)
+}
+
+func prefixEnd(pfx []byte) []byte {
+	end := append([]byte{}, pfx...)
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+			return end[:i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package manifest_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/provider/manifest"
+	"github.com/ovrclk/akash/testutil"
+)
+
+func TestStore(t *testing.T) {
+	store := manifest.NewStore(dbm.NewMemDB())
+
+	did := testutil.DeploymentID(t)
+	other := testutil.DeploymentID(t)
+	other.Owner = did.Owner
+	other.DSeq = did.DSeq*10 + 1
+
+	mani := testutil.AppManifestGenerator.Manifest(t)
+
+	require.NoError(t, store.Save(manifest.Record{Deployment: did, Version: []byte("v1"), Manifest: mani}))
+	require.NoError(t, store.Save(manifest.Record{Deployment: other, Version: []byte("v1"), Manifest: mani}))
+
+	// saving a new version replaces the previous one
+	require.NoError(t, store.Save(manifest.Record{Deployment: did, Version: []byte("v2"), Manifest: mani}))
+
+	records, err := store.All()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+
+	found := false
+	for _, rec := range records {
+		if rec.Deployment.Equals(did) {
+			found = true
+			assert.Equal(t, []byte("v2"), rec.Version)
+			assert.Equal(t, mani, rec.Manifest)
+		}
+	}
+	assert.True(t, found)
+
+	// deleting a deployment leaves those with longer sequences intact
+	require.NoError(t, store.Delete(did))
+
+	records, err = store.All()
+	require.NoError(t, err)
+	require.Len(t, records, 1)
+	assert.True(t, records[0].Deployment.Equals(other))
+}
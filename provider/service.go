@@ -8,6 +8,7 @@ import (
 	"github.com/caarlos0/env"
 	"github.com/pkg/errors"
 
+	"github.com/ovrclk/akash/events"
 	"github.com/ovrclk/akash/provider/bidengine"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/manifest"
@@ -24,6 +25,11 @@ type StatusClient interface {
 	Status(context.Context) (*Status, error)
 }
 
+// EventsStatusClient reports the progress of the chain events publisher
+type EventsStatusClient interface {
+	Status() *events.Status
+}
+
 type Client interface {
 	StatusClient
 	Manifest() manifest.Client
@@ -41,7 +47,8 @@ type Service interface {
 
 // NewService creates and returns new Service instance
 // Simple wrapper around various services needed for running a provider.
-func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cclient cluster.Client) (Service, error) {
+func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cclient cluster.Client,
+	eclient EventsStatusClient, mstore manifest.Store) (Service, error) {
 
 	config := config{}
 	if err := env.Parse(&config); err != nil {
@@ -76,7 +83,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cc
 		return nil, errors.Wrap(err, errmsg)
 	}
 
-	manifest, err := manifest.NewService(ctx, session, bus)
+	manifest, err := manifest.NewService(ctx, session, bus, mstore)
 	if err != nil {
 		session.Log().Error("creating manifest handler", "err", err)
 		cancel()
@@ -90,6 +97,7 @@ func NewService(ctx context.Context, session session.Session, bus pubsub.Bus, cc
 		bus:       bus,
 		cluster:   cluster,
 		cclient:   cclient,
+		eclient:   eclient,
 		bidengine: bidengine,
 		manifest:  manifest,
 		ctx:       ctx,
@@ -107,6 +115,7 @@ type service struct {
 	session session.Session
 	bus     pubsub.Bus
 	cclient cluster.Client
+	eclient EventsStatusClient
 
 	cluster   cluster.Service
 	bidengine bidengine.Service
@@ -151,6 +160,7 @@ func (s *service) Status(ctx context.Context) (*Status, error) {
 		Cluster:   cluster,
 		Bidengine: bidengine,
 		Manifest:  manifest,
+		Events:    s.eclient.Status(),
 	}, nil
 }
 
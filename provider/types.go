@@ -1,14 +1,16 @@
 package provider
 
 import (
+	"github.com/ovrclk/akash/events"
 	"github.com/ovrclk/akash/provider/bidengine"
 	"github.com/ovrclk/akash/provider/cluster"
 	"github.com/ovrclk/akash/provider/manifest"
 )
 
-// Status is the data structure that stores Cluster, Bidengine and Manifest details.
+// Status is the data structure that stores Cluster, Bidengine, Manifest and Events details.
 type Status struct {
 	Cluster   *cluster.Status   `json:"cluster"`
 	Bidengine *bidengine.Status `json:"bidengine"`
 	Manifest  *manifest.Status  `json:"manifest"`
+	Events    *events.Status    `json:"events"`
 }
@@ -129,6 +129,7 @@ loop:
 		case outch <- curev:
 			// Event was emitted. Shrink current event buffer.
 			b.evbuf = b.evbuf[1:]
+			bufferedEvents.Dec()
 
 		case ev := <-b.pubch:
 			// publish event
@@ -136,6 +137,7 @@ loop:
 			// Buffer event.
 			if b.eventch != nil {
 				b.evbuf = append(b.evbuf, ev)
+				bufferedEvents.Inc()
 			}
 
 			// Publish to children.
@@ -166,6 +168,9 @@ loop:
 		delete(b.subscriptions, sub)
 	}
 
+	// unread events are dropped
+	bufferedEvents.Sub(float64(len(b.evbuf)))
+
 	if b.parentch != nil {
 		b.parentch <- b
 	}
@@ -177,6 +182,7 @@ func newSubscriber(parent *bus) *bus {
 
 	evbuf := make([]Event, len(parent.evbuf))
 	copy(evbuf, parent.evbuf)
+	bufferedEvents.Add(float64(len(evbuf)))
 
 	sub := &bus{
 		eventch:  make(chan Event),
@@ -0,0 +1,13 @@
+package pubsub
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	bufferedEvents = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "akash_pubsub_buffered_events",
+		Help: "Events published but not yet read, summed over all subscribers",
+	})
+)
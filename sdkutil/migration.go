@@ -0,0 +1,109 @@
+package sdkutil
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	// ErrUnknownMigrationModule is the error when a migration is registered for an unregistered module
+	ErrUnknownMigrationModule = sdkerrors.New("sdkutil", 3, "unknown migration module")
+	// ErrMissingMigration is the error when no migration is registered from a store version
+	ErrMissingMigration = sdkerrors.New("sdkutil", 4, "missing migration")
+	// ErrStoreVersion is the error when a store is newer than its module's consensus version
+	ErrStoreVersion = sdkerrors.New("sdkutil", 5, "store version newer than consensus version")
+)
+
+// InitialStoreVersion is the version of stores written before versions were recorded
+const InitialStoreVersion uint64 = 1
+
+// Migration rewrites the store of a module from one version to the next
+type Migration func(ctx sdk.Context) error
+
+// VersionKeeper reads and writes the version of a module's store
+type VersionKeeper interface {
+	StoreVersion(ctx sdk.Context) uint64
+	SetStoreVersion(ctx sdk.Context, version uint64)
+}
+
+// Migrator migrates the stores of modules to their consensus versions. Modules
+// are migrated in the order they were registered.
+type Migrator struct {
+	modules []*migratorModule
+}
+
+type migratorModule struct {
+	name       string
+	keeper     VersionKeeper
+	version    uint64
+	migrations map[uint64]Migration
+}
+
+// NewMigrator returns a migrator without modules
+func NewMigrator() *Migrator {
+	return &Migrator{}
+}
+
+// RegisterModule registers a module whose store is migrated up to the given consensus version
+func (m *Migrator) RegisterModule(name string, keeper VersionKeeper, version uint64) {
+	m.modules = append(m.modules, &migratorModule{
+		name:       name,
+		keeper:     keeper,
+		version:    version,
+		migrations: make(map[uint64]Migration),
+	})
+}
+
+// RegisterMigration registers the migration of a module's store from version from to from+1
+func (m *Migrator) RegisterMigration(name string, from uint64, fn Migration) error {
+	for _, mod := range m.modules {
+		if mod.name == name {
+			mod.migrations[from] = fn
+			return nil
+		}
+	}
+	return sdkerrors.Wrap(ErrUnknownMigrationModule, name)
+}
+
+// Migrate runs the migrations of every module whose store is older than its
+// consensus version and records the new store versions
+func (m *Migrator) Migrate(ctx sdk.Context) error {
+	for _, mod := range m.modules {
+		version := mod.keeper.StoreVersion(ctx)
+		if version > mod.version {
+			return sdkerrors.Wrapf(ErrStoreVersion, "%v: %v > %v", mod.name, version, mod.version)
+		}
+
+		for ; version < mod.version; version++ {
+			fn, ok := mod.migrations[version]
+			if !ok {
+				return sdkerrors.Wrapf(ErrMissingMigration, "%v: from version %v", mod.name, version)
+			}
+			if err := fn(ctx); err != nil {
+				return sdkerrors.Wrapf(err, "%v: migrating from version %v", mod.name, version)
+			}
+			mod.keeper.SetStoreVersion(ctx, version+1)
+			ctx.Logger().Info("migrated store", "module", mod.name, "version", version+1)
+		}
+	}
+	return nil
+}
+
+// GetStoreVersion returns the store version recorded under key, or
+// InitialStoreVersion if none was
+func GetStoreVersion(store sdk.KVStore, key []byte) uint64 {
+	buf := store.Get(key)
+	if len(buf) != 8 {
+		return InitialStoreVersion
+	}
+	return binary.BigEndian.Uint64(buf)
+}
+
+// SetStoreVersion records the store version under key
+func SetStoreVersion(store sdk.KVStore, key []byte, version uint64) {
+	buf := make([]byte, 8)
+	binary.BigEndian.PutUint64(buf, version)
+	store.Set(key, buf)
+}
@@ -0,0 +1,71 @@
+package sdkutil
+
+import (
+	"encoding/json"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	ErrInvalidPageRequest = sdkerrors.New("sdkutil", 2, "invalid page request")
+)
+
+// PageRequest defines cursor based pagination parameters of list queries.
+// Key is the next_key returned by a previous page; a zero Limit returns all records.
+type PageRequest struct {
+	Key   []byte `json:"key,omitempty"`
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// Bytes returns the encoded page request, suitable as query request data
+func (p PageRequest) Bytes() []byte {
+	buf, _ := json.Marshal(p)
+	return buf
+}
+
+// ParsePageRequest decodes a page request from query request data.
+// Empty data yields an unlimited page starting at the first record.
+func ParsePageRequest(data []byte) (PageRequest, error) {
+	var page PageRequest
+	if len(data) == 0 {
+		return page, nil
+	}
+	if err := json.Unmarshal(data, &page); err != nil {
+		return PageRequest{}, sdkerrors.Wrap(ErrInvalidPageRequest, err.Error())
+	}
+	return page, nil
+}
+
+// PageResponse holds pagination details of a list query result.
+// NextKey is empty on the last page.
+type PageResponse struct {
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
+// Paginate iterates the store entries under pfx starting at the page key.
+// fn reports whether an entry was accepted; iteration stops once the page
+// limit of accepted entries is reached and the key of the following entry
+// is returned as the next page key.
+func Paginate(store sdk.KVStore, pfx []byte, page PageRequest,
+	fn func(key, value []byte) (bool, error)) (PageResponse, error) {
+	iter := prefix.NewStore(store, pfx).Iterator(page.Key, nil)
+	defer iter.Close()
+
+	var count uint64
+	for ; iter.Valid(); iter.Next() {
+		if page.Limit != 0 && count == page.Limit {
+			return PageResponse{NextKey: iter.Key()}, nil
+		}
+
+		accepted, err := fn(iter.Key(), iter.Value())
+		if err != nil {
+			return PageResponse{}, err
+		}
+		if accepted {
+			count++
+		}
+	}
+	return PageResponse{}, nil
+}
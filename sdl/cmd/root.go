@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/sdl"
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	mcli "github.com/ovrclk/akash/x/market/client/cli"
+	"github.com/ovrclk/akash/x/market/query"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+const (
+	flagPercentile = "percentile"
+	flagOutput     = "output-file"
+
+	percentileP10    = "p10"
+	percentileMedian = "median"
+	percentileP90    = "p90"
+)
+
+// ErrNoMarketData is the error when there are no lease prices to suggest pricing from
+var ErrNoMarketData = errors.New("no market data")
+
+// SDLCmd returns the SDL helper commands
+func SDLCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sdl",
+		Short: "SDL helper commands",
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		priceSuggestCmd(cdc),
+	)...)
+
+	return cmd
+}
+
+func priceSuggestCmd(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "price-suggest [sdl-file]",
+		Short: "Fill the pricing of an SDL file from the prices of active leases",
+		Long: "Fill the pricing of every deployed compute profile from the market statistics of active leases.\n" +
+			"When a placement has the --group-by attribute, the statistics of providers with the same attribute " +
+			"value are used if there are any.",
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			sfilters, err := mcli.StatsFiltersFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			percentile, err := cmd.Flags().GetString(flagPercentile)
+			if err != nil {
+				return err
+			}
+			switch percentile {
+			case percentileP10, percentileMedian, percentileP90:
+			default:
+				return fmt.Errorf("invalid percentile: %v", percentile)
+			}
+
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+
+			buf, err := ioutil.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			stats, err := query.NewClient(ctx, mtypes.StoreKey).Stats(sfilters)
+			if err != nil {
+				return err
+			}
+
+			out, err := sdl.SuggestPricing(buf, func(attrs map[string]string, u types.Unit) (sdk.Coin, error) {
+				ustats := stats.Leases
+				if value, ok := attrs[sfilters.Attribute]; ok {
+					ustats = stats.ForAttribute(value)
+				}
+				amount, ok := estimatePrice(ustats, u, percentile)
+				if !ok {
+					return sdk.Coin{}, fmt.Errorf("%w: no active leases priced in %v", ErrNoMarketData, sfilters.Denom)
+				}
+				return sdk.NewCoin(sfilters.Denom, amount), nil
+			})
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				_, err = os.Stdout.Write(out)
+				return err
+			}
+			return ioutil.WriteFile(output, out, 0644)
+		},
+	}
+
+	cmd.Flags().String("denom", dtypes.DefaultPriceDenom, "Denomination to price the deployment in")
+	cmd.Flags().String("group-by", "region", "Placement attribute to match with provider attributes")
+	cmd.Flags().String(flagPercentile, percentileMedian, "Lease price percentile to suggest (p10, median, p90)")
+	cmd.Flags().String(flagOutput, "", "Write the updated SDL to this file instead of stdout")
+
+	return cmd
+}
+
+// estimatePrice returns the price of one instance of the unit. Each statistic
+// attributes the full price of a lease to one resource, so the largest of the
+// per-resource estimates is used, rounded up to a whole amount.
+func estimatePrice(stats query.UnitPriceStats, u types.Unit, percentile string) (sdk.Int, bool) {
+	var (
+		price sdk.Dec
+		found bool
+	)
+
+	estimates := []struct {
+		stats    query.PriceStats
+		quantity sdk.Dec
+	}{
+		{stats.CPU, sdk.NewDec(int64(u.CPU))},
+		{stats.Memory, sdk.NewDec(int64(u.Memory)).QuoInt64(int64(unit.Gi))},
+		{stats.Storage, sdk.NewDec(int64(u.Storage)).QuoInt64(int64(unit.Gi))},
+	}
+
+	for _, est := range estimates {
+		if est.stats.Count == 0 || est.quantity.IsZero() {
+			continue
+		}
+
+		var unitPrice sdk.Dec
+		switch percentile {
+		case percentileP10:
+			unitPrice = est.stats.P10
+		case percentileP90:
+			unitPrice = est.stats.P90
+		default:
+			unitPrice = est.stats.Median
+		}
+
+		if value := unitPrice.Mul(est.quantity); !found || value.GT(price) {
+			price = value
+			found = true
+		}
+	}
+
+	if !found {
+		return sdk.Int{}, false
+	}
+
+	amount := price.Ceil().TruncateInt()
+	if amount.LT(sdk.OneInt()) {
+		amount = sdk.OneInt()
+	}
+	return amount, true
+}
@@ -0,0 +1,89 @@
+package sdl
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	yaml "gopkg.in/yaml.v2"
+
+	"github.com/ovrclk/akash/types"
+)
+
+// PriceFunc returns the price of one instance of a compute unit deployed
+// to a placement with the given attributes
+type PriceFunc func(attributes map[string]string, unit types.Unit) (sdk.Coin, error)
+
+// SuggestPricing fills the pricing of every compute profile deployed to each
+// placement with the price returned by fn, leaving the rest of the SDL intact.
+// Pricing that is already present is replaced.
+func SuggestPricing(buf []byte, fn PriceFunc) ([]byte, error) {
+	obj := &v1{}
+	if err := yaml.Unmarshal(buf, obj); err != nil {
+		return nil, err
+	}
+
+	if err := obj.Validate(); err != nil {
+		return nil, err
+	}
+
+	// edit the generic document so that key ordering and unknown fields are kept
+	var doc yaml.MapSlice
+	if err := yaml.Unmarshal(buf, &doc); err != nil {
+		return nil, err
+	}
+
+	for _, svcName := range v1DeploymentSvcNames(obj.Deployments) {
+		depl := obj.Deployments[svcName]
+
+		for _, placementName := range v1DeploymentPlacementNames(depl) {
+			svcdepl := depl[placementName]
+
+			compute, ok := obj.Profiles.Compute[svcdepl.Profile]
+			if !ok {
+				return nil, errors.Errorf("%v.%v: no compute profile named %v", svcName, placementName, svcdepl.Profile)
+			}
+
+			infra, ok := obj.Profiles.Placement[placementName]
+			if !ok {
+				return nil, errors.Errorf("%v.%v: no placement profile named %v", svcName, placementName, placementName)
+			}
+
+			price, err := fn(infra.Attributes, types.Unit{
+				CPU:     uint32(compute.CPU),
+				Memory:  uint64(compute.Memory),
+				Storage: uint64(compute.Storage),
+			})
+			if err != nil {
+				return nil, errors.Wrapf(err, "%v.%v", svcName, placementName)
+			}
+
+			doc = setYAMLPath(doc, []string{"profiles", "placement", placementName, "pricing", svcdepl.Profile},
+				yaml.MapSlice{
+					{Key: "denom", Value: price.Denom},
+					{Key: "amount", Value: price.Amount.String()},
+				})
+		}
+	}
+
+	return yaml.Marshal(doc)
+}
+
+// setYAMLPath sets the value at the given path of keys, creating missing mappings
+func setYAMLPath(doc yaml.MapSlice, path []string, value interface{}) yaml.MapSlice {
+	for idx := range doc {
+		if doc[idx].Key != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			doc[idx].Value = value
+			return doc
+		}
+		child, _ := doc[idx].Value.(yaml.MapSlice)
+		doc[idx].Value = setYAMLPath(child, path[1:], value)
+		return doc
+	}
+
+	if len(path) == 1 {
+		return append(doc, yaml.MapItem{Key: path[0], Value: value})
+	}
+	return append(doc, yaml.MapItem{Key: path[0], Value: setYAMLPath(nil, path[1:], value)})
+}
@@ -0,0 +1,54 @@
+package sdl_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/sdl"
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
+)
+
+func Test_SuggestPricing(t *testing.T) {
+	buf, err := ioutil.ReadFile("./_testdata/simple.yaml")
+	require.NoError(t, err)
+
+	out, err := sdl.SuggestPricing(buf, func(attrs map[string]string, u types.Unit) (sdk.Coin, error) {
+		assert.Equal(t, "us-west", attrs["region"])
+		assert.Equal(t, types.Unit{CPU: 100, Memory: 128 * unit.Mi, Storage: 1 * unit.Gi}, u)
+		return sdk.NewInt64Coin("akash", 75), nil
+	})
+	require.NoError(t, err)
+
+	obj, err := sdl.Read(out)
+	require.NoError(t, err)
+
+	groups, err := obj.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+	require.Len(t, groups[0].Resources, 1)
+	assert.Equal(t, sdk.NewInt64Coin("akash", 75), groups[0].Resources[0].Price)
+	assert.Equal(t, uint32(2), groups[0].Resources[0].Count)
+}
+
+func Test_SuggestPricingMissing(t *testing.T) {
+	buf, err := ioutil.ReadFile("./_testdata/simple.yaml")
+	require.NoError(t, err)
+
+	buf = []byte(strings.Replace(string(buf), "      pricing:\n        web:\n          denom: akash\n          amount: 50\n", "", 1))
+	_, err = sdl.Read(buf)
+	require.Error(t, err)
+
+	out, err := sdl.SuggestPricing(buf, func(map[string]string, types.Unit) (sdk.Coin, error) {
+		return sdk.NewInt64Coin("akash", 10), nil
+	})
+	require.NoError(t, err)
+
+	_, err = sdl.Read(out)
+	require.NoError(t, err)
+}
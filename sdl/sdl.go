@@ -58,9 +58,9 @@ func Read(buf []byte) (SDL, error) {
 	if err := validation.ValidateManifest(m); err != nil {
 		return nil, err
 	}
-	// if err := validation.ValidateManifestWithGroupSpecs(m, dgroups); err != nil {
-	// 	return nil, err
-	// }
+	if err := validation.ValidateManifestWithGroupSpecs(&m, dgroups); err != nil {
+		return nil, err
+	}
 
 	return obj, nil
 }
@@ -68,9 +68,15 @@ type v1ComputeProfile struct {
 
 type v1PlacementProfile struct {
 	Attributes map[string]string
+	SignedBy   v1SignedBy `yaml:"signedBy"`
 	Pricing    map[string]v1PricingProfile
 }
 
+type v1SignedBy struct {
+	AllOf []string `yaml:"allOf"`
+	AnyOf []string `yaml:"anyOf"`
+}
+
 // TODO: make coin parsing "just work".  wtf.
 type v1PricingProfile struct {
 	Denom  string
@@ -141,6 +147,10 @@ func (sdl *v1) DeploymentGroups() ([]*dtypes.GroupSpec, error) {
 			if group == nil {
 				group = &dtypes.GroupSpec{
 					Name: placementName,
+					SignedBy: dtypes.SignedBy{
+						AllOf: infra.SignedBy.AllOf,
+						AnyOf: infra.SignedBy.AnyOf,
+					},
 				}
 
 				for k, v := range infra.Attributes {
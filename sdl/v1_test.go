@@ -3,6 +3,7 @@ package sdl_test
 import (
 	"testing"
 
+	"github.com/ovrclk/akash/cmd/common"
 	"github.com/ovrclk/akash/sdl"
 	"github.com/ovrclk/akash/types"
 	"github.com/ovrclk/akash/types/unit"
@@ -79,6 +80,20 @@ func Test_v1_Parse_simple(t *testing.T) {
 
 }
 
+func Test_v1_Parse_SignedBy(t *testing.T) {
+	common.InitSDKConfig()
+
+	sdl, err := sdl.ReadFile("./_testdata/signed-by.yaml")
+	require.NoError(t, err)
+
+	groups, err := sdl.DeploymentGroups()
+	require.NoError(t, err)
+	require.Len(t, groups, 1)
+
+	assert.Empty(t, groups[0].SignedBy.AllOf)
+	assert.Equal(t, []string{"akash1qtqpdszzakz7ugkey7ka2cmss95z26ygar2mgr"}, groups[0].SignedBy.AnyOf)
+}
+
 func Test_v1_Parse_ProfileNameNotServiceName(t *testing.T) {
 	sdl, err := sdl.ReadFile("./_testdata/profile-svc-name-mismatch.yaml")
 	require.NoError(t, err)
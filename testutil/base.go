@@ -52,7 +52,7 @@ func Resources(t testing.TB) []dtypes.Resource {
 
 	vals := make([]dtypes.Resource, 0, count)
 	for i := 0; i < count; i++ {
-		coin := sdk.NewCoin(CoinDenom, sdk.NewInt(rand.Int63n(9999)))
+		coin := sdk.NewCoin(CoinDenom, sdk.NewInt(rand.Int63n(9998)+1))
 		res := dtypes.Resource{
 			Unit: types.Unit{
 				CPU:     100,
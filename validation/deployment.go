@@ -1,6 +1,7 @@
 package validation
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
 	"github.com/ovrclk/akash/types"
@@ -22,6 +23,9 @@ func ValidateDeploymentGroups(gspecs []dtypes.GroupSpec) error {
 		if err := validateGroupPricing(defaultConfig, group); err != nil {
 			return err
 		}
+		if err := validateSignedBy(group); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -34,5 +38,18 @@ func ValidateDeploymentGroup(gspec dtypes.GroupSpec) error {
 	if err := validateGroupPricing(defaultConfig, gspec); err != nil {
 		return err
 	}
+	if err := validateSignedBy(gspec); err != nil {
+		return err
+	}
+	return nil
+}
+
+func validateSignedBy(gspec dtypes.GroupSpec) error {
+	auditors := append(append([]string{}, gspec.SignedBy.AllOf...), gspec.SignedBy.AnyOf...)
+	for _, auditor := range auditors {
+		if _, err := sdk.AccAddressFromBech32(auditor); err != nil {
+			return errors.Errorf("group %v: invalid auditor address %q", gspec.GetName(), auditor)
+		}
+	}
 	return nil
 }
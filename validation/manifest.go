@@ -1,6 +1,10 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"strings"
+
 	"github.com/pkg/errors"
 
 	"github.com/ovrclk/akash/manifest"
@@ -8,19 +12,99 @@ import (
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
+// ErrInvalidManifest is the error when a manifest fails validation
+var ErrInvalidManifest = errors.New("invalid manifest")
+
+var (
+	// docker image reference: [host[:port]/]path[:tag][@digest]
+	imageRegexp = regexp.MustCompile(`^(?:[a-zA-Z0-9.-]+(?::[0-9]+)?/)?` +
+		`[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*(?:/[a-z0-9]+(?:(?:[._]|__|-+)[a-z0-9]+)*)*` +
+		`(?::[a-zA-Z0-9_][a-zA-Z0-9_.-]{0,127})?(?:@sha256:[a-f0-9]{64})?$`)
+
+	serviceNameRegexp = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+
+	hostnameRegexp = regexp.MustCompile(`^(?:\*\.)?[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?(?:\.[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?)*$`)
+)
+
 // ValidateManifest does validation for manifest
 func ValidateManifest(m manifest.Manifest) error {
 	return validateManifestGroups(m.GetGroups())
 }
 
 func validateManifestGroups(groups []manifest.Group) error {
-	// rlists := make([]hasResources, 0, len(groups))
-	// for _, group := range groups {
-	// 	rlists = append(rlists, group)
-	// }
-	// if err := validateResourceLists(defaultConfig, rlists); err != nil {
-	// 	return fmt.Errorf("manifest groups: %v", err)
-	// }
+	names := make(map[string]bool, len(groups))
+	for _, group := range groups {
+		if group.GetName() == "" {
+			return fmt.Errorf("%w: %v", ErrInvalidManifest, ErrGroupEmptyName)
+		}
+		if names[group.GetName()] {
+			return fmt.Errorf("%w: duplicate group (%v)", ErrInvalidManifest, group.GetName())
+		}
+		names[group.GetName()] = true
+
+		if err := validateManifestGroup(group); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateManifestGroup(group manifest.Group) error {
+	if len(group.Services) == 0 {
+		return fmt.Errorf("%w: group %v: no services", ErrInvalidManifest, group.GetName())
+	}
+
+	names := make(map[string]bool, len(group.Services))
+	for _, svc := range group.Services {
+		if names[svc.Name] {
+			return fmt.Errorf("%w: group %v: duplicate service (%v)", ErrInvalidManifest, group.GetName(), svc.Name)
+		}
+		names[svc.Name] = true
+
+		if err := validateManifestService(svc); err != nil {
+			return fmt.Errorf("%w: group %v: %v", ErrInvalidManifest, group.GetName(), err)
+		}
+	}
+	return nil
+}
+
+func validateManifestService(svc manifest.Service) error {
+	if !serviceNameRegexp.MatchString(svc.Name) {
+		return errors.Errorf("service %v: invalid name", svc.Name)
+	}
+
+	if !imageRegexp.MatchString(svc.Image) {
+		return errors.Errorf("service %v: invalid image (%v)", svc.Name, svc.Image)
+	}
+
+	if svc.Count == 0 {
+		return errors.Errorf("service %v: zero count", svc.Name)
+	}
+
+	for _, expose := range svc.Expose {
+		if err := validateManifestExpose(expose); err != nil {
+			return errors.Wrapf(err, "service %v", svc.Name)
+		}
+	}
+	return nil
+}
+
+func validateManifestExpose(expose manifest.ServiceExpose) error {
+	if expose.Port == 0 {
+		return errors.Errorf("invalid port (%v)", expose.Port)
+	}
+
+	switch strings.ToUpper(expose.Proto) {
+	case "", "TCP", "UDP":
+	default:
+		return errors.Errorf("port %v: invalid protocol (%v)", expose.Port, expose.Proto)
+	}
+
+	for _, host := range expose.Hosts {
+		if len(host) > 253 || !hostnameRegexp.MatchString(host) {
+			return errors.Errorf("port %v: invalid host (%v)", expose.Port, host)
+		}
+	}
 	return nil
 }
 
@@ -33,21 +117,20 @@ func ValidateManifestWithGroupSpecs(m *manifest.Manifest, gspecs []*dtypes.Group
 	return validateManifestDeploymentGroups(m.GetGroups(), rlists)
 }
 
-// ValidateManifestWithDeployment does basic validation and returns nil
+// ValidateManifestWithDeployment validates that the manifest groups match the deployment
+// groups by name and that their services use exactly the units and counts ordered
 func ValidateManifestWithDeployment(m *manifest.Manifest, dgroups []dtypes.Group) error {
-	// TODO
-	// rlists := make([]types.ResourceList, 0, len(dgroups))
-	// for _, dgroup := range dgroups {
-	// 	rlists = append(rlists, dgroup)
-	// }
-	// return validateManifestDeploymentGroups(m, rlists)
-	return nil
+	rlists := make([]types.ResourceGroup, 0, len(dgroups))
+	for _, dgroup := range dgroups {
+		rlists = append(rlists, dgroup)
+	}
+	return validateManifestDeploymentGroups(m.GetGroups(), rlists)
 }
 
 func validateManifestDeploymentGroups(mgroups []manifest.Group, dgroups []types.ResourceGroup) error {
 
 	if len(mgroups) != len(dgroups) {
-		return errors.Errorf("invalid manifest: group count mismatch (%v != %v)", len(mgroups), len(dgroups))
+		return fmt.Errorf("%w: group count mismatch (%v != %v)", ErrInvalidManifest, len(mgroups), len(dgroups))
 	}
 
 mainloop:
@@ -61,7 +144,7 @@ mainloop:
 			}
 			continue mainloop
 		}
-		return errors.Errorf("invalid manifest: unknown deployment group ('%v')", mgroup.GetName())
+		return fmt.Errorf("%w: unknown deployment group ('%v')", ErrInvalidManifest, mgroup.GetName())
 	}
 	return nil
 }
@@ -98,12 +181,12 @@ mainloop:
 				continue mainloop
 			}
 		}
-		return errors.Errorf("invalid manifest: unused deployment resources ('%v')", dgroup.GetName())
+		return fmt.Errorf("%w: unused deployment resources ('%v')", ErrInvalidManifest, dgroup.GetName())
 	}
 
 	for _, mrec := range mlist {
 		if mrec.Count > 0 {
-			return errors.Errorf("invalid manifest: excess manifest resources ('%v')", mgroup.GetName())
+			return fmt.Errorf("%w: excess manifest resources ('%v')", ErrInvalidManifest, mgroup.GetName())
 		}
 	}
 
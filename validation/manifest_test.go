@@ -1,6 +1,7 @@
 package validation_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ovrclk/akash/manifest"
@@ -261,3 +262,95 @@ func Test_ValidateManifest(t *testing.T) {
 	}
 
 }
+
+func Test_ValidateManifestServices(t *testing.T) {
+	valid := func() manifest.Group {
+		return manifest.Group{
+			Name: "foo",
+			Services: []manifest.Service{
+				{
+					Name:  "web",
+					Image: "quay.io/ovrclk/demo-app:v1.0",
+					Unit:  types.Unit{CPU: randCPU1, Memory: randMemory, Storage: randStorage},
+					Count: 1,
+					Expose: []manifest.ServiceExpose{
+						{Port: 80, Proto: "tcp", Hosts: []string{"example.com", "*.example.com"}},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		ok     bool
+		mutate func(*manifest.Group)
+	}{
+		{name: "valid", ok: true, mutate: func(*manifest.Group) {}},
+		{name: "empty-group-name", mutate: func(g *manifest.Group) { g.Name = "" }},
+		{name: "no-services", mutate: func(g *manifest.Group) { g.Services = nil }},
+		{name: "duplicate-service", mutate: func(g *manifest.Group) { g.Services = append(g.Services, g.Services[0]) }},
+		{name: "invalid-service-name", mutate: func(g *manifest.Group) { g.Services[0].Name = "Web_1" }},
+		{name: "empty-image", mutate: func(g *manifest.Group) { g.Services[0].Image = "" }},
+		{name: "invalid-image", mutate: func(g *manifest.Group) { g.Services[0].Image = "nginx latest" }},
+		{name: "digest-image", ok: true, mutate: func(g *manifest.Group) {
+			g.Services[0].Image = "nginx@sha256:" + "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+		}},
+		{name: "zero-count", mutate: func(g *manifest.Group) { g.Services[0].Count = 0 }},
+		{name: "zero-port", mutate: func(g *manifest.Group) { g.Services[0].Expose[0].Port = 0 }},
+		{name: "invalid-proto", mutate: func(g *manifest.Group) { g.Services[0].Expose[0].Proto = "sctp" }},
+		{name: "invalid-host", mutate: func(g *manifest.Group) { g.Services[0].Expose[0].Hosts = []string{"bad host"} }},
+	}
+
+	for _, test := range tests {
+		group := valid()
+		test.mutate(&group)
+		err := validation.ValidateManifest(manifest.Manifest{group})
+		if test.ok {
+			assert.NoError(t, err, test.name)
+		} else {
+			assert.Error(t, err, test.name)
+			assert.True(t, errors.Is(err, validation.ErrInvalidManifest), test.name)
+		}
+	}
+}
+
+func Test_ValidateManifestWithDeployment(t *testing.T) {
+	unit := types.Unit{CPU: 100, Memory: randMemory, Storage: randStorage}
+
+	dgroups := []dtypes.Group{
+		{
+			GroupSpec: dtypes.GroupSpec{
+				Name:      "foo",
+				Resources: []dtypes.Resource{{Unit: unit, Count: 1}},
+			},
+		},
+	}
+
+	m := manifest.Manifest{
+		{
+			Name: "foo",
+			Services: []manifest.Service{
+				{Name: "web", Image: "nginx", Unit: unit, Count: 1},
+			},
+		},
+	}
+	assert.NoError(t, validation.ValidateManifestWithDeployment(&m, dgroups))
+
+	// more replicas than leased
+	m[0].Services[0].Count = 10
+	err := validation.ValidateManifestWithDeployment(&m, dgroups)
+	assert.True(t, errors.Is(err, validation.ErrInvalidManifest))
+
+	// larger units than leased
+	m[0].Services[0].Count = 1
+	m[0].Services[0].Unit.CPU = 2000
+	err = validation.ValidateManifestWithDeployment(&m, dgroups)
+	assert.True(t, errors.Is(err, validation.ErrInvalidManifest))
+
+	// unknown group
+	m[0].Services[0].Unit = unit
+	m[0].Name = "bar"
+	err = validation.ValidateManifestWithDeployment(&m, dgroups)
+	assert.True(t, errors.Is(err, validation.ErrInvalidManifest))
+}
@@ -26,7 +26,7 @@ func validateGroupPricing(config ValConfig, gspec dtypes.GroupSpec) error {
 		} else {
 			rprice := resource.FullPrice()
 			if rprice.Denom != price.Denom {
-				return errors.Errorf("multi-denonimation group: (%v == %v fails)", rprice.Denom, price.Denom)
+				return errors.Errorf("multi-denomination group: (%v == %v fails)", rprice.Denom, price.Denom)
 			}
 			price = price.Add(rprice)
 		}
@@ -54,7 +54,7 @@ func validateUnitPricing(config ValConfig, rg dtypes.Resource) error {
 		return errors.Errorf("error: invalid unit price (%v > %v fails)", config.MaxUnitPrice, rg.Price)
 	}
 
-	if rg.Price.Amount.GT(sdk.NewIntFromUint64(uint64(config.MaxUnitPrice))) {
+	if rg.Price.Amount.LT(sdk.NewIntFromUint64(uint64(config.MinUnitPrice))) {
 		return errors.Errorf("error: invalid unit price (%v < %v fails)", config.MinUnitPrice, rg.Price)
 	}
 	return nil
@@ -71,15 +71,6 @@ func validateResourceList(config ValConfig, rlist types.ResourceGroup) error {
 		cpu = cpu.Add(sdk.NewUint(uint64(resource.Unit.CPU)).MulUint64(uint64(resource.Count)))
 		mem = mem.Add(sdk.NewUint(resource.Unit.Memory).MulUint64(uint64(resource.Count)))
 		storage = storage.Add(sdk.NewUint(resource.Unit.Storage).MulUint64(uint64(resource.Count)))
-
-		// TODO: validate pricing
-		// if idx == 0 {
-		// 	price = resource.Price
-		// } else {
-		// 	if resource.Price.Denom != price.Denom {
-		// 		return fmt.Errorf("mixed denonimations: (%v != %v)", price.Denom, resource.Price.Denom)
-		// 	}
-		// }
 	}
 
 	if cpu.GT(sdk.NewUint(uint64(config.MaxGroupCPU))) || cpu.LTE(sdk.ZeroUint()) {
@@ -109,11 +100,6 @@ func validateResourceGroup(config ValConfig, rg types.Resource) error {
 			config.MaxUnitCount, rg.Count, config.MinUnitCount)
 	}
 
-	// TODO: validate pricing
-	// if !rg.Price.IsPositive() {
-	// 	return fmt.Errorf("error: invalid unit price (not positive fails)")
-	// }
-
 	return nil
 }
 
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/audit/query"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// GetQueryCmd returns the query commands for the audit module
+func GetQueryCmd(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Audit query commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		cmdGetProviders(key, cdc),
+		cmdGetProvider(key, cdc),
+	)...)
+
+	return cmd
+}
+
+func cmdGetProviders(key string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Query for all audited provider attributes",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			obj, err := query.NewClient(ctx, key).AllProvidersAttributes()
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+}
+
+func cmdGetProvider(key string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get [provider] [auditor]",
+		Short: "Query audited attributes of a provider, optionally only those signed by one auditor",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				obj, err := query.NewClient(ctx, key).ProviderAttributes(owner)
+				if err != nil {
+					return err
+				}
+				return ctx.PrintOutput(obj)
+			}
+
+			auditor, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			obj, err := query.NewClient(ctx, key).ProviderAuditorAttributes(types.ProviderID{
+				Owner:   owner,
+				Auditor: auditor,
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+}
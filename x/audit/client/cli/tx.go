@@ -0,0 +1,126 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// GetTxCmd returns the transaction commands for audit module
+func GetTxCmd(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Audit transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(cmdAttributes(key, cdc))
+	return cmd
+}
+
+func cmdAttributes(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "attr",
+		Short:                      "Provider attributes subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(flags.PostCommands(
+		cmdSignAttributes(key, cdc),
+		cmdDeleteAttributes(key, cdc),
+	)...)
+	return cmd
+}
+
+func cmdSignAttributes(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [provider] [key=value]...",
+		Short: "Sign provider attributes as the auditor",
+		Args:  cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			attrs, err := parseAttributes(args[1:])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSignProviderAttributes{
+				Owner:      owner,
+				Auditor:    ctx.GetFromAddress(),
+				Attributes: attrs,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func cmdDeleteAttributes(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete [provider] [key]...",
+		Short: "Revoke provider attributes signed by the auditor; all of them when no keys are given",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeleteProviderAttributes{
+				Owner:   owner,
+				Auditor: ctx.GetFromAddress(),
+				Keys:    args[1:],
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func parseAttributes(args []string) ([]sdk.Attribute, error) {
+	attrs := make([]sdk.Attribute, 0, len(args))
+	for _, arg := range args {
+		parts := strings.SplitN(arg, "=", 2)
+		if len(parts) != 2 {
+			return nil, errors.Wrap(types.ErrAttributes, fmt.Sprintf("%q is not in key=value form", arg))
+		}
+		attrs = append(attrs, sdk.NewAttribute(parts[0], parts[1]))
+	}
+	return attrs, nil
+}
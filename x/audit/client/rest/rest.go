@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/ovrclk/akash/x/audit/query"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// RegisterRoutes registers all query routes
+func RegisterRoutes(ctx context.CLIContext, r *mux.Router, ns string) {
+	// Get all audited attributes
+	r.HandleFunc(fmt.Sprintf("/%s/list", ns), listProvidersHandler(ctx, ns)).Methods("GET")
+
+	// Get audited attributes of a single provider
+	r.HandleFunc(fmt.Sprintf("/%s/attributes/{providerOwner}", ns), getProviderHandler(ctx, ns)).Methods("GET")
+
+	// Get attributes one auditor signed for a single provider
+	r.HandleFunc(fmt.Sprintf("/%s/attributes/{providerOwner}/{auditor}", ns), getProviderAuditorHandler(ctx, ns)).Methods("GET")
+}
+
+func listProvidersHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := query.NewRawClient(ctx, ns).AllProvidersAttributes()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getProviderHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["providerOwner"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		res, err := query.NewRawClient(ctx, ns).ProviderAttributes(owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getProviderAuditorHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["providerOwner"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		auditor, err := sdk.AccAddressFromBech32(mux.Vars(r)["auditor"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		res, err := query.NewRawClient(ctx, ns).ProviderAuditorAttributes(types.ProviderID{
+			Owner:   owner,
+			Auditor: auditor,
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
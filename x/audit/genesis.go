@@ -0,0 +1,60 @@
+package audit
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// GenesisState defines the basic genesis state used by audit module
+type GenesisState struct {
+	Params     types.Params     `json:"params"`
+	Attributes []types.Provider `json:"attributes"`
+}
+
+// ValidateGenesis does validation check of the Genesis and returns error incase of failure
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+	for _, record := range data.Attributes {
+		msg := types.MsgSignProviderAttributes(record)
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis initiate genesis state and return updated validator details
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetStoreVersion(ctx, types.ConsensusVersion)
+	keeper.SetParams(ctx, data.Params)
+	for _, record := range data.Attributes {
+		keeper.CreateOrUpdateProviderAttributes(ctx, record.ID(), record.Attributes)
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns genesis state for the audit module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
+	var records []types.Provider
+	k.WithProviders(ctx, func(record types.Provider) bool {
+		records = append(records, record)
+		return false
+	})
+	return GenesisState{
+		Params:     k.GetParams(ctx),
+		Attributes: records,
+	}
+}
+
+// DefaultGenesisState returns default genesis state as raw bytes for the audit
+// module.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: types.DefaultParams(),
+	}
+}
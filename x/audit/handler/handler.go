@@ -0,0 +1,61 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// NewHandler returns a handler for "audit" type messages.
+func NewHandler(keeper keeper.Keeper, pkeeper ProviderKeeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.MsgSignProviderAttributes:
+			return handleMsgSignProviderAttributes(ctx, keeper, pkeeper, msg)
+		case types.MsgDeleteProviderAttributes:
+			return handleMsgDeleteProviderAttributes(ctx, keeper, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized audit message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgSignProviderAttributes(ctx sdk.Context, keeper keeper.Keeper, pkeeper ProviderKeeper,
+	msg types.MsgSignProviderAttributes) (*sdk.Result, error) {
+	if !keeper.GetParams(ctx).IsAuditor(msg.Auditor) {
+		return nil, types.ErrUnknownAuditor
+	}
+
+	if _, found := pkeeper.Get(ctx, msg.Owner); !found {
+		return nil, types.ErrUnknownProvider
+	}
+
+	id := types.ProviderID{
+		Owner:   msg.Owner,
+		Auditor: msg.Auditor,
+	}
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, msg.Attributes)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgDeleteProviderAttributes(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgDeleteProviderAttributes) (*sdk.Result, error) {
+	id := types.ProviderID{
+		Owner:   msg.Owner,
+		Auditor: msg.Auditor,
+	}
+
+	if err := keeper.DeleteProviderAttributes(ctx, id, msg.Keys); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
@@ -0,0 +1,103 @@
+package handler_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/audit/handler"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+	pkeeper "github.com/ovrclk/akash/x/provider/keeper"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+type testSuite struct {
+	t       testing.TB
+	ctx     sdk.Context
+	keeper  keeper.Keeper
+	pkeeper pkeeper.Keeper
+	handler sdk.Handler
+}
+
+func setupTestSuite(t testing.TB) *testSuite {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	pKey := sdk.NewKVStoreKey(ptypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
+
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	pk := params.NewKeeper(app.MakeCodec(), paramsKey, paramsTKey)
+
+	suite := &testSuite{
+		t:       t,
+		ctx:     sdk.NewContext(ms, abci.Header{}, true, testutil.Logger(t)),
+		keeper:  keeper.NewKeeper(app.MakeCodec(), key, pk.Subspace(types.DefaultParamspace)),
+		pkeeper: pkeeper.NewKeeper(app.MakeCodec(), pKey, pk.Subspace(ptypes.DefaultParamspace)),
+	}
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
+	suite.handler = handler.NewHandler(suite.keeper, suite.pkeeper)
+	return suite
+}
+
+func TestSignProviderAttributes(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	provider := testutil.Provider(t)
+	require.NoError(t, suite.pkeeper.Create(suite.ctx, provider))
+
+	msg := types.MsgSignProviderAttributes{
+		Owner:      provider.Owner,
+		Auditor:    testutil.AccAddress(t),
+		Attributes: []sdk.Attribute{sdk.NewAttribute("region", "us-west")},
+	}
+
+	// the signer is not an auditor
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrUnknownAuditor))
+
+	suite.keeper.SetParams(suite.ctx, types.Params{Auditors: []sdk.AccAddress{msg.Auditor}})
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	record, found := suite.keeper.GetProviderByAuditor(suite.ctx, types.ProviderID{Owner: msg.Owner, Auditor: msg.Auditor})
+	require.True(t, found)
+	require.Equal(t, msg.Attributes, record.Attributes)
+}
+
+func TestSignProviderAttributesUnknownProvider(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	msg := types.MsgSignProviderAttributes{
+		Owner:      testutil.AccAddress(t),
+		Auditor:    testutil.AccAddress(t),
+		Attributes: []sdk.Attribute{sdk.NewAttribute("region", "us-west")},
+	}
+	suite.keeper.SetParams(suite.ctx, types.Params{Auditors: []sdk.AccAddress{msg.Auditor}})
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrUnknownProvider))
+
+	_, found := suite.keeper.GetProviderByAuditor(suite.ctx, types.ProviderID{Owner: msg.Owner, Auditor: msg.Auditor})
+	require.False(t, found)
+}
@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"sort"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// Keeper of the audit store
+type Keeper struct {
+	skey   sdk.StoreKey
+	cdc    *codec.Codec
+	pspace params.Subspace
+}
+
+// NewKeeper creates and returns an instance for audit keeper
+func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey, pspace params.Subspace) Keeper {
+	if !pspace.HasKeyTable() {
+		pspace = pspace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		skey:   skey,
+		cdc:    cdc,
+		pspace: pspace,
+	}
+}
+
+// Codec returns keeper codec
+func (k Keeper) Codec() *codec.Codec {
+	return k.cdc
+}
+
+// GetParams returns the audit module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.pspace.GetParamSet(ctx, &params)
+	return
+}
+
+// SetParams sets the audit module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
+// GetProviderByAuditor returns the attributes signed by the given auditor for a provider
+func (k Keeper) GetProviderByAuditor(ctx sdk.Context, id types.ProviderID) (types.Provider, bool) {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(id)
+
+	if !store.Has(key) {
+		return types.Provider{}, false
+	}
+
+	var val types.Provider
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &val)
+	return val, true
+}
+
+// GetProviderAttributes returns the attributes signed by every auditor for a provider
+func (k Keeper) GetProviderAttributes(ctx sdk.Context, owner sdk.AccAddress) types.Providers {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, providerPrefixKey(owner))
+	defer iter.Close()
+
+	var vals types.Providers
+	for ; iter.Valid(); iter.Next() {
+		var val types.Provider
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// CreateOrUpdateProviderAttributes merges the given attributes into those already
+// signed by the auditor for the provider
+func (k Keeper) CreateOrUpdateProviderAttributes(ctx sdk.Context, id types.ProviderID, attrs []sdk.Attribute) {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(id)
+
+	prov := types.Provider{
+		Owner:   id.Owner,
+		Auditor: id.Auditor,
+	}
+
+	if store.Has(key) {
+		k.cdc.MustUnmarshalBinaryBare(store.Get(key), &prov)
+	}
+
+	merged := make(map[string]string, len(prov.Attributes)+len(attrs))
+	for _, attr := range prov.Attributes {
+		merged[attr.Key] = attr.Value
+	}
+	for _, attr := range attrs {
+		merged[attr.Key] = attr.Value
+	}
+
+	prov.Attributes = prov.Attributes[:0]
+	for key, value := range merged {
+		prov.Attributes = append(prov.Attributes, sdk.NewAttribute(key, value))
+	}
+
+	// keep ordering stable
+	sort.Slice(prov.Attributes, func(i, j int) bool {
+		return prov.Attributes[i].Key < prov.Attributes[j].Key
+	})
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(prov))
+
+	ctx.EventManager().EmitEvent(
+		types.EventProviderAttributesSigned{ID: id}.ToSDKEvent(),
+	)
+}
+
+// DeleteProviderAttributes revokes the given attribute keys signed by the auditor for a provider.
+// All attributes are revoked when keys is empty.
+func (k Keeper) DeleteProviderAttributes(ctx sdk.Context, id types.ProviderID, keys []string) error {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(id)
+
+	if !store.Has(key) {
+		return types.ErrProviderNotFound
+	}
+
+	var prov types.Provider
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &prov)
+
+	if len(keys) != 0 {
+		remove := make(map[string]bool, len(keys))
+		for _, key := range keys {
+			remove[key] = true
+		}
+
+		attrs := prov.Attributes[:0]
+		for _, attr := range prov.Attributes {
+			if !remove[attr.Key] {
+				attrs = append(attrs, attr)
+			}
+		}
+		prov.Attributes = attrs
+	}
+
+	if len(keys) == 0 || len(prov.Attributes) == 0 {
+		store.Delete(key)
+	} else {
+		store.Set(key, k.cdc.MustMarshalBinaryBare(prov))
+	}
+
+	ctx.EventManager().EmitEvent(
+		types.EventProviderAttributesDeleted{ID: id}.ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// WithProviders iterates all audited attributes
+func (k Keeper) WithProviders(ctx sdk.Context, fn func(types.Provider) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, providerPrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var val types.Provider
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,156 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+func TestProviderAttributesCreate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testProviderID(t)
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, []sdk.Attribute{
+		sdk.NewAttribute("region", "us-west"),
+	})
+
+	prov, found := keeper.GetProviderByAuditor(ctx, id)
+	require.True(t, found)
+	require.Equal(t, id, prov.ID())
+	require.Equal(t, []sdk.Attribute{sdk.NewAttribute("region", "us-west")}, prov.Attributes)
+}
+
+func TestProviderAttributesMerge(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testProviderID(t)
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, []sdk.Attribute{
+		sdk.NewAttribute("region", "us-west"),
+		sdk.NewAttribute("tier", "1"),
+	})
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, []sdk.Attribute{
+		sdk.NewAttribute("region", "us-east"),
+		sdk.NewAttribute("capabilities", "gpu"),
+	})
+
+	prov, found := keeper.GetProviderByAuditor(ctx, id)
+	require.True(t, found)
+	require.Equal(t, []sdk.Attribute{
+		sdk.NewAttribute("capabilities", "gpu"),
+		sdk.NewAttribute("region", "us-east"),
+		sdk.NewAttribute("tier", "1"),
+	}, prov.Attributes)
+}
+
+func TestProviderAttributesGetNonExisting(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testProviderID(t)
+
+	prov, found := keeper.GetProviderByAuditor(ctx, id)
+	require.False(t, found)
+	require.Equal(t, types.Provider{}, prov)
+	require.Empty(t, keeper.GetProviderAttributes(ctx, id.Owner))
+}
+
+func TestProviderAttributesMultipleAuditors(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testProviderID(t)
+	id2 := types.ProviderID{Owner: id.Owner, Auditor: testutil.AccAddress(t)}
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, []sdk.Attribute{sdk.NewAttribute("region", "us-west")})
+	keeper.CreateOrUpdateProviderAttributes(ctx, id2, []sdk.Attribute{sdk.NewAttribute("tier", "1")})
+
+	provs := keeper.GetProviderAttributes(ctx, id.Owner)
+	require.Len(t, provs, 2)
+
+	attrs, ok := provs.SignedBy(id2.Auditor.String())
+	require.True(t, ok)
+	require.Equal(t, []sdk.Attribute{sdk.NewAttribute("tier", "1")}, attrs)
+}
+
+func TestProviderAttributesDeleteKeys(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testProviderID(t)
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, []sdk.Attribute{
+		sdk.NewAttribute("region", "us-west"),
+		sdk.NewAttribute("tier", "1"),
+	})
+
+	err := keeper.DeleteProviderAttributes(ctx, id, []string{"tier"})
+	require.NoError(t, err)
+
+	prov, found := keeper.GetProviderByAuditor(ctx, id)
+	require.True(t, found)
+	require.Equal(t, []sdk.Attribute{sdk.NewAttribute("region", "us-west")}, prov.Attributes)
+}
+
+func TestProviderAttributesDeleteAll(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testProviderID(t)
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, id, []sdk.Attribute{sdk.NewAttribute("region", "us-west")})
+
+	err := keeper.DeleteProviderAttributes(ctx, id, nil)
+	require.NoError(t, err)
+
+	_, found := keeper.GetProviderByAuditor(ctx, id)
+	require.False(t, found)
+}
+
+func TestProviderAttributesDeleteNonExisting(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	err := keeper.DeleteProviderAttributes(ctx, testProviderID(t), nil)
+	require.EqualError(t, err, types.ErrProviderNotFound.Error())
+}
+
+func TestWithProviders(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	keeper.CreateOrUpdateProviderAttributes(ctx, testProviderID(t), []sdk.Attribute{sdk.NewAttribute("a", "b")})
+	keeper.CreateOrUpdateProviderAttributes(ctx, testProviderID(t), []sdk.Attribute{sdk.NewAttribute("a", "b")})
+
+	count := 0
+	keeper.WithProviders(ctx, func(types.Provider) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 2, count)
+}
+
+func testProviderID(t testing.TB) types.ProviderID {
+	t.Helper()
+	return types.ProviderID{
+		Owner:   testutil.AccAddress(t),
+		Auditor: testutil.AccAddress(t),
+	}
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	pkey := sdk.NewKVStoreKey(params.StoreKey)
+	tkey := sdk.NewTransientStoreKey(params.TStoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pkey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	pspace := params.NewKeeper(app.MakeCodec(), pkey, tkey).Subspace(types.DefaultParamspace)
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key, pspace)
+}
@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+var (
+	storeVersionKey = []byte{0x00}
+	providerPrefix  = []byte{0x01}
+)
+
+func providerKey(id types.ProviderID) []byte {
+	buf := bytes.NewBuffer(providerPrefix)
+	buf.Write(id.Owner.Bytes())
+	buf.Write(id.Auditor.Bytes())
+	return buf.Bytes()
+}
+
+func providerPrefixKey(owner sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(providerPrefix)
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// StoreVersion returns the version of the audit store format
+func (k Keeper) StoreVersion(ctx sdk.Context) uint64 {
+	return sdkutil.GetStoreVersion(ctx.KVStore(k.skey), storeVersionKey)
+}
+
+// SetStoreVersion records the version of the audit store format
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	sdkutil.SetStoreVersion(ctx.KVStore(k.skey), storeVersionKey, version)
+}
+
+// RegisterMigrations registers the audit store and its migrations with m
+func RegisterMigrations(m *sdkutil.Migrator, k Keeper) error {
+	m.RegisterModule(types.ModuleName, k, types.ConsensusVersion)
+	return m.RegisterMigration(types.ModuleName, 1, k.migrateV1)
+}
+
+// migrateV1 sets the module params, which version 1 stores do not have
+func (k Keeper) migrateV1(ctx sdk.Context) error {
+	k.SetParams(ctx, types.DefaultParams())
+	return nil
+}
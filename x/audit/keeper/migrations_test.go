@@ -0,0 +1,25 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+func TestMigrateV1(t *testing.T) {
+	ctx, akeeper := setupKeeper(t)
+
+	// version 1 chains never set the module params
+	require.Equal(t, sdkutil.InitialStoreVersion, akeeper.StoreVersion(ctx))
+	require.Panics(t, func() { akeeper.GetParams(ctx) })
+
+	m := sdkutil.NewMigrator()
+	require.NoError(t, keeper.RegisterMigrations(m, akeeper))
+	require.NoError(t, m.Migrate(ctx))
+	require.Equal(t, types.ConsensusVersion, akeeper.StoreVersion(ctx))
+	require.Equal(t, types.DefaultParams(), akeeper.GetParams(ctx))
+}
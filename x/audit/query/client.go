@@ -0,0 +1,52 @@
+package query
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// Client interface
+type Client interface {
+	AllProvidersAttributes() (Providers, error)
+	ProviderAttributes(sdk.AccAddress) (Providers, error)
+	ProviderAuditorAttributes(types.ProviderID) (Provider, error)
+}
+
+// NewClient creates a client instance with provided context and key
+func NewClient(ctx context.CLIContext, key string) Client {
+	return &client{ctx: ctx, key: key}
+}
+
+type client struct {
+	ctx context.CLIContext
+	key string
+}
+
+func (c *client) AllProvidersAttributes() (Providers, error) {
+	var obj Providers
+	buf, err := NewRawClient(c.ctx, c.key).AllProvidersAttributes()
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
+
+func (c *client) ProviderAttributes(owner sdk.AccAddress) (Providers, error) {
+	var obj Providers
+	buf, err := NewRawClient(c.ctx, c.key).ProviderAttributes(owner)
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
+
+func (c *client) ProviderAuditorAttributes(id types.ProviderID) (Provider, error) {
+	var obj Provider
+	buf, err := NewRawClient(c.ctx, c.key).ProviderAuditorAttributes(id)
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
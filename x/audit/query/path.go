@@ -0,0 +1,29 @@
+package query
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+const (
+	providersPath = "providers"
+	providerPath  = "provider"
+)
+
+// getProvidersPath returns providers path for queries
+func getProvidersPath() string {
+	return providersPath
+}
+
+// getProviderPath returns the path for all audited attributes of a provider
+func getProviderPath(owner sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s", providerPath, owner)
+}
+
+// getProviderAuditorPath returns the path for the attributes one auditor signed for a provider
+func getProviderAuditorPath(id types.ProviderID) string {
+	return fmt.Sprintf("%s/%s/%s", providerPath, id.Owner, id.Auditor)
+}
@@ -0,0 +1,64 @@
+package query
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/audit/keeper"
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// NewQuerier creates and returns a new audit querier instance
+func NewQuerier(keeper keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case providersPath:
+			return queryProviders(ctx, path[1:], req, keeper)
+		case providerPath:
+			return queryProvider(ctx, path[1:], req, keeper)
+		}
+		return []byte{}, sdkerrors.ErrUnknownRequest
+	}
+}
+
+func queryProviders(ctx sdk.Context, _ []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	values := Providers{}
+	keeper.WithProviders(ctx, func(obj types.Provider) bool {
+		values = append(values, Provider(obj))
+		return false
+	})
+	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
+}
+
+func queryProvider(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) != 1 && len(path) != 2 {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if len(path) == 1 {
+		values := Providers{}
+		for _, obj := range keeper.GetProviderAttributes(ctx, owner) {
+			values = append(values, Provider(obj))
+		}
+		return sdkutil.RenderQueryResponse(keeper.Codec(), values)
+	}
+
+	auditor, err := sdk.AccAddressFromBech32(path[1])
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	provider, ok := keeper.GetProviderByAuditor(ctx, types.ProviderID{Owner: owner, Auditor: auditor})
+	if !ok {
+		return nil, types.ErrProviderNotFound
+	}
+
+	return sdkutil.RenderQueryResponse(keeper.Codec(), Provider(provider))
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+// RawClient interface
+type RawClient interface {
+	AllProvidersAttributes() ([]byte, error)
+	ProviderAttributes(sdk.AccAddress) ([]byte, error)
+	ProviderAuditorAttributes(types.ProviderID) ([]byte, error)
+}
+
+// NewRawClient creates a client instance with provided context and key
+func NewRawClient(ctx context.CLIContext, key string) RawClient {
+	return &rawclient{ctx: ctx, key: key}
+}
+
+type rawclient struct {
+	ctx context.CLIContext
+	key string
+}
+
+func (c *rawclient) AllProvidersAttributes() ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getProvidersPath()), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, err
+}
+
+func (c *rawclient) ProviderAttributes(owner sdk.AccAddress) ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getProviderPath(owner)), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, err
+}
+
+func (c *rawclient) ProviderAuditorAttributes(id types.ProviderID) ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getProviderAuditorPath(id)), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, err
+}
@@ -0,0 +1,49 @@
+package query
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ovrclk/akash/x/audit/types"
+)
+
+type (
+	// Provider type
+	Provider types.Provider
+	// Providers - Slice of Provider Struct
+	Providers []Provider
+)
+
+func (p Provider) String() string {
+	return fmt.Sprintf(`AuditedAttributes
+	Owner:   %s
+	Auditor: %s
+	Attributes: %v
+	`, p.Owner, p.Auditor, p.Attributes)
+}
+
+func (obj Providers) String() string {
+	var buf bytes.Buffer
+
+	const sep = "\n\n"
+
+	for _, p := range obj {
+		buf.WriteString(p.String())
+		buf.WriteString(sep)
+	}
+
+	if len(obj) > 0 {
+		buf.Truncate(buf.Len() - len(sep))
+	}
+
+	return buf.String()
+}
+
+// ToTypes converts the query result into audit types
+func (obj Providers) ToTypes() types.Providers {
+	result := make(types.Providers, 0, len(obj))
+	for _, p := range obj {
+		result = append(result, types.Provider(p))
+	}
+	return result
+}
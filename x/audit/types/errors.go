@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	errProviderNotFound uint32 = iota + 1
+	errInvalidAddress
+	errAttributes
+	errSelfAudit
+	errUnknownAuditor
+	errUnknownProvider
+)
+
+var (
+	// ErrProviderNotFound audited attributes not found
+	ErrProviderNotFound = sdkerrors.Register(ModuleName, errProviderNotFound, "invalid provider: audited attributes not found")
+
+	// ErrInvalidAddress invalid provider or auditor address
+	ErrInvalidAddress = sdkerrors.Register(ModuleName, errInvalidAddress, "invalid address")
+
+	// ErrAttributes error code for attribute problems
+	ErrAttributes = sdkerrors.Register(ModuleName, errAttributes, "attribute specification error")
+
+	// ErrSelfAudit error code for providers signing their own attributes
+	ErrSelfAudit = sdkerrors.Register(ModuleName, errSelfAudit, "auditor and provider are the same account")
+
+	// ErrUnknownAuditor error code for attributes signed by an account that is not an auditor
+	ErrUnknownAuditor = sdkerrors.Register(ModuleName, errUnknownAuditor, "not an auditor")
+
+	// ErrUnknownProvider error code for attributes of providers that are not registered
+	ErrUnknownProvider = sdkerrors.Register(ModuleName, errUnknownProvider, "provider not registered")
+)
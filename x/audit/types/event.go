@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+const (
+	evActionProviderAttributesSigned  = "provider-attributes-signed"
+	evActionProviderAttributesDeleted = "provider-attributes-deleted"
+	evOwnerKey                        = "owner"
+	evAuditorKey                      = "auditor"
+)
+
+// EventProviderAttributesSigned struct
+type EventProviderAttributesSigned struct {
+	ID ProviderID
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderAttributesSigned struct
+func (ev EventProviderAttributesSigned) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionProviderAttributesSigned),
+		}, ProviderIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventProviderAttributesDeleted struct
+type EventProviderAttributesDeleted struct {
+	ID ProviderID
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderAttributesDeleted struct
+func (ev EventProviderAttributesDeleted) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionProviderAttributesDeleted),
+		}, ProviderIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// ProviderIDEVAttributes returns event attribues for given ProviderID
+func ProviderIDEVAttributes(id ProviderID) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(evOwnerKey, id.Owner.String()),
+		sdk.NewAttribute(evAuditorKey, id.Auditor.String()),
+	}
+}
+
+// ParseEVProviderID returns ProviderID details for given event attributes
+func ParseEVProviderID(attrs []sdk.Attribute) (ProviderID, error) {
+	owner, err := sdkutil.GetAccAddress(attrs, evOwnerKey)
+	if err != nil {
+		return ProviderID{}, err
+	}
+
+	auditor, err := sdkutil.GetAccAddress(attrs, evAuditorKey)
+	if err != nil {
+		return ProviderID{}, err
+	}
+
+	return ProviderID{
+		Owner:   owner,
+		Auditor: auditor,
+	}, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+	switch ev.Action {
+	case evActionProviderAttributesSigned:
+		id, err := ParseEVProviderID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventProviderAttributesSigned{ID: id}, nil
+	case evActionProviderAttributesDeleted:
+		id, err := ParseEVProviderID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventProviderAttributesDeleted{ID: id}, nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
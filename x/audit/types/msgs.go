@@ -0,0 +1,102 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	msgTypeSignProviderAttributes   = "sign-provider-attributes"
+	msgTypeDeleteProviderAttributes = "delete-provider-attributes"
+)
+
+// MsgSignProviderAttributes defines an SDK message for an auditor signing provider attributes
+type MsgSignProviderAttributes struct {
+	Owner      sdk.AccAddress  `json:"owner"`
+	Auditor    sdk.AccAddress  `json:"auditor"`
+	Attributes []sdk.Attribute `json:"attributes"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgSignProviderAttributes) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgSignProviderAttributes) Type() string { return msgTypeSignProviderAttributes }
+
+// ValidateBasic does basic validation
+func (msg MsgSignProviderAttributes) ValidateBasic() error {
+	if err := validateIDs(msg.Owner, msg.Auditor); err != nil {
+		return err
+	}
+	if len(msg.Attributes) == 0 {
+		return errors.Wrap(ErrAttributes, "no attributes to sign")
+	}
+	return validateAttributes(msg.Attributes)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSignProviderAttributes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSignProviderAttributes) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Auditor}
+}
+
+// MsgDeleteProviderAttributes defines an SDK message for an auditor revoking provider attributes.
+// All signed attributes are revoked when Keys is empty.
+type MsgDeleteProviderAttributes struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Auditor sdk.AccAddress `json:"auditor"`
+	Keys    []string       `json:"keys"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDeleteProviderAttributes) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDeleteProviderAttributes) Type() string { return msgTypeDeleteProviderAttributes }
+
+// ValidateBasic does basic validation
+func (msg MsgDeleteProviderAttributes) ValidateBasic() error {
+	return validateIDs(msg.Owner, msg.Auditor)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDeleteProviderAttributes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDeleteProviderAttributes) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Auditor}
+}
+
+func validateIDs(owner, auditor sdk.AccAddress) error {
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAddress, "invalid provider address")
+	}
+	if err := sdk.VerifyAddressFormat(auditor); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAddress, "invalid auditor address")
+	}
+	if owner.Equals(auditor) {
+		return ErrSelfAudit
+	}
+	return nil
+}
+
+func validateAttributes(attrs []sdk.Attribute) error {
+	keys := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		if attr.Key == "" {
+			return errors.Wrap(ErrAttributes, "empty attribute key")
+		}
+		if keys[attr.Key] {
+			return errors.Wrapf(ErrAttributes, "duplicate attribute %q", attr.Key)
+		}
+		keys[attr.Key] = true
+	}
+	return nil
+}
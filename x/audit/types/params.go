@@ -0,0 +1,80 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// DefaultParamspace is the paramstore subspace of the audit module
+	DefaultParamspace = ModuleName
+)
+
+var (
+	// KeyAuditors is the paramstore key of the auditors
+	KeyAuditors = []byte("Auditors")
+)
+
+// Params defines the parameters of the audit module
+type Params struct {
+	// Auditors are the accounts that may sign provider attributes
+	Auditors []sdk.AccAddress `json:"auditors" yaml:"auditors"`
+}
+
+// ParamKeyTable returns the key table of the audit module params
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default audit module params, without auditors
+func DefaultParams() Params {
+	return Params{}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyAuditors, &p.Auditors, validateAuditors),
+	}
+}
+
+// Validate returns error if params are invalid
+func (p Params) Validate() error {
+	return validateAuditors(p.Auditors)
+}
+
+// IsAuditor returns true if addr is one of the auditors
+func (p Params) IsAuditor(addr sdk.AccAddress) bool {
+	for _, auditor := range p.Auditors {
+		if auditor.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Audit Params:
+	Auditors: %v`, p.Auditors)
+}
+
+func validateAuditors(i interface{}) error {
+	auditors, ok := i.([]sdk.AccAddress)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[string]bool, len(auditors))
+	for _, auditor := range auditors {
+		if err := sdk.VerifyAddressFormat(auditor); err != nil {
+			return fmt.Errorf("invalid auditor address %v: %w", auditor, err)
+		}
+		if seen[auditor.String()] {
+			return fmt.Errorf("duplicate auditor %v", auditor)
+		}
+		seen[auditor.String()] = true
+	}
+	return nil
+}
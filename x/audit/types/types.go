@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderID identifies the attributes an auditor signed for a provider
+type ProviderID struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Auditor sdk.AccAddress `json:"auditor"`
+}
+
+// Provider stores the attributes an auditor has signed for a provider
+type Provider struct {
+	Owner      sdk.AccAddress  `json:"owner"`
+	Auditor    sdk.AccAddress  `json:"auditor"`
+	Attributes []sdk.Attribute `json:"attributes"`
+}
+
+// ID method returns ProviderID details of specific audited attributes
+func (p Provider) ID() ProviderID {
+	return ProviderID{
+		Owner:   p.Owner,
+		Auditor: p.Auditor,
+	}
+}
+
+// Providers is a list of audited attributes
+type Providers []Provider
+
+// SignedBy returns the attributes signed by the given auditor and whether any exist
+func (obj Providers) SignedBy(auditor string) ([]sdk.Attribute, bool) {
+	for _, p := range obj {
+		if p.Auditor.String() == auditor {
+			return p.Attributes, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,23 @@
+package authz
+
+import (
+	"github.com/ovrclk/akash/x/authz/keeper"
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+const (
+	// StoreKey represents storekey of authz module
+	StoreKey = types.StoreKey
+	// ModuleName represents current module name
+	ModuleName = types.ModuleName
+)
+
+type (
+	// Keeper defines keeper of authz module
+	Keeper = keeper.Keeper
+)
+
+var (
+	// NewKeeper creates new keeper instance of authz module
+	NewKeeper = keeper.NewKeeper
+)
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/authz/query"
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+// GetQueryCmd returns the query commands for the authz module
+func GetQueryCmd(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization query commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		cmdGetGrants(key, cdc),
+		cmdGetGrant(key, cdc),
+	)...)
+
+	return cmd
+}
+
+func cmdGetGrants(key string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Query for all grants",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			obj, err := query.NewClient(ctx, key).Grants()
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+}
+
+func cmdGetGrant(key string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get [granter] [grantee]",
+		Short: "Query the grants a granter gave a grantee",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			granter, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			obj, err := query.NewClient(ctx, key).GranteeGrants(granter, grantee)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+}
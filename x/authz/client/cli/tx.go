@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+// GetTxCmd returns the transaction commands for authz module
+func GetTxCmd(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Authorization transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(flags.PostCommands(
+		cmdGrant(key, cdc),
+		cmdRevoke(key, cdc),
+	)...)
+	return cmd
+}
+
+func cmdGrant(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant [grantee] [msg-type]",
+		Short: "Authorize the grantee to sign messages of a type on your behalf",
+		Example: "akashctl tx authz grant [grantee] create-deployment --spend-limit=1000akash --price-cap=100akash --expires-at=50000\n" +
+			"akashctl tx authz grant [grantee] update-deployment --dseq=[uint64]",
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := grantIDFromArgs(cmd.Flags(), ctx.GetFromAddress(), args)
+			if err != nil {
+				return err
+			}
+
+			grant := types.Grant{GrantID: id}
+			if grant.SpendLimit, err = coinsFromFlags(cmd.Flags(), "spend-limit"); err != nil {
+				return err
+			}
+			if grant.PriceCap, err = coinsFromFlags(cmd.Flags(), "price-cap"); err != nil {
+				return err
+			}
+
+			if grant.ExpiresAt, err = cmd.Flags().GetInt64("expires-at"); err != nil {
+				return err
+			}
+
+			msg := types.MsgGrant{Grant: grant}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+	addGrantIDFlags(cmd.Flags())
+	cmd.Flags().String("spend-limit", "", "Total price per block of the groups the grantee may create or update over all messages (default unlimited)")
+	cmd.Flags().String("price-cap", "", "Total price per block of the groups each message of the grantee may create or update (default unlimited)")
+	cmd.Flags().Int64("expires-at", 0, "Block height at which the grant expires")
+	return cmd
+}
+
+func cmdRevoke(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [grantee] [msg-type]",
+		Short: "Revoke a grant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := grantIDFromArgs(cmd.Flags(), ctx.GetFromAddress(), args)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRevoke{ID: id}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+	addGrantIDFlags(cmd.Flags())
+	return cmd
+}
+
+func addGrantIDFlags(flags *pflag.FlagSet) {
+	flags.Uint64("dseq", 0, "Deployment Sequence the grant is limited to (default all deployments)")
+}
+
+func coinsFromFlags(flags *pflag.FlagSet, name string) (sdk.Coins, error) {
+	val, err := flags.GetString(name)
+	if err != nil || val == "" {
+		return nil, err
+	}
+	return sdk.ParseCoins(val)
+}
+
+func grantIDFromArgs(flags *pflag.FlagSet, granter sdk.AccAddress, args []string) (types.GrantID, error) {
+	id := types.GrantID{
+		Granter: granter,
+		MsgType: args[1],
+	}
+
+	var err error
+	if id.Grantee, err = sdk.AccAddressFromBech32(args[0]); err != nil {
+		return id, err
+	}
+	if id.DSeq, err = flags.GetUint64("dseq"); err != nil {
+		return id, err
+	}
+	return id, nil
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/ovrclk/akash/x/authz/query"
+)
+
+// RegisterRoutes registers all query routes
+func RegisterRoutes(ctx context.CLIContext, r *mux.Router, ns string) {
+	// Get all grants
+	r.HandleFunc(fmt.Sprintf("/%s/list", ns), listGrantsHandler(ctx, ns)).Methods("GET")
+
+	// Get the grants a granter gave a grantee
+	r.HandleFunc(fmt.Sprintf("/%s/grants/{granter}/{grantee}", ns), getGrantsHandler(ctx, ns)).Methods("GET")
+}
+
+func listGrantsHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := query.NewRawClient(ctx, ns).Grants()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getGrantsHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		granter, err := sdk.AccAddressFromBech32(mux.Vars(r)["granter"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		grantee, err := sdk.AccAddressFromBech32(mux.Vars(r)["grantee"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		res, err := query.NewRawClient(ctx, ns).GranteeGrants(granter, grantee)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
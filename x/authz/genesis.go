@@ -0,0 +1,49 @@
+package authz
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/authz/keeper"
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+// GenesisState defines the basic genesis state used by authz module
+type GenesisState struct {
+	Grants []types.Grant `json:"grants"`
+}
+
+// ValidateGenesis does validation check of the Genesis and returns error incase of failure
+func ValidateGenesis(data GenesisState) error {
+	for _, record := range data.Grants {
+		msg := types.MsgGrant{Grant: record}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// InitGenesis initiate genesis state and return updated validator details
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	for _, record := range data.Grants {
+		keeper.SetGrant(ctx, record)
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns genesis state for the authz module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
+	var records []types.Grant
+	k.WithGrants(ctx, func(record types.Grant) bool {
+		records = append(records, record)
+		return false
+	})
+	return GenesisState{Grants: records}
+}
+
+// DefaultGenesisState returns default genesis state as raw bytes for the authz
+// module.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
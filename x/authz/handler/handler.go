@@ -0,0 +1,46 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+
+	"github.com/ovrclk/akash/x/authz/keeper"
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+// NewHandler returns a handler for "authz" type messages.
+func NewHandler(keeper keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.MsgGrant:
+			return handleMsgGrant(ctx, keeper, msg)
+		case types.MsgRevoke:
+			return handleMsgRevoke(ctx, keeper, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized authz message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgGrant(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgGrant) (*sdk.Result, error) {
+	if msg.Grant.Expired(ctx.BlockHeight()) {
+		return nil, errors.Wrapf(types.ErrInvalidExpiry, "expiry %v not after current height", msg.Grant.ExpiresAt)
+	}
+
+	keeper.SetGrant(ctx, msg.Grant)
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgRevoke(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgRevoke) (*sdk.Result, error) {
+	if err := keeper.Revoke(ctx, msg.ID); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
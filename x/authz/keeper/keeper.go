@@ -0,0 +1,147 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+// Keeper of the authz store
+type Keeper struct {
+	skey sdk.StoreKey
+	cdc  *codec.Codec
+}
+
+// NewKeeper creates and returns an instance for authz keeper
+func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey) Keeper {
+	return Keeper{
+		skey: skey,
+		cdc:  cdc,
+	}
+}
+
+// Codec returns keeper codec
+func (k Keeper) Codec() *codec.Codec {
+	return k.cdc
+}
+
+// GetGrant returns the grant with the given ID
+func (k Keeper) GetGrant(ctx sdk.Context, id types.GrantID) (types.Grant, bool) {
+	store := ctx.KVStore(k.skey)
+	key := grantKey(id)
+
+	buf := store.Get(key)
+	if buf == nil {
+		return types.Grant{}, false
+	}
+
+	var val types.Grant
+	k.cdc.MustUnmarshalBinaryBare(buf, &val)
+	return val, true
+}
+
+// SetGrant creates a grant, replacing any grant with the same ID
+func (k Keeper) SetGrant(ctx sdk.Context, grant types.Grant) {
+	store := ctx.KVStore(k.skey)
+	store.Set(grantKey(grant.ID()), k.cdc.MustMarshalBinaryBare(grant))
+
+	ctx.EventManager().EmitEvent(
+		types.EventGrantCreated{ID: grant.ID()}.ToSDKEvent(),
+	)
+}
+
+// Revoke removes the grant with the given ID
+func (k Keeper) Revoke(ctx sdk.Context, id types.GrantID) error {
+	store := ctx.KVStore(k.skey)
+	key := grantKey(id)
+
+	if !store.Has(key) {
+		return types.ErrGrantNotFound
+	}
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		types.EventGrantRevoked{ID: id}.ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// Authorize returns an error unless the granter authorized the grantee to sign a message of
+// msgType for deployment dseq pricing its groups at spend per block. A grant for dseq is
+// preferred over one covering every deployment. spend must be within the grant's price cap
+// and is deducted from its spend limit.
+func (k Keeper) Authorize(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string,
+	dseq uint64, spend sdk.Coins) error {
+	id := types.GrantID{Granter: granter, Grantee: grantee, MsgType: msgType, DSeq: dseq}
+
+	grant, found := k.GetGrant(ctx, id)
+	if !found && dseq != 0 {
+		id.DSeq = 0
+		grant, found = k.GetGrant(ctx, id)
+	}
+	if !found {
+		return errors.Wrapf(types.ErrUnauthorized, "%v may not sign %v for %v", grantee, msgType, granter)
+	}
+
+	if grant.Expired(ctx.BlockHeight()) {
+		return errors.Wrapf(types.ErrUnauthorized, "grant expired at height %v", grant.ExpiresAt)
+	}
+
+	if spend.Empty() {
+		return nil
+	}
+
+	if !grant.PriceCap.Empty() && !spend.IsAllLTE(grant.PriceCap) {
+		return errors.Wrapf(types.ErrPriceCapExceeded, "%v > %v", spend, grant.PriceCap)
+	}
+
+	if grant.SpendLimit.Empty() {
+		return nil
+	}
+
+	remaining, negative := grant.SpendLimit.SafeSub(spend)
+	if negative {
+		return errors.Wrapf(types.ErrSpendLimitExceeded, "%v > %v", spend, grant.SpendLimit)
+	}
+
+	// an empty limit is unlimited, so a used up grant is removed
+	if remaining.IsZero() {
+		return k.Revoke(ctx, id)
+	}
+
+	grant.SpendLimit = remaining
+	ctx.KVStore(k.skey).Set(grantKey(id), k.cdc.MustMarshalBinaryBare(grant))
+	return nil
+}
+
+// GetGrants returns the grants the granter gave the grantee
+func (k Keeper) GetGrants(ctx sdk.Context, granter, grantee sdk.AccAddress) []types.Grant {
+	var grants []types.Grant
+	k.withGrants(ctx, grantPrefixKey(granter, grantee), func(grant types.Grant) bool {
+		grants = append(grants, grant)
+		return false
+	})
+	return grants
+}
+
+// WithGrants iterates all grants
+func (k Keeper) WithGrants(ctx sdk.Context, fn func(types.Grant) bool) {
+	k.withGrants(ctx, grantPrefix, fn)
+}
+
+func (k Keeper) withGrants(ctx sdk.Context, pfx []byte, fn func(types.Grant) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, pfx)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var val types.Grant
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
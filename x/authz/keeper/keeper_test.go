@@ -0,0 +1,157 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/authz/keeper"
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+func TestGrantCreate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	grant := types.Grant{GrantID: testGrantID(t)}
+
+	keeper.SetGrant(ctx, grant)
+
+	found, ok := keeper.GetGrant(ctx, grant.ID())
+	require.True(t, ok)
+	require.Equal(t, grant, found)
+	require.Equal(t, []types.Grant{grant}, keeper.GetGrants(ctx, grant.Granter, grant.Grantee))
+	require.Empty(t, keeper.GetGrants(ctx, grant.Grantee, grant.Granter))
+}
+
+func TestGrantRevoke(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	grant := types.Grant{GrantID: testGrantID(t)}
+
+	require.True(t, errors.Is(keeper.Revoke(ctx, grant.ID()), types.ErrGrantNotFound))
+
+	keeper.SetGrant(ctx, grant)
+	require.NoError(t, keeper.Revoke(ctx, grant.ID()))
+
+	_, ok := keeper.GetGrant(ctx, grant.ID())
+	require.False(t, ok)
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, nil),
+		types.ErrUnauthorized))
+}
+
+func TestAuthorizeScope(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := testGrantID(t)
+	id.DSeq = 10
+	keeper.SetGrant(ctx, types.Grant{GrantID: id})
+
+	require.NoError(t, keeper.Authorize(ctx, id.Granter, id.Grantee, id.MsgType, 10, nil))
+	require.True(t, errors.Is(keeper.Authorize(ctx, id.Granter, id.Grantee, id.MsgType, 11, nil),
+		types.ErrUnauthorized))
+	require.True(t, errors.Is(keeper.Authorize(ctx, id.Granter, id.Grantee, "other", 10, nil),
+		types.ErrUnauthorized))
+
+	// a grant without a deployment sequence covers every deployment
+	id.DSeq = 0
+	keeper.SetGrant(ctx, types.Grant{GrantID: id})
+	require.NoError(t, keeper.Authorize(ctx, id.Granter, id.Grantee, id.MsgType, 11, nil))
+}
+
+func TestAuthorizeExpired(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	ctx = ctx.WithBlockHeight(10)
+	grant := types.Grant{GrantID: testGrantID(t), ExpiresAt: 11}
+	keeper.SetGrant(ctx, grant)
+
+	require.NoError(t, keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, nil))
+
+	ctx = ctx.WithBlockHeight(11)
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, nil),
+		types.ErrUnauthorized))
+}
+
+func TestAuthorizePriceCap(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	grant := types.Grant{
+		GrantID:  testGrantID(t),
+		PriceCap: sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 10)),
+	}
+	keeper.SetGrant(ctx, grant)
+
+	// the cap applies to each message; it is not used up
+	spend := sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 6))
+	require.NoError(t, keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend))
+	require.NoError(t, keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend))
+
+	found, ok := keeper.GetGrant(ctx, grant.ID())
+	require.True(t, ok)
+	require.Equal(t, grant, found)
+
+	spend = sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 11))
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend),
+		types.ErrPriceCapExceeded))
+
+	other := sdk.NewCoins(sdk.NewInt64Coin("other", 1))
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, other),
+		types.ErrPriceCapExceeded))
+}
+
+func TestAuthorizeSpendLimit(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	grant := types.Grant{
+		GrantID:    testGrantID(t),
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 25)),
+	}
+	keeper.SetGrant(ctx, grant)
+
+	// each message deducts its spend until the limit is used up
+	spend := sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 10))
+	require.NoError(t, keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend))
+	require.NoError(t, keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend))
+
+	found, ok := keeper.GetGrant(ctx, grant.ID())
+	require.True(t, ok)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 5)), found.SpendLimit)
+
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend),
+		types.ErrSpendLimitExceeded))
+
+	other := sdk.NewCoins(sdk.NewInt64Coin("other", 1))
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, other),
+		types.ErrSpendLimitExceeded))
+
+	// spending the rest removes the grant
+	spend = sdk.NewCoins(sdk.NewInt64Coin(testutil.CoinDenom, 5))
+	require.NoError(t, keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend))
+	_, ok = keeper.GetGrant(ctx, grant.ID())
+	require.False(t, ok)
+	require.True(t, errors.Is(keeper.Authorize(ctx, grant.Granter, grant.Grantee, grant.MsgType, 0, spend),
+		types.ErrUnauthorized))
+}
+
+func testGrantID(t testing.TB) types.GrantID {
+	t.Helper()
+	return types.GrantID{
+		Granter: testutil.AccAddress(t),
+		Grantee: testutil.AccAddress(t),
+		MsgType: "create-deployment",
+	}
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+var (
+	// grantPrefix keys are the prefix, the granter, the grantee, the
+	// deployment sequence and the message type.
+	grantPrefix = []byte{0x01}
+)
+
+func grantKey(id types.GrantID) []byte {
+	buf := bytes.NewBuffer(grantPrefixKey(id.Granter, id.Grantee))
+	binary.Write(buf, binary.BigEndian, id.DSeq)
+	buf.WriteString(id.MsgType)
+	return buf.Bytes()
+}
+
+func grantPrefixKey(granter, grantee sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(grantPrefix)
+	buf.Write(granter.Bytes())
+	buf.Write(grantee.Bytes())
+	return buf.Bytes()
+}
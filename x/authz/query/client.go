@@ -0,0 +1,40 @@
+package query
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Client interface
+type Client interface {
+	Grants() (Grants, error)
+	GranteeGrants(granter, grantee sdk.AccAddress) (Grants, error)
+}
+
+// NewClient creates a client instance with provided context and key
+func NewClient(ctx context.CLIContext, key string) Client {
+	return &client{ctx: ctx, key: key}
+}
+
+type client struct {
+	ctx context.CLIContext
+	key string
+}
+
+func (c *client) Grants() (Grants, error) {
+	var obj Grants
+	buf, err := NewRawClient(c.ctx, c.key).Grants()
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
+
+func (c *client) GranteeGrants(granter, grantee sdk.AccAddress) (Grants, error) {
+	var obj Grants
+	buf, err := NewRawClient(c.ctx, c.key).GranteeGrants(granter, grantee)
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
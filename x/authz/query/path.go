@@ -0,0 +1,21 @@
+package query
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	grantsPath = "grants"
+)
+
+// getGrantsPath returns grants path for queries
+func getGrantsPath() string {
+	return grantsPath
+}
+
+// getGranteeGrantsPath returns the path for the grants a granter gave a grantee
+func getGranteeGrantsPath(granter, grantee sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s/%s", grantsPath, granter, grantee)
+}
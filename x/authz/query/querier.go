@@ -0,0 +1,50 @@
+package query
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/authz/keeper"
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+// NewQuerier creates and returns a new authz querier instance
+func NewQuerier(keeper keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case grantsPath:
+			return queryGrants(ctx, path[1:], req, keeper)
+		}
+		return []byte{}, sdkerrors.ErrUnknownRequest
+	}
+}
+
+func queryGrants(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	values := Grants{}
+
+	switch len(path) {
+	case 0:
+		keeper.WithGrants(ctx, func(obj types.Grant) bool {
+			values = append(values, Grant(obj))
+			return false
+		})
+	case 2:
+		granter, err := sdk.AccAddressFromBech32(path[0])
+		if err != nil {
+			return nil, types.ErrInvalidAddress
+		}
+		grantee, err := sdk.AccAddressFromBech32(path[1])
+		if err != nil {
+			return nil, types.ErrInvalidAddress
+		}
+		for _, obj := range keeper.GetGrants(ctx, granter, grantee) {
+			values = append(values, Grant(obj))
+		}
+	default:
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
+}
@@ -0,0 +1,44 @@
+package query
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ovrclk/akash/x/authz/types"
+)
+
+type (
+	// Grant type
+	Grant types.Grant
+	// Grants - Slice of Grant Struct
+	Grants []Grant
+)
+
+func (g Grant) String() string {
+	return fmt.Sprintf(`Grant
+	Granter:    %s
+	Grantee:    %s
+	MsgType:    %s
+	DSeq:       %d
+	SpendLimit: %s
+	PriceCap:   %s
+	ExpiresAt:  %d
+	`, g.Granter, g.Grantee, g.MsgType, g.DSeq, g.SpendLimit, g.PriceCap, g.ExpiresAt)
+}
+
+func (obj Grants) String() string {
+	var buf bytes.Buffer
+
+	const sep = "\n\n"
+
+	for _, g := range obj {
+		buf.WriteString(g.String())
+		buf.WriteString(sep)
+	}
+
+	if len(obj) > 0 {
+		buf.Truncate(buf.Len() - len(sep))
+	}
+
+	return buf.String()
+}
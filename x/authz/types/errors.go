@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	errGrantNotFound uint32 = iota + 1
+	errInvalidAddress
+	errSelfGrant
+	errInvalidMsgType
+	errInvalidPriceCap
+	errInvalidExpiry
+	errUnauthorized
+	errPriceCapExceeded
+	errInvalidSpendLimit
+	errSpendLimitExceeded
+)
+
+var (
+	// ErrGrantNotFound grant not found
+	ErrGrantNotFound = sdkerrors.Register(ModuleName, errGrantNotFound, "grant not found")
+
+	// ErrInvalidAddress invalid granter or grantee address
+	ErrInvalidAddress = sdkerrors.Register(ModuleName, errInvalidAddress, "invalid address")
+
+	// ErrSelfGrant error code for granters authorizing themselves
+	ErrSelfGrant = sdkerrors.Register(ModuleName, errSelfGrant, "granter and grantee are the same account")
+
+	// ErrInvalidMsgType error code for grants of message types that cannot be delegated
+	ErrInvalidMsgType = sdkerrors.Register(ModuleName, errInvalidMsgType, "invalid message type")
+
+	// ErrInvalidPriceCap error code for malformed price caps
+	ErrInvalidPriceCap = sdkerrors.Register(ModuleName, errInvalidPriceCap, "invalid price cap")
+
+	// ErrInvalidExpiry error code for grants expiring before they are created
+	ErrInvalidExpiry = sdkerrors.Register(ModuleName, errInvalidExpiry, "invalid expiry")
+
+	// ErrUnauthorized error code for messages signed without a valid grant
+	ErrUnauthorized = sdkerrors.Register(ModuleName, errUnauthorized, "no valid grant")
+
+	// ErrPriceCapExceeded error code for messages pricing groups above the grant's cap
+	ErrPriceCapExceeded = sdkerrors.Register(ModuleName, errPriceCapExceeded, "price cap exceeded")
+
+	// ErrInvalidSpendLimit error code for malformed spend limits
+	ErrInvalidSpendLimit = sdkerrors.Register(ModuleName, errInvalidSpendLimit, "invalid spend limit")
+
+	// ErrSpendLimitExceeded error code for messages spending more than remains of the grant's limit
+	ErrSpendLimitExceeded = sdkerrors.Register(ModuleName, errSpendLimitExceeded, "spend limit exceeded")
+)
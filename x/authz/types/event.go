@@ -0,0 +1,114 @@
+package types
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+const (
+	evActionGrantCreated = "grant-created"
+	evActionGrantRevoked = "grant-revoked"
+	evGranterKey         = "granter"
+	evGranteeKey         = "grantee"
+	evMsgTypeKey         = "msg-type"
+	evDSeqKey            = "dseq"
+)
+
+// EventGrantCreated struct
+type EventGrantCreated struct {
+	ID GrantID
+}
+
+// ToSDKEvent method creates new sdk event for EventGrantCreated struct
+func (ev EventGrantCreated) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionGrantCreated),
+		}, GrantIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventGrantRevoked struct
+type EventGrantRevoked struct {
+	ID GrantID
+}
+
+// ToSDKEvent method creates new sdk event for EventGrantRevoked struct
+func (ev EventGrantRevoked) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionGrantRevoked),
+		}, GrantIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// GrantIDEVAttributes returns event attribues for given GrantID
+func GrantIDEVAttributes(id GrantID) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(evGranterKey, id.Granter.String()),
+		sdk.NewAttribute(evGranteeKey, id.Grantee.String()),
+		sdk.NewAttribute(evMsgTypeKey, id.MsgType),
+		sdk.NewAttribute(evDSeqKey, strconv.FormatUint(id.DSeq, 10)),
+	}
+}
+
+// ParseEVGrantID returns GrantID details for given event attributes
+func ParseEVGrantID(attrs []sdk.Attribute) (GrantID, error) {
+	granter, err := sdkutil.GetAccAddress(attrs, evGranterKey)
+	if err != nil {
+		return GrantID{}, err
+	}
+
+	grantee, err := sdkutil.GetAccAddress(attrs, evGranteeKey)
+	if err != nil {
+		return GrantID{}, err
+	}
+
+	msgType, err := sdkutil.GetString(attrs, evMsgTypeKey)
+	if err != nil {
+		return GrantID{}, err
+	}
+
+	dseq, err := sdkutil.GetUint64(attrs, evDSeqKey)
+	if err != nil {
+		return GrantID{}, err
+	}
+
+	return GrantID{
+		Granter: granter,
+		Grantee: grantee,
+		MsgType: msgType,
+		DSeq:    dseq,
+	}, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+	switch ev.Action {
+	case evActionGrantCreated:
+		id, err := ParseEVGrantID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventGrantCreated{ID: id}, nil
+	case evActionGrantRevoked:
+		id, err := ParseEVGrantID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventGrantRevoked{ID: id}, nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
@@ -0,0 +1,97 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+)
+
+const (
+	msgTypeGrant  = "grant"
+	msgTypeRevoke = "revoke"
+)
+
+// MsgGrant defines an SDK message for a granter authorizing a grantee.
+// An existing grant with the same ID is replaced.
+type MsgGrant struct {
+	Grant Grant `json:"grant"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgGrant) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgGrant) Type() string { return msgTypeGrant }
+
+// ValidateBasic does basic validation
+func (msg MsgGrant) ValidateBasic() error {
+	if err := msg.Grant.GrantID.Validate(); err != nil {
+		return err
+	}
+	if !msg.Grant.SpendLimit.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidSpendLimit, msg.Grant.SpendLimit.String())
+	}
+	if !msg.Grant.PriceCap.IsValid() {
+		return sdkerrors.Wrap(ErrInvalidPriceCap, msg.Grant.PriceCap.String())
+	}
+	if msg.Grant.ExpiresAt < 0 {
+		return sdkerrors.Wrapf(ErrInvalidExpiry, "negative height %v", msg.Grant.ExpiresAt)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgGrant) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgGrant) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Grant.Granter}
+}
+
+// MsgRevoke defines an SDK message for a granter revoking a grant
+type MsgRevoke struct {
+	ID GrantID `json:"id"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRevoke) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgRevoke) Type() string { return msgTypeRevoke }
+
+// ValidateBasic does basic validation
+func (msg MsgRevoke) ValidateBasic() error {
+	return msg.ID.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevoke) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevoke) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ID.Granter}
+}
+
+// Validate checks the addresses and message type of the grant ID
+func (id GrantID) Validate() error {
+	if err := sdk.VerifyAddressFormat(id.Granter); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAddress, "invalid granter address")
+	}
+	if err := sdk.VerifyAddressFormat(id.Grantee); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAddress, "invalid grantee address")
+	}
+	if id.Granter.Equals(id.Grantee) {
+		return ErrSelfGrant
+	}
+	for _, msgType := range dtypes.DelegableMsgTypes() {
+		if id.MsgType == msgType {
+			return nil
+		}
+	}
+	return sdkerrors.Wrapf(ErrInvalidMsgType, "%q is not one of %v", id.MsgType, dtypes.DelegableMsgTypes())
+}
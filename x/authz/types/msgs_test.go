@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+)
+
+func TestMsgGrantMsgType(t *testing.T) {
+	msg := MsgGrant{Grant: Grant{GrantID: GrantID{
+		Granter: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+		Grantee: sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()),
+	}}}
+
+	for _, msgType := range dtypes.DelegableMsgTypes() {
+		msg.Grant.MsgType = msgType
+		require.NoError(t, msg.ValidateBasic(), msgType)
+	}
+
+	for _, msgType := range []string{"", "transfer-deployment", "send"} {
+		msg.Grant.MsgType = msgType
+		require.True(t, errors.Is(msg.ValidateBasic(), ErrInvalidMsgType), msgType)
+	}
+}
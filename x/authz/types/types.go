@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GrantID identifies the authorization a granter gave a grantee for one message type.
+// A zero DSeq covers every deployment of the granter.
+type GrantID struct {
+	Granter sdk.AccAddress `json:"granter"`
+	Grantee sdk.AccAddress `json:"grantee"`
+	MsgType string         `json:"msg_type"`
+	DSeq    uint64         `json:"dseq"`
+}
+
+// Grant authorizes the grantee to sign messages of one type on behalf of the granter
+type Grant struct {
+	GrantID `json:"id"`
+
+	// SpendLimit is what remains of the total price per block of the groups the grantee
+	// may create or update; each authorized message deducts the price of its groups.
+	// An empty limit is unlimited. The grant is removed once the limit is used up.
+	SpendLimit sdk.Coins `json:"spend_limit"`
+
+	// PriceCap caps the total price per block of the groups created or updated by
+	// each message the grantee signs; an empty cap is unlimited.
+	PriceCap sdk.Coins `json:"price_cap,omitempty"`
+
+	// ExpiresAt is the block height from which the grant is no longer valid; zero never expires
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+}
+
+// ID returns the GrantID of the grant
+func (g Grant) ID() GrantID {
+	return g.GrantID
+}
+
+// Expired returns true if the grant is no longer valid at the given height
+func (g Grant) Expired(height int64) bool {
+	return g.ExpiresAt != 0 && g.ExpiresAt <= height
+}
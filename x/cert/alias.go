@@ -0,0 +1,23 @@
+package cert
+
+import (
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+const (
+	// StoreKey represents storekey of cert module
+	StoreKey = types.StoreKey
+	// ModuleName represents current module name
+	ModuleName = types.ModuleName
+)
+
+type (
+	// Keeper defines keeper of cert module
+	Keeper = keeper.Keeper
+)
+
+var (
+	// NewKeeper creates new keeper instance of cert module
+	NewKeeper = keeper.NewKeeper
+)
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/x/cert/query"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// GetQueryCmd returns the query commands for the cert module
+func GetQueryCmd(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Certificate query commands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(flags.GetCommands(
+		cmdGetCertificates(key, cdc),
+		cmdGetCertificate(key, cdc),
+	)...)
+
+	return cmd
+}
+
+func cmdGetCertificates(key string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Query for all certificates",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			obj, err := query.NewClient(ctx, key).Certificates()
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+}
+
+func cmdGetCertificate(key string, cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get [owner] [fingerprint]",
+		Short: "Query certificates of an account, optionally only the one with the given fingerprint",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 1 {
+				obj, err := query.NewClient(ctx, key).OwnerCertificates(owner)
+				if err != nil {
+					return err
+				}
+				return ctx.PrintOutput(obj)
+			}
+
+			obj, err := query.NewClient(ctx, key).Certificate(types.CertificateID{
+				Owner:       owner,
+				Fingerprint: args[1],
+			})
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+}
@@ -0,0 +1,156 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/cosmos/cosmos-sdk/x/auth/client/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ovrclk/akash/x/cert/types"
+	cutils "github.com/ovrclk/akash/x/cert/utils"
+)
+
+const (
+	flagHost     = "host"
+	flagValidFor = "valid-for"
+	flagOverride = "override"
+)
+
+// GetTxCmd returns the transaction commands for cert module
+func GetTxCmd(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Certificate transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(cmdGenerate(key, cdc))
+	cmd.AddCommand(flags.PostCommands(
+		cmdCreate(key, cdc),
+		cmdRevoke(key, cdc),
+	)...)
+	return cmd
+}
+
+func cmdGenerate(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate",
+		Short: "Generate a key pair for the --from account and save it to <home>/<address>.pem",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			owner := ctx.GetFromAddress()
+			path := cutils.DefaultPath(viper.GetString(flags.FlagHome), owner)
+
+			if _, err := os.Stat(path); err == nil && !viper.GetBool(flagOverride) {
+				return fmt.Errorf("%s exists; use --%s to replace it", path, flagOverride)
+			}
+
+			hosts, err := cmd.Flags().GetStringSlice(flagHost)
+			if err != nil {
+				return err
+			}
+
+			validFor, err := cmd.Flags().GetDuration(flagValidFor)
+			if err != nil {
+				return err
+			}
+
+			pair, err := cutils.Generate(owner, hosts, validFor)
+			if err != nil {
+				return err
+			}
+
+			if err := cutils.Write(path, pair); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), path)
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of private key the certificate is issued to")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|test)")
+	cmd.Flags().StringSlice(flagHost, nil, "Host names or IP addresses the certificate is served at; required for provider gateways")
+	cmd.Flags().Duration(flagValidFor, 365*24*time.Hour, "How long the certificate is valid for")
+	cmd.Flags().Bool(flagOverride, false, "Replace an existing key pair")
+	viper.BindPFlag(flags.FlagFrom, cmd.Flags().Lookup(flags.FlagFrom))
+	viper.BindPFlag(flags.FlagKeyringBackend, cmd.Flags().Lookup(flags.FlagKeyringBackend))
+	viper.BindPFlag(flagOverride, cmd.Flags().Lookup(flagOverride))
+
+	return cmd
+}
+
+func cmdCreate(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create [pem-file]",
+		Short: "Publish a certificate or public key; defaults to the one generated for the --from account",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			path := cutils.DefaultPath(viper.GetString(flags.FlagHome), ctx.GetFromAddress())
+			if len(args) > 0 {
+				path = args[0]
+			}
+
+			pem, err := cutils.ReadCertificatePEM(path)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgCreateCertificate{
+				Owner: ctx.GetFromAddress(),
+				PEM:   pem,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func cmdRevoke(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [fingerprint]",
+		Short: "Revoke a certificate of the --from account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.MsgRevokeCertificate{
+				ID: types.CertificateID{
+					Owner:       ctx.GetFromAddress(),
+					Fingerprint: args[0],
+				},
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
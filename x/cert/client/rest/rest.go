@@ -0,0 +1,72 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	"github.com/ovrclk/akash/x/cert/query"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// RegisterRoutes registers all query routes
+func RegisterRoutes(ctx context.CLIContext, r *mux.Router, ns string) {
+	// Get all certificates
+	r.HandleFunc(fmt.Sprintf("/%s/list", ns), listCertificatesHandler(ctx, ns)).Methods("GET")
+
+	// Get certificates of a single account
+	r.HandleFunc(fmt.Sprintf("/%s/certificates/{owner}", ns), getOwnerCertificatesHandler(ctx, ns)).Methods("GET")
+
+	// Get a single certificate
+	r.HandleFunc(fmt.Sprintf("/%s/certificates/{owner}/{fingerprint}", ns), getCertificateHandler(ctx, ns)).Methods("GET")
+}
+
+func listCertificatesHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		res, err := query.NewRawClient(ctx, ns).Certificates()
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getOwnerCertificatesHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["owner"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		res, err := query.NewRawClient(ctx, ns).OwnerCertificates(owner)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
+
+func getCertificateHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		owner, err := sdk.AccAddressFromBech32(mux.Vars(r)["owner"])
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Invalid address")
+			return
+		}
+		res, err := query.NewRawClient(ctx, ns).Certificate(types.CertificateID{
+			Owner:       owner,
+			Fingerprint: mux.Vars(r)["fingerprint"],
+		})
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
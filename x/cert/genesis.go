@@ -0,0 +1,61 @@
+package cert
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// GenesisState defines the basic genesis state used by cert module
+type GenesisState struct {
+	Certificates []types.Certificate `json:"certificates"`
+}
+
+// ValidateGenesis does validation check of the Genesis and returns error incase of failure
+func ValidateGenesis(data GenesisState) error {
+	for _, record := range data.Certificates {
+		msg := types.MsgCreateCertificate{Owner: record.Owner, PEM: record.PEM}
+		if err := msg.ValidateBasic(); err != nil {
+			return err
+		}
+		pubkey, _, _ := types.ParsePEM(record.PEM)
+		if types.Fingerprint(pubkey) != record.Fingerprint {
+			return errors.Wrapf(types.ErrInvalidCertificate, "fingerprint mismatch: %s", record.Fingerprint)
+		}
+	}
+	return nil
+}
+
+// InitGenesis initiate genesis state and return updated validator details
+func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	for _, record := range data.Certificates {
+		if _, err := keeper.CreateCertificate(ctx, record.Owner, record.PEM); err != nil {
+			panic(err)
+		}
+		if record.State == types.CertificateRevoked {
+			if err := keeper.RevokeCertificate(ctx, record.ID()); err != nil {
+				panic(err)
+			}
+		}
+	}
+	return []abci.ValidatorUpdate{}
+}
+
+// ExportGenesis returns genesis state for the cert module
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
+	var records []types.Certificate
+	k.WithCertificates(ctx, func(record types.Certificate) bool {
+		records = append(records, record)
+		return false
+	})
+	return GenesisState{Certificates: records}
+}
+
+// DefaultGenesisState returns default genesis state as raw bytes for the cert
+// module.
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
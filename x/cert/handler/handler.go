@@ -0,0 +1,45 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// NewHandler returns a handler for "cert" type messages.
+func NewHandler(keeper keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		switch msg := msg.(type) {
+		case types.MsgCreateCertificate:
+			return handleMsgCreateCertificate(ctx, keeper, msg)
+		case types.MsgRevokeCertificate:
+			return handleMsgRevokeCertificate(ctx, keeper, msg)
+		default:
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized cert message type: %T", msg)
+		}
+	}
+}
+
+func handleMsgCreateCertificate(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgCreateCertificate) (*sdk.Result, error) {
+	if _, err := keeper.CreateCertificate(ctx, msg.Owner, msg.PEM); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgRevokeCertificate(ctx sdk.Context, keeper keeper.Keeper,
+	msg types.MsgRevokeCertificate) (*sdk.Result, error) {
+	if err := keeper.RevokeCertificate(ctx, msg.ID); err != nil {
+		return nil, err
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
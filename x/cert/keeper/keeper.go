@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// Keeper of the cert store
+type Keeper struct {
+	skey sdk.StoreKey
+	cdc  *codec.Codec
+}
+
+// NewKeeper creates and returns an instance for cert keeper
+func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey) Keeper {
+	return Keeper{
+		skey: skey,
+		cdc:  cdc,
+	}
+}
+
+// Codec returns keeper codec
+func (k Keeper) Codec() *codec.Codec {
+	return k.cdc
+}
+
+// GetCertificate returns the certificate with given id
+func (k Keeper) GetCertificate(ctx sdk.Context, id types.CertificateID) (types.Certificate, bool) {
+	store := ctx.KVStore(k.skey)
+	key := certificateKey(id)
+
+	if !store.Has(key) {
+		return types.Certificate{}, false
+	}
+
+	var val types.Certificate
+	k.cdc.MustUnmarshalBinaryBare(store.Get(key), &val)
+	return val, true
+}
+
+// GetOwnerCertificates returns the certificates published by an account
+func (k Keeper) GetOwnerCertificates(ctx sdk.Context, owner sdk.AccAddress) types.Certificates {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, certificateOwnerKey(owner))
+	defer iter.Close()
+
+	var vals types.Certificates
+	for ; iter.Valid(); iter.Next() {
+		var val types.Certificate
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		vals = append(vals, val)
+	}
+	return vals
+}
+
+// CreateCertificate publishes a certificate or public key for its owner.
+// Certificates are identified by the fingerprint of their public key.
+func (k Keeper) CreateCertificate(ctx sdk.Context, owner sdk.AccAddress, pem []byte) (types.CertificateID, error) {
+	pubkey, _, err := types.ParsePEM(pem)
+	if err != nil {
+		return types.CertificateID{}, err
+	}
+
+	id := types.CertificateID{
+		Owner:       owner,
+		Fingerprint: types.Fingerprint(pubkey),
+	}
+
+	store := ctx.KVStore(k.skey)
+	key := certificateKey(id)
+
+	if store.Has(key) {
+		return types.CertificateID{}, types.ErrCertificateExists
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(types.Certificate{
+		CertificateID: id,
+		State:         types.CertificateValid,
+		PEM:           pem,
+	}))
+
+	ctx.EventManager().EmitEvent(
+		types.EventCertificateCreate{ID: id}.ToSDKEvent(),
+	)
+
+	return id, nil
+}
+
+// RevokeCertificate marks a certificate revoked. Revoked certificates are kept so
+// that their public key cannot be published again.
+func (k Keeper) RevokeCertificate(ctx sdk.Context, id types.CertificateID) error {
+	cert, found := k.GetCertificate(ctx, id)
+	if !found {
+		return types.ErrCertificateNotFound
+	}
+
+	if cert.State == types.CertificateRevoked {
+		return types.ErrCertificateRevoked
+	}
+
+	cert.State = types.CertificateRevoked
+
+	store := ctx.KVStore(k.skey)
+	store.Set(certificateKey(id), k.cdc.MustMarshalBinaryBare(cert))
+
+	ctx.EventManager().EmitEvent(
+		types.EventCertificateRevoke{ID: id}.ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// WithCertificates iterates all certificates
+func (k Keeper) WithCertificates(ctx sdk.Context, fn func(types.Certificate) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, certificatePrefix)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var val types.Certificate
+		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+	"github.com/ovrclk/akash/x/cert/utils"
+)
+
+func TestCertificateCreate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	pem := testCertificate(t, owner)
+
+	id, err := keeper.CreateCertificate(ctx, owner, pem)
+	require.NoError(t, err)
+	require.Equal(t, owner, id.Owner)
+
+	cert, found := keeper.GetCertificate(ctx, id)
+	require.True(t, found)
+	require.Equal(t, id, cert.ID())
+	require.Equal(t, types.CertificateValid, cert.State)
+	require.Equal(t, pem, cert.PEM)
+}
+
+func TestCertificateCreateDuplicate(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	pem := testCertificate(t, owner)
+
+	_, err := keeper.CreateCertificate(ctx, owner, pem)
+	require.NoError(t, err)
+
+	_, err = keeper.CreateCertificate(ctx, owner, pem)
+	require.True(t, errors.Is(err, types.ErrCertificateExists))
+}
+
+func TestCertificateRevoke(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+
+	id, err := keeper.CreateCertificate(ctx, owner, testCertificate(t, owner))
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.RevokeCertificate(ctx, id))
+
+	cert, found := keeper.GetCertificate(ctx, id)
+	require.True(t, found)
+	require.Equal(t, types.CertificateRevoked, cert.State)
+
+	err = keeper.RevokeCertificate(ctx, id)
+	require.True(t, errors.Is(err, types.ErrCertificateRevoked))
+}
+
+func TestCertificateRevokeNonExisting(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	err := keeper.RevokeCertificate(ctx, types.CertificateID{
+		Owner:       testutil.AccAddress(t),
+		Fingerprint: "00",
+	})
+	require.True(t, errors.Is(err, types.ErrCertificateNotFound))
+}
+
+func TestOwnerCertificates(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	owner := testutil.AccAddress(t)
+	other := testutil.AccAddress(t)
+
+	_, err := keeper.CreateCertificate(ctx, owner, testCertificate(t, owner))
+	require.NoError(t, err)
+	_, err = keeper.CreateCertificate(ctx, owner, testCertificate(t, owner))
+	require.NoError(t, err)
+	_, err = keeper.CreateCertificate(ctx, other, testCertificate(t, other))
+	require.NoError(t, err)
+
+	require.Len(t, keeper.GetOwnerCertificates(ctx, owner), 2)
+	require.Len(t, keeper.GetOwnerCertificates(ctx, other), 1)
+
+	count := 0
+	keeper.WithCertificates(ctx, func(types.Certificate) bool {
+		count++
+		return false
+	})
+	require.Equal(t, 3, count)
+}
+
+func testCertificate(t testing.TB, owner sdk.AccAddress) []byte {
+	t.Helper()
+	pair, err := utils.Generate(owner, nil, time.Hour)
+	require.NoError(t, err)
+	cert, err := utils.CertificatePEM(pair)
+	require.NoError(t, err)
+	return cert
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key)
+}
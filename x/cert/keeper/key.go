@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+var (
+	certificatePrefix = []byte{0x01}
+)
+
+func certificateKey(id types.CertificateID) []byte {
+	buf := bytes.NewBuffer(certificateOwnerKey(id.Owner))
+	buf.WriteString(id.Fingerprint)
+	return buf.Bytes()
+}
+
+func certificateOwnerKey(owner sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(append([]byte{}, certificatePrefix...))
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
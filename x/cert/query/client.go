@@ -0,0 +1,52 @@
+package query
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// Client interface
+type Client interface {
+	Certificates() (Certificates, error)
+	OwnerCertificates(sdk.AccAddress) (Certificates, error)
+	Certificate(types.CertificateID) (Certificate, error)
+}
+
+// NewClient creates a client instance with provided context and key
+func NewClient(ctx context.CLIContext, key string) Client {
+	return &client{ctx: ctx, key: key}
+}
+
+type client struct {
+	ctx context.CLIContext
+	key string
+}
+
+func (c *client) Certificates() (Certificates, error) {
+	var obj Certificates
+	buf, err := NewRawClient(c.ctx, c.key).Certificates()
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
+
+func (c *client) OwnerCertificates(owner sdk.AccAddress) (Certificates, error) {
+	var obj Certificates
+	buf, err := NewRawClient(c.ctx, c.key).OwnerCertificates(owner)
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
+
+func (c *client) Certificate(id types.CertificateID) (Certificate, error) {
+	var obj Certificate
+	buf, err := NewRawClient(c.ctx, c.key).Certificate(id)
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
@@ -0,0 +1,29 @@
+package query
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+const (
+	certificatesPath = "certificates"
+	certificatePath  = "certificate"
+)
+
+// getCertificatesPath returns certificates path for queries
+func getCertificatesPath() string {
+	return certificatesPath
+}
+
+// getOwnerCertificatesPath returns the path for all certificates of an account
+func getOwnerCertificatesPath(owner sdk.AccAddress) string {
+	return fmt.Sprintf("%s/%s", certificatePath, owner)
+}
+
+// getCertificatePath returns the path for a single certificate
+func getCertificatePath(id types.CertificateID) string {
+	return fmt.Sprintf("%s/%s/%s", certificatePath, id.Owner, id.Fingerprint)
+}
@@ -0,0 +1,59 @@
+package query
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/cert/keeper"
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// NewQuerier creates and returns a new cert querier instance
+func NewQuerier(keeper keeper.Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
+		switch path[0] {
+		case certificatesPath:
+			return queryCertificates(ctx, path[1:], req, keeper)
+		case certificatePath:
+			return queryCertificate(ctx, path[1:], req, keeper)
+		}
+		return []byte{}, sdkerrors.ErrUnknownRequest
+	}
+}
+
+func queryCertificates(ctx sdk.Context, _ []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	values := Certificates{}
+	keeper.WithCertificates(ctx, func(obj types.Certificate) bool {
+		values = append(values, Certificate(obj))
+		return false
+	})
+	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
+}
+
+func queryCertificate(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+	if len(path) != 1 && len(path) != 2 {
+		return nil, sdkerrors.ErrInvalidRequest
+	}
+
+	owner, err := sdk.AccAddressFromBech32(path[0])
+	if err != nil {
+		return nil, types.ErrInvalidAddress
+	}
+
+	if len(path) == 1 {
+		values := Certificates{}
+		for _, obj := range keeper.GetOwnerCertificates(ctx, owner) {
+			values = append(values, Certificate(obj))
+		}
+		return sdkutil.RenderQueryResponse(keeper.Codec(), values)
+	}
+
+	cert, ok := keeper.GetCertificate(ctx, types.CertificateID{Owner: owner, Fingerprint: path[1]})
+	if !ok {
+		return nil, types.ErrCertificateNotFound
+	}
+
+	return sdkutil.RenderQueryResponse(keeper.Codec(), Certificate(cert))
+}
@@ -0,0 +1,51 @@
+package query
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+// RawClient interface
+type RawClient interface {
+	Certificates() ([]byte, error)
+	OwnerCertificates(sdk.AccAddress) ([]byte, error)
+	Certificate(types.CertificateID) ([]byte, error)
+}
+
+// NewRawClient creates a client instance with provided context and key
+func NewRawClient(ctx context.CLIContext, key string) RawClient {
+	return &rawclient{ctx: ctx, key: key}
+}
+
+type rawclient struct {
+	ctx context.CLIContext
+	key string
+}
+
+func (c *rawclient) Certificates() ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getCertificatesPath()), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, err
+}
+
+func (c *rawclient) OwnerCertificates(owner sdk.AccAddress) ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getOwnerCertificatesPath(owner)), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, err
+}
+
+func (c *rawclient) Certificate(id types.CertificateID) ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getCertificatePath(id)), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, err
+}
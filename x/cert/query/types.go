@@ -0,0 +1,40 @@
+package query
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+type (
+	// Certificate type
+	Certificate types.Certificate
+	// Certificates - Slice of Certificate Struct
+	Certificates []Certificate
+)
+
+func (c Certificate) String() string {
+	return fmt.Sprintf(`Certificate
+	Owner:       %s
+	Fingerprint: %s
+	State:       %s
+	%s`, c.Owner, c.Fingerprint, c.State, c.PEM)
+}
+
+func (obj Certificates) String() string {
+	var buf bytes.Buffer
+
+	const sep = "\n\n"
+
+	for _, c := range obj {
+		buf.WriteString(c.String())
+		buf.WriteString(sep)
+	}
+
+	if len(obj) > 0 {
+		buf.Truncate(buf.Len() - len(sep))
+	}
+
+	return buf.String()
+}
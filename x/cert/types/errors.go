@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	errCertificateNotFound uint32 = iota + 1
+	errCertificateExists
+	errInvalidAddress
+	errInvalidCertificate
+	errCertificateRevoked
+	errCertificateMismatch
+)
+
+var (
+	// ErrCertificateNotFound certificate not found
+	ErrCertificateNotFound = sdkerrors.Register(ModuleName, errCertificateNotFound, "certificate not found")
+
+	// ErrCertificateExists certificate already exists
+	ErrCertificateExists = sdkerrors.Register(ModuleName, errCertificateExists, "certificate already exists")
+
+	// ErrInvalidAddress invalid owner address
+	ErrInvalidAddress = sdkerrors.Register(ModuleName, errInvalidAddress, "invalid address")
+
+	// ErrInvalidCertificate error code for certificates that cannot be parsed
+	ErrInvalidCertificate = sdkerrors.Register(ModuleName, errInvalidCertificate, "invalid certificate")
+
+	// ErrCertificateRevoked error code for revoked certificates
+	ErrCertificateRevoked = sdkerrors.Register(ModuleName, errCertificateRevoked, "certificate revoked")
+
+	// ErrCertificateMismatch error code for certificates that do not match the on-chain record
+	ErrCertificateMismatch = sdkerrors.Register(ModuleName, errCertificateMismatch, "certificate does not match record")
+)
@@ -0,0 +1,95 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/sdkutil"
+)
+
+const (
+	evActionCertificateCreate = "certificate-create"
+	evActionCertificateRevoke = "certificate-revoke"
+	evOwnerKey                = "owner"
+	evFingerprintKey          = "fingerprint"
+)
+
+// EventCertificateCreate struct
+type EventCertificateCreate struct {
+	ID CertificateID
+}
+
+// ToSDKEvent method creates new sdk event for EventCertificateCreate struct
+func (ev EventCertificateCreate) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionCertificateCreate),
+		}, CertificateIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventCertificateRevoke struct
+type EventCertificateRevoke struct {
+	ID CertificateID
+}
+
+// ToSDKEvent method creates new sdk event for EventCertificateRevoke struct
+func (ev EventCertificateRevoke) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionCertificateRevoke),
+		}, CertificateIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// CertificateIDEVAttributes returns event attribues for given CertificateID
+func CertificateIDEVAttributes(id CertificateID) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(evOwnerKey, id.Owner.String()),
+		sdk.NewAttribute(evFingerprintKey, id.Fingerprint),
+	}
+}
+
+// ParseEVCertificateID returns CertificateID details for given event attributes
+func ParseEVCertificateID(attrs []sdk.Attribute) (CertificateID, error) {
+	owner, err := sdkutil.GetAccAddress(attrs, evOwnerKey)
+	if err != nil {
+		return CertificateID{}, err
+	}
+
+	fingerprint, err := sdkutil.GetString(attrs, evFingerprintKey)
+	if err != nil {
+		return CertificateID{}, err
+	}
+
+	return CertificateID{
+		Owner:       owner,
+		Fingerprint: fingerprint,
+	}, nil
+}
+
+// ParseEvent parses event and returns details of event and error if occurred
+func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
+	if ev.Type != sdkutil.EventTypeMessage {
+		return nil, sdkutil.ErrUnknownType
+	}
+	if ev.Module != ModuleName {
+		return nil, sdkutil.ErrUnknownModule
+	}
+	switch ev.Action {
+	case evActionCertificateCreate:
+		id, err := ParseEVCertificateID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventCertificateCreate{ID: id}, nil
+	case evActionCertificateRevoke:
+		id, err := ParseEVCertificateID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventCertificateRevoke{ID: id}, nil
+	default:
+		return nil, sdkutil.ErrUnknownAction
+	}
+}
@@ -0,0 +1,83 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+)
+
+const (
+	msgTypeCreateCertificate = "create-certificate"
+	msgTypeRevokeCertificate = "revoke-certificate"
+)
+
+// MsgCreateCertificate defines an SDK message for publishing a certificate or public key
+type MsgCreateCertificate struct {
+	Owner sdk.AccAddress `json:"owner"`
+	PEM   []byte         `json:"pem"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgCreateCertificate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgCreateCertificate) Type() string { return msgTypeCreateCertificate }
+
+// ValidateBasic does basic validation
+func (msg MsgCreateCertificate) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAddress, "invalid owner address")
+	}
+
+	_, cert, err := ParsePEM(msg.PEM)
+	if err != nil {
+		return err
+	}
+
+	if cert != nil && cert.Subject.CommonName != msg.Owner.String() {
+		return errors.Wrapf(ErrInvalidCertificate, "common name %q is not the owner address", cert.Subject.CommonName)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgCreateCertificate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgCreateCertificate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgRevokeCertificate defines an SDK message for revoking a certificate
+type MsgRevokeCertificate struct {
+	ID CertificateID `json:"id"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgRevokeCertificate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgRevokeCertificate) Type() string { return msgTypeRevokeCertificate }
+
+// ValidateBasic does basic validation
+func (msg MsgRevokeCertificate) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.ID.Owner); err != nil {
+		return sdkerrors.Wrap(ErrInvalidAddress, "invalid owner address")
+	}
+	if msg.ID.Fingerprint == "" {
+		return errors.Wrap(ErrInvalidCertificate, "empty fingerprint")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgRevokeCertificate) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgRevokeCertificate) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ID.Owner}
+}
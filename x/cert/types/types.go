@@ -0,0 +1,113 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+)
+
+//go:generate stringer -linecomment -output=autogen_stringer.go -type=CertificateState
+
+// CertificateState defines state of a certificate
+type CertificateState uint8
+
+const (
+	// CertificateValid is used when the certificate may be used to authenticate its owner
+	CertificateValid CertificateState = iota + 1 // valid
+	// CertificateRevoked is used when the owner revoked the certificate
+	CertificateRevoked // revoked
+)
+
+// CertificateStateMap is used to decode certificate state flag value
+var CertificateStateMap = map[string]CertificateState{
+	"valid":   CertificateValid,
+	"revoked": CertificateRevoked,
+}
+
+const (
+	// PEMBlockCertificate is the PEM block type of X.509 certificates
+	PEMBlockCertificate = "CERTIFICATE"
+	// PEMBlockPublicKey is the PEM block type of PKIX public keys
+	PEMBlockPublicKey = "PUBLIC KEY"
+)
+
+// CertificateID identifies a certificate by its owner and the fingerprint of its public key
+type CertificateID struct {
+	Owner       sdk.AccAddress `json:"owner"`
+	Fingerprint string         `json:"fingerprint"`
+}
+
+// Certificate stores a PEM encoded X.509 certificate or public key published by an account
+type Certificate struct {
+	CertificateID `json:"id"`
+	State         CertificateState `json:"state"`
+	PEM           []byte           `json:"pem"`
+}
+
+// ID method returns CertificateID details of specific certificate
+func (c Certificate) ID() CertificateID {
+	return c.CertificateID
+}
+
+// Certificates is a list of certificates
+type Certificates []Certificate
+
+// ParsePEM decodes a PEM encoded X.509 certificate or PKIX public key. It
+// returns the DER encoded public key and the certificate, which is nil for
+// public keys.
+func ParsePEM(buf []byte) ([]byte, *x509.Certificate, error) {
+	block, rest := pem.Decode(buf)
+	if block == nil {
+		return nil, nil, errors.Wrap(ErrInvalidCertificate, "no PEM data")
+	}
+	if len(bytes.TrimSpace(rest)) != 0 {
+		return nil, nil, errors.Wrap(ErrInvalidCertificate, "unexpected data after PEM block")
+	}
+
+	switch block.Type {
+	case PEMBlockCertificate:
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, nil, errors.Wrap(ErrInvalidCertificate, err.Error())
+		}
+		return cert.RawSubjectPublicKeyInfo, cert, nil
+	case PEMBlockPublicKey:
+		if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			return nil, nil, errors.Wrap(ErrInvalidCertificate, err.Error())
+		}
+		return block.Bytes, nil, nil
+	default:
+		return nil, nil, errors.Wrapf(ErrInvalidCertificate, "unsupported PEM block %q", block.Type)
+	}
+}
+
+// Fingerprint returns the hex encoded SHA-256 digest of a DER encoded public key
+func Fingerprint(pubkey []byte) string {
+	sum := sha256.Sum256(pubkey)
+	return hex.EncodeToString(sum[:])
+}
+
+// Authenticates returns nil if cert is a certificate of owner matching this
+// valid record: it must carry the owner's address as its common name, be
+// within its validity period at now and have the recorded public key.
+func (c Certificate) Authenticates(owner sdk.AccAddress, cert *x509.Certificate, now time.Time) error {
+	if c.State != CertificateValid {
+		return errors.Wrapf(ErrCertificateRevoked, "%s/%s", c.Owner, c.Fingerprint)
+	}
+	if !c.Owner.Equals(owner) || cert.Subject.CommonName != owner.String() {
+		return errors.Wrapf(ErrCertificateMismatch, "certificate not issued to %s", owner)
+	}
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return errors.Wrap(ErrCertificateMismatch, "certificate expired or not yet valid")
+	}
+	if Fingerprint(cert.RawSubjectPublicKeyInfo) != c.Fingerprint {
+		return errors.Wrap(ErrCertificateMismatch, "public key does not match")
+	}
+	return nil
+}
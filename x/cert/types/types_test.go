@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	"github.com/ovrclk/akash/x/cert/types"
+	"github.com/ovrclk/akash/x/cert/utils"
+)
+
+func testAddress() sdk.AccAddress {
+	return sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+}
+
+func testCertificate(t *testing.T, owner sdk.AccAddress) ([]byte, []byte) {
+	pair, err := utils.Generate(owner, nil, time.Hour)
+	require.NoError(t, err)
+	cert, err := utils.CertificatePEM(pair)
+	require.NoError(t, err)
+	return pair, cert
+}
+
+func TestParsePEM(t *testing.T) {
+	owner := testAddress()
+	pair, pem := testCertificate(t, owner)
+
+	pubkey, cert, err := types.ParsePEM(pem)
+	require.NoError(t, err)
+	assert.Equal(t, cert.RawSubjectPublicKeyInfo, pubkey)
+	assert.Equal(t, owner.String(), cert.Subject.CommonName)
+
+	// private keys must never be published
+	_, _, err = types.ParsePEM(pair)
+	assert.True(t, errors.Is(err, types.ErrInvalidCertificate))
+
+	_, _, err = types.ParsePEM([]byte("garbage"))
+	assert.True(t, errors.Is(err, types.ErrInvalidCertificate))
+}
+
+func TestCertificateAuthenticates(t *testing.T) {
+	owner := testAddress()
+	_, pem := testCertificate(t, owner)
+
+	pubkey, cert, err := types.ParsePEM(pem)
+	require.NoError(t, err)
+
+	record := types.Certificate{
+		CertificateID: types.CertificateID{Owner: owner, Fingerprint: types.Fingerprint(pubkey)},
+		State:         types.CertificateValid,
+		PEM:           pem,
+	}
+	now := time.Now()
+
+	assert.NoError(t, record.Authenticates(owner, cert, now))
+
+	err = record.Authenticates(testAddress(), cert, now)
+	assert.True(t, errors.Is(err, types.ErrCertificateMismatch))
+
+	err = record.Authenticates(owner, cert, now.Add(2*time.Hour))
+	assert.True(t, errors.Is(err, types.ErrCertificateMismatch))
+
+	_, other := testCertificate(t, owner)
+	_, ocert, err := types.ParsePEM(other)
+	require.NoError(t, err)
+	err = record.Authenticates(owner, ocert, now)
+	assert.True(t, errors.Is(err, types.ErrCertificateMismatch))
+
+	record.State = types.CertificateRevoked
+	err = record.Authenticates(owner, cert, now)
+	assert.True(t, errors.Is(err, types.ErrCertificateRevoked))
+}
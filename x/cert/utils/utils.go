@@ -0,0 +1,125 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"path/filepath"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	"github.com/ovrclk/akash/x/cert/types"
+)
+
+const pemBlockECPrivateKey = "EC PRIVATE KEY"
+
+// DefaultPath returns where the key pair of an account is kept in the home directory
+func DefaultPath(home string, owner sdk.AccAddress) string {
+	return filepath.Join(home, owner.String()+".pem")
+}
+
+// Generate creates a self signed certificate for owner valid for the given
+// duration, along with its private key. Hosts are added as DNS names or IP
+// addresses so the certificate can be served by a provider gateway.
+func Generate(owner sdk.AccAddress, hosts []string, validFor time.Duration) ([]byte, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	template := x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: owner.String()},
+		NotBefore:    now,
+		NotAfter:     now.Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	for _, host := range hosts {
+		if ip := net.ParseIP(host); ip != nil {
+			template.IPAddresses = append(template.IPAddresses, ip)
+		} else {
+			template.DNSNames = append(template.DNSNames, host)
+		}
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		return nil, err
+	}
+
+	kder, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := pem.Encode(&buf, &pem.Block{Type: types.PEMBlockCertificate, Bytes: der}); err != nil {
+		return nil, err
+	}
+	if err := pem.Encode(&buf, &pem.Block{Type: pemBlockECPrivateKey, Bytes: kder}); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Write saves a key pair readable by its owner only
+func Write(path string, pair []byte) error {
+	return ioutil.WriteFile(path, pair, 0600)
+}
+
+// LoadKeyPair reads the certificate and private key kept at path
+func LoadKeyPair(path string) (tls.Certificate, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.X509KeyPair(buf, buf)
+}
+
+// ReadCertificatePEM returns the PEM encoded certificate or public key kept at
+// path, leaving out any private key
+func ReadCertificatePEM(path string) ([]byte, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := CertificatePEM(buf)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading %s", path)
+	}
+	return cert, nil
+}
+
+// CertificatePEM returns the first PEM encoded certificate or public key of
+// a key pair, leaving out any private key
+func CertificatePEM(pair []byte) ([]byte, error) {
+	for {
+		var block *pem.Block
+		block, pair = pem.Decode(pair)
+		if block == nil {
+			return nil, errors.Wrap(types.ErrInvalidCertificate, "no certificate found")
+		}
+		if block.Type == types.PEMBlockCertificate || block.Type == types.PEMBlockPublicKey {
+			return pem.EncodeToMemory(block), nil
+		}
+	}
+}
@@ -10,6 +10,8 @@ const (
 	StoreKey = types.StoreKey
 	// ModuleName represents current module name
 	ModuleName = types.ModuleName
+	// DefaultParamspace represents the paramstore subspace of deployment module
+	DefaultParamspace = types.DefaultParamspace
 )
 
 type (
@@ -20,4 +22,7 @@ type (
 var (
 	// NewKeeper creates new keeper instance of deployment module
 	NewKeeper = keeper.NewKeeper
+
+	// RegisterMigrations registers the deployment store migrations
+	RegisterMigrations = keeper.RegisterMigrations
 )
@@ -0,0 +1,257 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/ovrclk/akash/sdl"
+	"github.com/ovrclk/akash/x/deployment/types"
+)
+
+const (
+	flagBlockTime  = "block-time"
+	flagMinRuntime = "min-runtime"
+
+	// DefaultBlockTime is the average block time used to convert per block prices
+	DefaultBlockTime = 6 * time.Second
+	// DefaultMinRuntime is the runtime the owner's balance must cover to create a deployment
+	DefaultMinRuntime = time.Hour
+
+	month = 30 * 24 * time.Hour
+)
+
+var (
+	// ErrInsufficientBalance is the error when the owner's balance does not cover the minimum runtime
+	ErrInsufficientBalance = errors.New("insufficient balance")
+	// ErrInvalidBlockTime is the error when the block time is not positive
+	ErrInvalidBlockTime = errors.New("invalid block time")
+)
+
+// CostEstimate is the maximum cost of a deployment, the sum of all its group prices
+type CostEstimate struct {
+	BlockTime time.Duration `json:"block_time"`
+	PerBlock  sdk.Coins     `json:"per_block"`
+	PerHour   sdk.DecCoins  `json:"per_hour"`
+	PerDay    sdk.DecCoins  `json:"per_day"`
+	PerMonth  sdk.DecCoins  `json:"per_month"`
+
+	Balance sdk.Coins `json:"balance,omitempty"`
+	// Runtime is how long the balance lasts at the maximum cost; zero if the balance was not queried
+	Runtime time.Duration `json:"runtime,omitempty"`
+}
+
+// NewCostEstimate returns the maximum cost of the given groups
+func NewCostEstimate(groups []types.GroupSpec, blockTime time.Duration) (CostEstimate, error) {
+	est := CostEstimate{BlockTime: blockTime, PerBlock: sdk.NewCoins()}
+	for _, group := range groups {
+		price, err := group.Price()
+		if err != nil {
+			return CostEstimate{}, err
+		}
+		est.PerBlock = est.PerBlock.Add(price)
+	}
+	est.PerHour = est.per(time.Hour)
+	est.PerDay = est.per(24 * time.Hour)
+	est.PerMonth = est.per(month)
+	return est, nil
+}
+
+// per returns the cost over the given duration
+func (est CostEstimate) per(duration time.Duration) sdk.DecCoins {
+	blocks := sdk.NewDec(int64(duration)).QuoInt64(int64(est.BlockTime))
+	return sdk.NewDecCoinsFromCoins(est.PerBlock...).MulDec(blocks)
+}
+
+// WithBalance returns the estimate with the runtime the balance covers, limited
+// by the denomination that runs out first
+func (est CostEstimate) WithBalance(balance sdk.Coins) CostEstimate {
+	if balance == nil {
+		balance = sdk.Coins{}
+	}
+	est.Balance = balance
+	est.Runtime = 0
+
+	// cap runtimes that do not fit a duration
+	maxBlocks := sdk.NewInt(int64(math.MaxInt64 / est.BlockTime))
+
+	for idx, price := range est.PerBlock {
+		runtime := time.Duration(math.MaxInt64)
+		if blocks := balance.AmountOf(price.Denom).Quo(price.Amount); blocks.LT(maxBlocks) {
+			runtime = time.Duration(blocks.Int64()) * est.BlockTime
+		}
+		if idx == 0 || runtime < est.Runtime {
+			est.Runtime = runtime
+		}
+	}
+	return est
+}
+
+// CoversRuntime returns true if the balance lasts at least the given runtime
+func (est CostEstimate) CoversRuntime(runtime time.Duration) bool {
+	return est.PerBlock.IsZero() || est.Runtime >= runtime
+}
+
+func (est CostEstimate) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Block Time: %v\n", est.BlockTime)
+	fmt.Fprintf(&sb, "Max Cost Per Block: %v\n", est.PerBlock)
+	fmt.Fprintf(&sb, "Max Cost Per Hour:  %v\n", est.PerHour)
+	fmt.Fprintf(&sb, "Max Cost Per Day:   %v\n", est.PerDay)
+	fmt.Fprintf(&sb, "Max Cost Per Month: %v\n", est.PerMonth)
+	if est.Balance != nil {
+		fmt.Fprintf(&sb, "Balance: %v\n", est.Balance)
+		fmt.Fprintf(&sb, "Funds Last: %v\n", est.Runtime)
+	}
+	return sb.String()
+}
+
+func cmdEstimate(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate [sdl-file]",
+		Short: "Estimate the maximum cost of a deployment and how long the owner's funds last",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			groups, err := groupsFromSDL(args[0])
+			if err != nil {
+				return err
+			}
+
+			blockTime, err := blockTimeFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			est, err := NewCostEstimate(groups, blockTime)
+			if err != nil {
+				return err
+			}
+
+			owner, err := cmd.Flags().GetString("owner")
+			if err != nil {
+				return err
+			}
+
+			var addr sdk.AccAddress
+			if owner != "" {
+				if addr, err = sdk.AccAddressFromBech32(owner); err != nil {
+					return err
+				}
+			} else {
+				addr = ctx.GetFromAddress()
+			}
+
+			if !addr.Empty() {
+				if est, err = estimateWithBalance(ctx, est, addr); err != nil {
+					return err
+				}
+
+				runtime, err := cmd.Flags().GetDuration(flagMinRuntime)
+				if err != nil {
+					return err
+				}
+				if !est.CoversRuntime(runtime) {
+					fmt.Fprintf(os.Stderr, "Warning: funds run out after %v, less than %v\n", est.Runtime, runtime)
+				}
+			}
+
+			return ctx.PrintOutput(est)
+		},
+	}
+	cmd.Flags().String("owner", "", "Account whose balance is compared with the cost (default: --from)")
+	AddMinRuntimeFlags(cmd.Flags())
+	return cmd
+}
+
+// AddBlockTimeFlag adds the block time flag used to convert per block prices
+func AddBlockTimeFlag(flags *pflag.FlagSet) {
+	flags.Duration(flagBlockTime, DefaultBlockTime, "Average block time used to convert per block prices")
+}
+
+// AddMinRuntimeFlags adds the flags of the balance check made before creating a deployment
+func AddMinRuntimeFlags(flags *pflag.FlagSet) {
+	AddBlockTimeFlag(flags)
+	flags.Duration(flagMinRuntime, DefaultMinRuntime,
+		"Minimum time the balance must cover the maximum cost of the deployment for; 0 disables the check")
+}
+
+// blockTimeFromFlags returns the block time given by flags, which per block
+// prices are divided by
+func blockTimeFromFlags(flags *pflag.FlagSet) (time.Duration, error) {
+	blockTime, err := flags.GetDuration(flagBlockTime)
+	if err != nil {
+		return 0, err
+	}
+	if blockTime <= 0 {
+		return 0, fmt.Errorf("%w: --%v must be positive, got %v", ErrInvalidBlockTime, flagBlockTime, blockTime)
+	}
+	return blockTime, nil
+}
+
+// CheckMinRuntime returns an error if the owner's balance does not cover the maximum cost
+// of the groups for the minimum runtime given by flags
+func CheckMinRuntime(ctx context.CLIContext, flags *pflag.FlagSet, groups []types.GroupSpec) error {
+	runtime, err := flags.GetDuration(flagMinRuntime)
+	if err != nil || runtime == 0 {
+		return err
+	}
+
+	blockTime, err := blockTimeFromFlags(flags)
+	if err != nil {
+		return err
+	}
+
+	est, err := NewCostEstimate(groups, blockTime)
+	if err != nil {
+		return err
+	}
+
+	est, err = estimateWithBalance(ctx, est, ctx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	if !est.CoversRuntime(runtime) {
+		return fmt.Errorf("%w: %v lasts %v at up to %v per block, less than the minimum runtime of %v (see --%v)",
+			ErrInsufficientBalance, est.Balance, est.Runtime, est.PerBlock, runtime, flagMinRuntime)
+	}
+	return nil
+}
+
+func estimateWithBalance(ctx context.CLIContext, est CostEstimate, addr sdk.AccAddress) (CostEstimate, error) {
+	acc, err := auth.NewAccountRetriever(ctx).GetAccount(addr)
+	if err != nil {
+		return est, err
+	}
+	return est.WithBalance(acc.GetCoins()), nil
+}
+
+func groupsFromSDL(path string) ([]types.GroupSpec, error) {
+	obj, err := sdl.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	dgroups, err := obj.DeploymentGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make([]types.GroupSpec, 0, len(dgroups))
+	for _, group := range dgroups {
+		groups = append(groups, *group)
+	}
+	return groups, nil
+}
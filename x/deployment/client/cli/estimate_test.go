@@ -0,0 +1,94 @@
+package cli_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/x/deployment/client/cli"
+	"github.com/ovrclk/akash/x/deployment/types"
+)
+
+func groupSpec(denom string, amount int64) types.GroupSpec {
+	return types.GroupSpec{
+		Name: "group",
+		Resources: []types.Resource{{
+			Count: 1,
+			Price: sdk.NewInt64Coin(denom, amount),
+		}},
+	}
+}
+
+func TestCostEstimate(t *testing.T) {
+	est, err := cli.NewCostEstimate([]types.GroupSpec{
+		groupSpec("akash", 10),
+		groupSpec("akash", 10),
+	}, 6*time.Second)
+	require.NoError(t, err)
+
+	assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("akash", 20)), est.PerBlock)
+	assert.Equal(t, sdk.NewDec(12000), est.PerHour.AmountOf("akash"))
+	assert.Equal(t, sdk.NewDec(288000), est.PerDay.AmountOf("akash"))
+	assert.Equal(t, sdk.NewDec(8640000), est.PerMonth.AmountOf("akash"))
+
+	est = est.WithBalance(sdk.NewCoins(sdk.NewInt64Coin("akash", 12030)))
+	assert.Equal(t, time.Hour+6*time.Second, est.Runtime)
+	assert.True(t, est.CoversRuntime(time.Hour))
+	assert.False(t, est.CoversRuntime(2*time.Hour))
+
+	est = est.WithBalance(nil)
+	assert.Equal(t, time.Duration(0), est.Runtime)
+	assert.False(t, est.CoversRuntime(time.Minute))
+}
+
+func TestCostEstimateDenoms(t *testing.T) {
+	est, err := cli.NewCostEstimate([]types.GroupSpec{
+		groupSpec("akash", 10),
+		groupSpec("other", 1),
+	}, time.Second)
+	require.NoError(t, err)
+
+	// the denomination that runs out first limits the runtime
+	est = est.WithBalance(sdk.NewCoins(
+		sdk.NewInt64Coin("akash", 1000),
+		sdk.NewInt64Coin("other", 10),
+	))
+	assert.Equal(t, 10*time.Second, est.Runtime)
+}
+
+func TestCostEstimateMixedDenoms(t *testing.T) {
+	group := groupSpec("akash", 10)
+	group.Resources = append(group.Resources, groupSpec("other", 1).Resources...)
+
+	_, err := cli.NewCostEstimate([]types.GroupSpec{group}, time.Second)
+	assert.True(t, types.ErrMixedPriceDenoms.Is(err))
+
+	flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	cli.AddMinRuntimeFlags(flags)
+	err = cli.CheckMinRuntime(context.CLIContext{}, flags, []types.GroupSpec{group})
+	assert.True(t, types.ErrMixedPriceDenoms.Is(err))
+}
+
+func TestCostEstimateFree(t *testing.T) {
+	est, err := cli.NewCostEstimate(nil, time.Second)
+	require.NoError(t, err)
+	est = est.WithBalance(nil)
+	assert.True(t, est.CoversRuntime(time.Hour))
+}
+
+func TestCheckMinRuntimeBlockTime(t *testing.T) {
+	for _, blockTime := range []string{"0s", "-6s"} {
+		flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		cli.AddMinRuntimeFlags(flags)
+		require.NoError(t, flags.Set("block-time", blockTime))
+
+		err := cli.CheckMinRuntime(context.CLIContext{}, flags, []types.GroupSpec{groupSpec("akash", 10)})
+		assert.True(t, errors.Is(err, cli.ErrInvalidBlockTime), blockTime)
+	}
+}
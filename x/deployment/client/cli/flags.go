@@ -1,7 +1,10 @@
 package cli
 
 import (
+	"encoding/base64"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/x/deployment/query"
 	"github.com/ovrclk/akash/x/deployment/types"
 	"github.com/spf13/cobra"
@@ -40,6 +43,25 @@ func DeploymentIDFromFlags(flags *pflag.FlagSet, defaultOwner string) (types.Dep
 	return id, nil
 }
 
+// AddExpiryFlags add flags for deployment expiry
+func AddExpiryFlags(flags *pflag.FlagSet) {
+	flags.Int64("expires-at", 0, "Block height at which the deployment is closed")
+	flags.Int64("expires-in", 0, "Number of blocks after which the deployment is closed")
+}
+
+// ExpiryFromFlags returns Expiry with given flags and error if occurred
+func ExpiryFromFlags(flags *pflag.FlagSet) (types.Expiry, error) {
+	var expiry types.Expiry
+	var err error
+	if expiry.Height, err = flags.GetInt64("expires-at"); err != nil {
+		return expiry, err
+	}
+	if expiry.Duration, err = flags.GetInt64("expires-in"); err != nil {
+		return expiry, err
+	}
+	return expiry, expiry.Validate()
+}
+
 // AddGroupIDFlags add flags for Group
 func AddGroupIDFlags(flags *pflag.FlagSet) {
 	AddDeploymentIDFlags(flags)
@@ -71,6 +93,7 @@ func GroupIDFromFlags(flags *pflag.FlagSet) (types.GroupID, error) {
 func AddDeploymentFilterFlags(flags *pflag.FlagSet) {
 	flags.String("owner", "", "deployment owner address to filter")
 	flags.String("state", "", "deployment state to filter (active,closed)")
+	AddPaginationFlags(flags)
 }
 
 // DepFiltersFromFlags returns DeploymentFilters with given flags and error if occurred
@@ -88,6 +111,10 @@ func DepFiltersFromFlags(flags *pflag.FlagSet) (query.DeploymentFilters, error)
 	if err != nil {
 		return dfilters, err
 	}
+	dfilters.Page, err = PageRequestFromFlags(flags)
+	if err != nil {
+		return dfilters, err
+	}
 	return dfilters, nil
 }
 
@@ -95,6 +122,7 @@ func DepFiltersFromFlags(flags *pflag.FlagSet) (query.DeploymentFilters, error)
 func AddGroupFilterFlags(flags *pflag.FlagSet) {
 	flags.String("owner", "", "group owner address to filter")
 	flags.String("state", "", "group state to filter (open,ordered,matched,insufficient-funds,closed)")
+	AddPaginationFlags(flags)
 }
 
 // GroupFiltersFromFlags returns GroupFilters with given flags and error if occurred
@@ -106,6 +134,32 @@ func GroupFiltersFromFlags(flags *pflag.FlagSet) (query.GroupFilters, error) {
 	gfilters := query.GroupFilters{
 		Owner:        dfilters.Owner,
 		StateFlagVal: dfilters.StateFlagVal,
+		Page:         dfilters.Page,
 	}
 	return gfilters, nil
 }
+
+// AddPaginationFlags add flags for paginated list queries
+func AddPaginationFlags(flags *pflag.FlagSet) {
+	flags.Uint64("limit", 0, "maximum number of records to return; the next page key is part of the output (default all)")
+	flags.String("page-key", "", "next_key of the previous page to continue from")
+}
+
+// PageRequestFromFlags returns PageRequest with given flags and error if occurred
+func PageRequestFromFlags(flags *pflag.FlagSet) (sdkutil.PageRequest, error) {
+	var page sdkutil.PageRequest
+	limit, err := flags.GetUint64("limit")
+	if err != nil {
+		return page, err
+	}
+	key, err := flags.GetString("page-key")
+	if err != nil {
+		return page, err
+	}
+	page.Key, err = base64.StdEncoding.DecodeString(key)
+	if err != nil {
+		return page, err
+	}
+	page.Limit = limit
+	return page, nil
+}
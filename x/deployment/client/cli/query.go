@@ -25,6 +25,7 @@ func GetQueryCmd(key string, cdc *codec.Codec) *cobra.Command {
 		cmdDeployments(key, cdc),
 		cmdDeployment(key, cdc),
 		getGroupCmd(key, cdc),
+		cmdEstimate(cdc),
 	)...)
 
 	return cmd
@@ -32,6 +32,9 @@ func GetTxCmd(key string, cdc *codec.Codec) *cobra.Command {
 		cmdUpdate(key, cdc),
 		cmdClose(key, cdc),
 		cmdGroupClose(key, cdc),
+		cmdGroup(key, cdc),
+		cmdTransfer(key, cdc),
+		cmdAccept(key, cdc),
 	)...)
 	return cmd
 }
@@ -67,10 +70,17 @@ func cmdCreate(key string, cdc *codec.Codec) *cobra.Command {
 				}
 			}
 
+			expiry, err := ExpiryFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgCreateDeployment{
 				ID: id,
 				// Version:  []byte{0x1, 0x2},
 				Groups: make([]types.GroupSpec, 0, len(groups)),
+				Expiry: expiry,
+				Signer: delegatedSigner(ctx, id),
 			}
 
 			for _, group := range groups {
@@ -81,10 +91,19 @@ func cmdCreate(key string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
+			// the balance can only be checked when connected to a node
+			if !ctx.GenerateOnly {
+				if err := CheckMinRuntime(ctx, cmd.Flags(), msg.Groups); err != nil {
+					return err
+				}
+			}
+
 			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
 		},
 	}
 	AddDeploymentIDFlags(cmd.Flags())
+	AddExpiryFlags(cmd.Flags())
+	AddMinRuntimeFlags(cmd.Flags())
 
 	return cmd
 }
@@ -103,7 +122,10 @@ func cmdClose(key string, cdc *codec.Codec) *cobra.Command {
 				return err
 			}
 
-			msg := types.MsgCloseDeployment{ID: id}
+			msg := types.MsgCloseDeployment{
+				ID:     id,
+				Signer: delegatedSigner(ctx, id),
+			}
 
 			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
 		},
@@ -121,19 +143,46 @@ func cmdUpdate(key string, cdc *codec.Codec) *cobra.Command {
 			ctx := context.NewCLIContext().WithCodec(cdc)
 			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
 
+			sdl, err := sdl.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			groups, err := sdl.DeploymentGroups()
+			if err != nil {
+				return err
+			}
+
 			id, err := DeploymentIDFromFlags(cmd.Flags(), ctx.GetFromAddress().String())
 			if err != nil {
 				return err
 			}
 
+			expiry, err := ExpiryFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			msg := types.MsgUpdateDeployment{
-				ID: id,
+				ID:     id,
+				Expiry: expiry,
+				Groups: make([]types.GroupSpec, 0, len(groups)),
+				Signer: delegatedSigner(ctx, id),
+			}
+
+			for _, group := range groups {
+				msg.Groups = append(msg.Groups, *group)
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
 
 			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
 		},
 	}
 	AddDeploymentIDFlags(cmd.Flags())
+	AddExpiryFlags(cmd.Flags())
 	return cmd
 }
 
@@ -167,3 +216,154 @@ func cmdGroupClose(_ string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func cmdGroup(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "group",
+		Short:                      "Deployment group transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+	cmd.AddCommand(flags.PostCommands(
+		cmdGroupPause(key, cdc),
+		cmdGroupStart(key, cdc),
+	)...)
+	return cmd
+}
+
+func cmdGroupPause(_ string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause",
+		Short:   "pause a Deployment's specific Group, closing its lease",
+		Example: "akashctl tx deployment group pause --owner=[Account Address] --dseq=[uint64] --gseq=[uint32]",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+			id, err := GroupIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPauseGroup{
+				ID: id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+	AddGroupIDFlags(cmd.Flags())
+	MarkReqGroupIDFlags(cmd)
+
+	return cmd
+}
+
+func cmdGroupStart(_ string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "start",
+		Short:   "start a Deployment's paused Group, ordering it anew",
+		Example: "akashctl tx deployment group start --owner=[Account Address] --dseq=[uint64] --gseq=[uint32]",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+			id, err := GroupIDFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgStartGroup{
+				ID: id,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+	AddGroupIDFlags(cmd.Flags())
+	MarkReqGroupIDFlags(cmd)
+
+	return cmd
+}
+
+func cmdTransfer(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer [new-owner]",
+		Short:   fmt.Sprintf("Offer %s to a new owner", key),
+		Example: "akashctl tx deployment transfer [Account Address] --dseq=[uint64]",
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := DeploymentIDFromFlags(cmd.Flags(), ctx.GetFromAddress().String())
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgTransferDeployment{
+				ID:       id,
+				NewOwner: owner,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+	AddDeploymentIDFlags(cmd.Flags())
+	cmd.MarkFlagRequired("dseq")
+	return cmd
+}
+
+func cmdAccept(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "accept",
+		Short:   fmt.Sprintf("Accept a %s offered to the sender", key),
+		Example: "akashctl tx deployment accept --owner=[Account Address] --dseq=[uint64]",
+		Args:    cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			id, err := DeploymentIDFromFlags(cmd.Flags(), "")
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgAcceptDeployment{
+				ID:       id,
+				NewOwner: ctx.GetFromAddress(),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+	AddDeploymentIDFlags(cmd.Flags())
+	MarkReqDeploymentIDFlags(cmd)
+	return cmd
+}
+
+// delegatedSigner returns the sender when it signs for another owner under an
+// authorization grant, and nil when the owner signs
+func delegatedSigner(ctx context.CLIContext, id types.DeploymentID) sdk.AccAddress {
+	if from := ctx.GetFromAddress(); !from.Equals(id.Owner) {
+		return from
+	}
+	return nil
+}
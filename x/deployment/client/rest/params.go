@@ -1,15 +1,19 @@
 package rest
 
 import (
+	"encoding/base64"
 	"net/http"
 	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/x/deployment/query"
 	"github.com/ovrclk/akash/x/deployment/types"
 )
 
+const defaultPageLimit = 100
+
 // DeploymentIDFromRequest returns DeploymentID from parsing request
 func DeploymentIDFromRequest(r *http.Request) (types.DeploymentID, string) {
 	ownerAddr := r.URL.Query().Get("owner")
@@ -55,9 +59,39 @@ func DepFiltersFromRequest(r *http.Request) (query.DeploymentFilters, string) {
 	if len(state) != 0 {
 		dfilters.StateFlagVal = state
 	}
+
+	page, errMsg := PageRequestFromRequest(r)
+	if len(errMsg) != 0 {
+		return query.DeploymentFilters{}, errMsg
+	}
+	dfilters.Page = page
+
 	return dfilters, ""
 }
 
+// PageRequestFromRequest returns PageRequest from the limit and page-key params in request
+func PageRequestFromRequest(r *http.Request) (sdkutil.PageRequest, string) {
+	page := sdkutil.PageRequest{Limit: defaultPageLimit}
+
+	if limit := r.URL.Query().Get("limit"); len(limit) != 0 {
+		num, err := strconv.ParseUint(limit, 10, 64)
+		if err != nil {
+			return sdkutil.PageRequest{}, err.Error()
+		}
+		page.Limit = num
+	}
+
+	if key := r.URL.Query().Get("page-key"); len(key) != 0 {
+		buf, err := base64.StdEncoding.DecodeString(key)
+		if err != nil {
+			return sdkutil.PageRequest{}, err.Error()
+		}
+		page.Key = buf
+	}
+
+	return page, ""
+}
+
 // GroupIDFromRequest returns GroupID from parsing request
 func GroupIDFromRequest(r *http.Request) (types.GroupID, string) {
 	dID, errMsg := DeploymentIDFromRequest(r)
@@ -90,6 +124,7 @@ func GroupFiltersFromRequest(r *http.Request) (query.GroupFilters, string) {
 	gfilters := query.GroupFilters{
 		Owner:        dfilters.Owner,
 		StateFlagVal: dfilters.StateFlagVal,
+		Page:         dfilters.Page,
 	}
 	return gfilters, ""
 }
@@ -15,9 +15,17 @@ type GenesisDeployment struct {
 	Groups []types.Group
 }
 
+// GenesisTransfer records a deployment ID that was transferred to another owner
+type GenesisTransfer struct {
+	ID       types.DeploymentID `json:"id"`
+	NewOwner sdk.AccAddress     `json:"new_owner"`
+}
+
 // GenesisState stores slice of genesis deployment instance
 type GenesisState struct {
+	Params      types.Params        `json:"params"`
 	Deployments []GenesisDeployment `json:"deployments"`
+	Transferred []GenesisTransfer   `json:"transferred,omitempty"`
 }
 
 // func NewGenesisState(deployments []Deployment) GenesisState {
@@ -28,25 +36,43 @@ type GenesisState struct {
 
 // ValidateGenesis does validation check of the Genesis and return error incase of failure
 func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
 	for _, record := range data.Deployments {
 		if err := record.Validate(); err != nil {
 			return errors.Wrap(err, types.ErrInvalidDeployment.Error())
 		}
 	}
+	for _, record := range data.Transferred {
+		if err := record.ID.Validate(); err != nil {
+			return errors.Wrap(err, types.ErrInvalidDeploymentID.Error())
+		}
+		if err := sdk.VerifyAddressFormat(record.NewOwner); err != nil {
+			return errors.Wrap(err, types.ErrInvalidTransfer.Error())
+		}
+	}
 	return nil
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the deployment
 // module.
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: types.DefaultParams(),
+	}
 }
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetStoreVersion(ctx, types.ConsensusVersion)
+	keeper.SetParams(ctx, data.Params)
 	for _, record := range data.Deployments {
 		keeper.Create(ctx, record.Deployment, record.Groups)
 	}
+	for _, record := range data.Transferred {
+		keeper.SetTransferred(ctx, record.ID, record.NewOwner)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
@@ -61,5 +87,14 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
 		})
 		return false
 	})
-	return GenesisState{Deployments: records}
+	var transferred []GenesisTransfer
+	k.WithTransferred(ctx, func(id types.DeploymentID, owner sdk.AccAddress) bool {
+		transferred = append(transferred, GenesisTransfer{ID: id, NewOwner: owner})
+		return false
+	})
+	return GenesisState{
+		Params:      k.GetParams(ctx),
+		Deployments: records,
+		Transferred: transferred,
+	}
 }
@@ -10,6 +10,25 @@ import (
 // Executed at the end of block
 func OnEndBlock(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper) {
 
+	// close expired deployments before ordering their groups
+	var expired []types.Deployment
+	keeper.WithDeploymentsExpired(ctx, ctx.BlockHeight(), func(d types.Deployment) bool {
+		expired = append(expired, d)
+		return false
+	})
+	for _, d := range expired {
+		// close in a cache so that a failed close leaves the deployment untouched
+		cctx, write := ctx.CacheContext()
+		cctx = cctx.WithEventManager(sdk.NewEventManager())
+		if err := closeDeployment(cctx, keeper, mkeeper, d); err != nil {
+			ctx.Logger().With("deployment", d.ID(), "error", err).Error("closing expired deployment")
+			continue
+		}
+		write()
+		ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+		ctx.Logger().Info("closed expired deployment", "deployment", d.ID(), "expires-at", d.ExpiresAt)
+	}
+
 	// create orders as necessary for Active Deployment
 	keeper.WithDeploymentsActive(ctx, func(d types.Deployment) bool {
 		for _, group := range keeper.GetGroups(ctx, d.ID()) {
@@ -26,7 +45,9 @@ func OnEndBlock(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper) {
 			}
 
 			// set state to ordered
-			keeper.OnOrderCreated(ctx, group)
+			if err := keeper.OnOrderCreated(ctx, group); err != nil {
+				ctx.Logger().With("group", group.ID(), "error", err).Error("ordering group")
+			}
 		}
 		return false
 	})
@@ -71,3 +71,46 @@ func TestEndBlock(t *testing.T) {
 		})
 	}
 }
+
+func TestEndBlockExpiry(t *testing.T) {
+	suite := setupTestSuite(t)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	expiring, groups := suite.createDeployment()
+	lasting, _ := suite.createDeployment()
+
+	for _, d := range []types.Deployment{expiring, lasting} {
+		msg := types.MsgCreateDeployment{
+			ID: d.ID(),
+		}
+		if d.ID().Equals(expiring.ID()) {
+			msg.Expiry = types.Expiry{Height: 12}
+		}
+		for _, group := range groups {
+			msg.Groups = append(msg.Groups, group.GroupSpec)
+		}
+		_, err := suite.handler(suite.ctx, msg)
+		assert.NoError(t, err)
+	}
+
+	handler.OnEndBlock(suite.ctx, suite.dkeeper, suite.mkeeper)
+
+	d, _ := suite.dkeeper.GetDeployment(suite.ctx, expiring.ID())
+	assert.Equal(t, types.DeploymentActive, d.State)
+
+	suite.ctx = suite.ctx.WithBlockHeight(12)
+	handler.OnEndBlock(suite.ctx, suite.dkeeper, suite.mkeeper)
+
+	d, _ = suite.dkeeper.GetDeployment(suite.ctx, expiring.ID())
+	assert.Equal(t, types.DeploymentClosed, d.State)
+	for _, g := range suite.dkeeper.GetGroups(suite.ctx, expiring.ID()) {
+		assert.Equal(t, types.GroupClosed, g.State)
+		suite.mkeeper.WithOrdersForGroup(suite.ctx, g.ID(), func(o mtypes.Order) bool {
+			assert.Equal(t, mtypes.OrderClosed, o.State)
+			return false
+		})
+	}
+
+	d, _ = suite.dkeeper.GetDeployment(suite.ctx, lasting.ID())
+	assert.Equal(t, types.DeploymentActive, d.State)
+}
@@ -1,6 +1,8 @@
 package handler
 
 import (
+	"reflect"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/pkg/errors"
@@ -11,39 +13,74 @@ import (
 )
 
 // NewHandler returns a handler for "deployment" type messages
-func NewHandler(keeper keeper.Keeper, mkeeper MarketKeeper) sdk.Handler {
+func NewHandler(keeper keeper.Keeper, mkeeper MarketKeeper, akeeper AuthzKeeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
 		case types.MsgCreateDeployment:
-			return handleMsgCreate(ctx, keeper, mkeeper, msg)
+			return handleMsgCreate(ctx, keeper, mkeeper, akeeper, msg)
 		case types.MsgUpdateDeployment:
-			return handleMsgUpdate(ctx, keeper, mkeeper, msg)
+			return handleMsgUpdate(ctx, keeper, mkeeper, akeeper, msg)
 		case types.MsgCloseDeployment:
-			return handleMsgCloseDeployment(ctx, keeper, mkeeper, msg)
+			return handleMsgCloseDeployment(ctx, keeper, mkeeper, akeeper, msg)
 		case types.MsgCloseGroup:
 			return handleMsgCloseGroup(ctx, keeper, mkeeper, msg)
+		case types.MsgPauseGroup:
+			return handleMsgPauseGroup(ctx, keeper, mkeeper, msg)
+		case types.MsgStartGroup:
+			return handleMsgStartGroup(ctx, keeper, mkeeper, msg)
+		case types.MsgTransferDeployment:
+			return handleMsgTransfer(ctx, keeper, msg)
+		case types.MsgAcceptDeployment:
+			return handleMsgAccept(ctx, keeper, mkeeper, msg)
 		default:
 			return nil, sdkerrors.ErrUnknownRequest
 		}
 	}
 }
 
-func handleMsgCreate(ctx sdk.Context, keeper keeper.Keeper, _ MarketKeeper, msg types.MsgCreateDeployment) (*sdk.Result, error) {
+func handleMsgCreate(ctx sdk.Context, keeper keeper.Keeper, _ MarketKeeper, akeeper AuthzKeeper,
+	msg types.MsgCreateDeployment) (*sdk.Result, error) {
 	if _, found := keeper.GetDeployment(ctx, msg.ID); found {
 		return nil, types.ErrDeploymentExists
 	}
 
+	if _, found := keeper.GetTransferred(ctx, msg.ID); found {
+		return nil, types.ErrDeploymentTransferred
+	}
+
 	deployment := types.Deployment{
 		DeploymentID: msg.ID,
 		State:        types.DeploymentActive,
 		// TODO: version
 		// Version: sdk.Address.Bytes(),
+		ExpiresAt: msg.Expiry.ExpiresAt(ctx.BlockHeight()),
+	}
+
+	if deployment.ExpiresAt != 0 && deployment.ExpiresAt <= ctx.BlockHeight() {
+		return nil, errors.Wrapf(types.ErrInvalidExpiry, "expiry %v not after current height", deployment.ExpiresAt)
 	}
 
 	if err := validation.ValidateDeploymentGroups(msg.Groups); err != nil {
 		return nil, errors.Wrap(types.ErrInvalidGroups, err.Error())
 	}
 
+	params := keeper.GetParams(ctx)
+	spend := sdk.NewCoins()
+	for _, spec := range msg.Groups {
+		price, err := spec.Price()
+		if err != nil {
+			return nil, err
+		}
+		if !params.IsPriceDenom(price.Denom) {
+			return nil, errors.Wrapf(types.ErrInvalidPriceDenom, "group %v: %v", spec.GetName(), price.Denom)
+		}
+		spend = spend.Add(price)
+	}
+
+	if err := authorize(ctx, akeeper, msg.ID, msg.Signer, msg.Type(), spend); err != nil {
+		return nil, err
+	}
+
 	groups := make([]types.Group, 0, len(msg.Groups))
 
 	for idx, spec := range msg.Groups {
@@ -63,25 +100,124 @@ func handleMsgCreate(ctx sdk.Context, keeper keeper.Keeper, _ MarketKeeper, msg
 	}, nil
 }
 
-func handleMsgUpdate(ctx sdk.Context, keeper keeper.Keeper, _ MarketKeeper, msg types.MsgUpdateDeployment) (*sdk.Result, error) {
+func handleMsgUpdate(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper, akeeper AuthzKeeper,
+	msg types.MsgUpdateDeployment) (*sdk.Result, error) {
 	deployment, found := keeper.GetDeployment(ctx, msg.ID)
 	if !found {
 		return nil, types.ErrDeploymentNotFound
 	}
 
+	if deployment.State == types.DeploymentClosed {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	updates, err := groupUpdates(keeper.GetGroups(ctx, msg.ID), msg.Groups)
+	if err != nil {
+		return nil, err
+	}
+
+	params := keeper.GetParams(ctx)
+	spend := sdk.NewCoins()
+	for _, group := range updates {
+		price, err := group.Price()
+		if err != nil {
+			return nil, err
+		}
+		if !params.IsPriceDenom(price.Denom) {
+			return nil, errors.Wrapf(types.ErrInvalidPriceDenom, "group %v: %v", group.GetName(), price.Denom)
+		}
+		spend = spend.Add(price)
+	}
+
+	if err := authorize(ctx, akeeper, msg.ID, msg.Signer, msg.Type(), spend); err != nil {
+		return nil, err
+	}
+
 	// TODO: version
 	// deployment.Version = msg.Version
 
+	if !msg.Expiry.Empty() {
+		expiresAt := msg.Expiry.ExpiresAt(ctx.BlockHeight())
+		if expiresAt <= ctx.BlockHeight() {
+			return nil, errors.Wrapf(types.ErrInvalidExpiry, "expiry %v not after current height", expiresAt)
+		}
+		if deployment.ExpiresAt != 0 && expiresAt < deployment.ExpiresAt {
+			return nil, errors.Wrapf(types.ErrInvalidExpiry, "expiry can only be extended (%v < %v)",
+				expiresAt, deployment.ExpiresAt)
+		}
+		deployment.ExpiresAt = expiresAt
+	}
+
 	if err := keeper.UpdateDeployment(ctx, deployment); err != nil {
 		return nil, errors.Wrap(types.ErrInternal, err.Error())
 	}
 
+	for _, update := range updates {
+		group, err := keeper.UpdateGroupSpec(ctx, update.ID(), update.GroupSpec)
+		if err != nil {
+			return nil, errors.Wrap(types.ErrInternal, err.Error())
+		}
+		if err := mkeeper.OnGroupUpdated(ctx, group); err != nil {
+			return nil, errors.Wrap(types.ErrInternal, err.Error())
+		}
+	}
+
 	return &sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
 
-func handleMsgCloseDeployment(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper, msg types.MsgCloseDeployment) (*sdk.Result, error) {
+// groupUpdates returns the groups whose specifications differ from those of the same name in
+// specs, carrying the new specifications. Groups not named in specs are left as they are.
+func groupUpdates(groups []types.Group, specs []types.GroupSpec) ([]types.Group, error) {
+	if len(specs) == 0 {
+		return nil, nil
+	}
+
+	byName := make(map[string]types.Group, len(groups))
+	for _, group := range groups {
+		byName[group.GetName()] = group
+	}
+
+	updated := make(map[string]types.GroupSpec, len(specs))
+	updates := make([]types.Group, 0, len(specs))
+	for _, spec := range specs {
+		group, ok := byName[spec.GetName()]
+		if !ok {
+			return nil, errors.Wrapf(types.ErrGroupNotFound, "group %v", spec.GetName())
+		}
+		if group.State == types.GroupClosed {
+			return nil, errors.Wrapf(types.ErrGroupClosed, "group %v", spec.GetName())
+		}
+		updated[spec.GetName()] = spec
+		if reflect.DeepEqual(group.GroupSpec, spec) {
+			continue
+		}
+		group.GroupSpec = spec
+		updates = append(updates, group)
+	}
+
+	// the deployment as a whole must remain valid
+	all := make([]types.GroupSpec, 0, len(groups))
+	for _, group := range groups {
+		if spec, ok := updated[group.GetName()]; ok {
+			all = append(all, spec)
+			continue
+		}
+		all = append(all, group.GroupSpec)
+	}
+	if err := validation.ValidateDeploymentGroups(all); err != nil {
+		return nil, errors.Wrap(types.ErrInvalidGroups, err.Error())
+	}
+
+	return updates, nil
+}
+
+func handleMsgCloseDeployment(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper, akeeper AuthzKeeper,
+	msg types.MsgCloseDeployment) (*sdk.Result, error) {
+	if err := authorize(ctx, akeeper, msg.ID, msg.Signer, msg.Type(), nil); err != nil {
+		return nil, err
+	}
 
 	deployment, found := keeper.GetDeployment(ctx, msg.ID)
 	if !found {
@@ -92,17 +228,10 @@ func handleMsgCloseDeployment(ctx sdk.Context, keeper keeper.Keeper, mkeeper Mar
 		return nil, types.ErrDeploymentClosed
 	}
 
-	deployment.State = types.DeploymentClosed
-
-	if err := keeper.UpdateDeployment(ctx, deployment); err != nil {
+	if err := closeDeployment(ctx, keeper, mkeeper, deployment); err != nil {
 		return nil, errors.Wrap(types.ErrInternal, err.Error())
 	}
 
-	for _, group := range keeper.GetGroups(ctx, deployment.ID()) {
-		keeper.OnDeploymentClosed(ctx, group)
-		mkeeper.OnGroupClosed(ctx, group.ID())
-	}
-
 	return &sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}, nil
@@ -125,9 +254,150 @@ func handleMsgCloseGroup(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKe
 	if err != nil {
 		return nil, err
 	}
-	mkeeper.OnGroupClosed(ctx, group.ID())
+	if err := mkeeper.OnGroupClosed(ctx, group.ID()); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgPauseGroup(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper, msg types.MsgPauseGroup) (*sdk.Result, error) {
+	group, found := keeper.GetGroup(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrGroupNotFound
+	}
+
+	if err := group.ValidatePausable(); err != nil {
+		return nil, err
+	}
+
+	if err := keeper.OnPauseGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	if err := mkeeper.OnGroupPaused(ctx, group.ID()); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgStartGroup(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper, msg types.MsgStartGroup) (*sdk.Result, error) {
+	deployment, found := keeper.GetDeployment(ctx, msg.ID.DeploymentID())
+	if !found {
+		return nil, types.ErrDeploymentNotFound
+	}
+
+	if deployment.State == types.DeploymentClosed {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	group, found := keeper.GetGroup(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrGroupNotFound
+	}
+
+	if err := group.ValidateStartable(); err != nil {
+		return nil, err
+	}
+
+	if err := keeper.OnStartGroup(ctx, group); err != nil {
+		return nil, err
+	}
+	group.State = types.GroupOpen
+
+	// order the group from its stored specifications
+	if _, err := mkeeper.CreateOrder(ctx, group.ID(), group.GroupSpec); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+	if err := keeper.OnOrderCreated(ctx, group); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgTransfer(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgTransferDeployment) (*sdk.Result, error) {
+	deployment, found := keeper.GetDeployment(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrDeploymentNotFound
+	}
+
+	if deployment.State == types.DeploymentClosed {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	if err := keeper.RequestTransfer(ctx, msg.ID, msg.NewOwner); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
 
 	return &sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}, nil
 }
+
+func handleMsgAccept(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper,
+	msg types.MsgAcceptDeployment) (*sdk.Result, error) {
+	deployment, found := keeper.GetDeployment(ctx, msg.ID)
+	if !found {
+		return nil, types.ErrDeploymentNotFound
+	}
+
+	if deployment.State == types.DeploymentClosed {
+		return nil, types.ErrDeploymentClosed
+	}
+
+	if owner, found := keeper.GetTransfer(ctx, msg.ID); !found || !owner.Equals(msg.NewOwner) {
+		return nil, types.ErrTransferNotFound
+	}
+
+	if _, found := keeper.GetDeployment(ctx, types.DeploymentID{Owner: msg.NewOwner, DSeq: msg.ID.DSeq}); found {
+		return nil, types.ErrDeploymentExists
+	}
+
+	if _, err := keeper.TransferDeployment(ctx, deployment, msg.NewOwner); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	if err := mkeeper.OnDeploymentTransferred(ctx, msg.ID, msg.NewOwner); err != nil {
+		return nil, errors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// authorize checks that signer may sign a message of msgType for the deployment owner.
+// The owner signing for themselves needs no grant.
+func authorize(ctx sdk.Context, akeeper AuthzKeeper, id types.DeploymentID, signer sdk.AccAddress,
+	msgType string, spend sdk.Coins) error {
+	if signer.Empty() || signer.Equals(id.Owner) {
+		return nil
+	}
+	return akeeper.Authorize(ctx, id.Owner, signer, msgType, id.DSeq, spend)
+}
+
+// closeDeployment closes the deployment along with its groups and their orders, bids and leases
+func closeDeployment(ctx sdk.Context, keeper keeper.Keeper, mkeeper MarketKeeper, deployment types.Deployment) error {
+	deployment.State = types.DeploymentClosed
+
+	if err := keeper.UpdateDeployment(ctx, deployment); err != nil {
+		return err
+	}
+
+	for _, group := range keeper.GetGroups(ctx, deployment.ID()) {
+		if err := keeper.OnDeploymentClosed(ctx, group); err != nil {
+			return err
+		}
+		if err := mkeeper.OnGroupClosed(ctx, group.ID()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
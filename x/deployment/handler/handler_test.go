@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -13,6 +14,8 @@ import (
 
 	"github.com/ovrclk/akash/app"
 	"github.com/ovrclk/akash/testutil"
+	akeeper "github.com/ovrclk/akash/x/authz/keeper"
+	atypes "github.com/ovrclk/akash/x/authz/types"
 	"github.com/ovrclk/akash/x/deployment/handler"
 	"github.com/ovrclk/akash/x/deployment/keeper"
 	"github.com/ovrclk/akash/x/deployment/types"
@@ -26,6 +29,7 @@ type testSuite struct {
 	ctx     sdk.Context
 	mkeeper mkeeper.Keeper
 	dkeeper keeper.Keeper
+	akeeper akeeper.Keeper
 	handler sdk.Handler
 }
 
@@ -36,11 +40,17 @@ func setupTestSuite(t *testing.T) *testSuite {
 
 	dKey := sdk.NewKVStoreKey(types.StoreKey)
 	mKey := sdk.NewKVStoreKey(mtypes.StoreKey)
+	aKey := sdk.NewKVStoreKey(atypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(aKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
@@ -48,9 +58,12 @@ func setupTestSuite(t *testing.T) *testSuite {
 	suite.ctx = sdk.NewContext(suite.ms, abci.Header{}, true, testutil.Logger(t))
 
 	suite.mkeeper = mkeeper.NewKeeper(app.MakeCodec(), mKey)
-	suite.dkeeper = keeper.NewKeeper(app.MakeCodec(), dKey)
+	suite.akeeper = akeeper.NewKeeper(app.MakeCodec(), aKey)
+	pkeeper := params.NewKeeper(app.MakeCodec(), paramsKey, paramsTKey)
+	suite.dkeeper = keeper.NewKeeper(app.MakeCodec(), dKey, pkeeper.Subspace(types.DefaultParamspace))
+	suite.dkeeper.SetParams(suite.ctx, types.DefaultParams())
 
-	suite.handler = handler.NewHandler(suite.dkeeper, suite.mkeeper)
+	suite.handler = handler.NewHandler(suite.dkeeper, suite.mkeeper, suite.akeeper)
 
 	return suite
 }
@@ -113,6 +126,36 @@ func TestCreateDeploymentEmptyGroups(t *testing.T) {
 	require.True(t, errors.Is(err, types.ErrInvalidGroups))
 }
 
+func TestCreateDeploymentInvalidPriceDenom(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+	for i := range groups {
+		for j := range groups[i].Resources {
+			groups[i].Resources[j].Price.Denom = "unlisted"
+		}
+	}
+
+	msg := types.MsgCreateDeployment{
+		ID: deployment.ID(),
+	}
+	for _, group := range groups {
+		msg.Groups = append(msg.Groups, group.GroupSpec)
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrInvalidPriceDenom))
+
+	suite.dkeeper.SetParams(suite.ctx, types.Params{
+		PriceDenoms: []string{types.DefaultPriceDenom, "unlisted"},
+	})
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+}
+
 func TestUpdateDeploymentNonExisting(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -163,6 +206,63 @@ func TestUpdateDeploymentExisting(t *testing.T) {
 	})
 }
 
+func TestUpdateDeploymentExtendExpiry(t *testing.T) {
+	suite := setupTestSuite(t)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := types.MsgCreateDeployment{
+		ID:     deployment.ID(),
+		Expiry: types.Expiry{Duration: 10},
+	}
+	for _, group := range groups {
+		msg.Groups = append(msg.Groups, group.GroupSpec)
+	}
+
+	_, err := suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+
+	d, found := suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.True(t, found)
+	require.Equal(t, int64(20), d.ExpiresAt)
+
+	_, err = suite.handler(suite.ctx, types.MsgUpdateDeployment{
+		ID:     deployment.ID(),
+		Expiry: types.Expiry{Height: 15},
+	})
+	require.True(t, errors.Is(err, types.ErrInvalidExpiry))
+
+	_, err = suite.handler(suite.ctx, types.MsgUpdateDeployment{
+		ID:     deployment.ID(),
+		Expiry: types.Expiry{Height: 50},
+	})
+	require.NoError(t, err)
+
+	d, found = suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.True(t, found)
+	require.Equal(t, int64(50), d.ExpiresAt)
+}
+
+func TestCreateDeploymentExpiryInPast(t *testing.T) {
+	suite := setupTestSuite(t)
+	suite.ctx = suite.ctx.WithBlockHeight(10)
+
+	deployment, groups := suite.createDeployment()
+
+	msg := types.MsgCreateDeployment{
+		ID:     deployment.ID(),
+		Expiry: types.Expiry{Height: 10},
+	}
+	for _, group := range groups {
+		msg.Groups = append(msg.Groups, group.GroupSpec)
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrInvalidExpiry))
+}
+
 func TestCloseDeploymentNonExisting(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -204,14 +304,17 @@ func TestCloseDeploymentExisting(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("ensure event created", func(t *testing.T) {
-		// TODO: this should emit a DeploymentClose
+		iev := testutil.ParseDeploymentEvent(t, res.Events[1:2])
+		require.IsType(t, types.EventDeploymentClose{}, iev)
 
-		iev := testutil.ParseDeploymentEvent(t, res.Events[1:])
-		require.IsType(t, types.EventDeploymentUpdate{}, iev)
-
-		dev := iev.(types.EventDeploymentUpdate)
+		dev := iev.(types.EventDeploymentClose)
 
 		require.Equal(t, msg.ID, dev.ID)
+
+		for idx := range msg.Groups {
+			gev := testutil.ParseDeploymentEvent(t, res.Events[2+idx:3+idx])
+			require.Equal(t, types.EventGroupClose{ID: types.MakeGroupID(msg.ID, uint32(idx+1))}, gev)
+		}
 	})
 
 	res, err = suite.handler(suite.ctx, msgClose)
@@ -219,6 +322,300 @@ func TestCloseDeploymentExisting(t *testing.T) {
 	require.EqualError(t, err, types.ErrDeploymentClosed.Error())
 }
 
+func TestCreateDeploymentDelegated(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createDeployment()
+	signer := testutil.AccAddress(t)
+
+	msg := types.MsgCreateDeployment{
+		ID:     deployment.ID(),
+		Signer: signer,
+	}
+	for _, group := range groups {
+		msg.Groups = append(msg.Groups, group.GroupSpec)
+	}
+	require.Equal(t, []sdk.AccAddress{signer}, msg.GetSigners())
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, atypes.ErrUnauthorized))
+
+	price, err := groups[0].Price()
+	require.NoError(t, err)
+	grant := atypes.Grant{
+		GrantID: atypes.GrantID{
+			Granter: deployment.Owner,
+			Grantee: signer,
+			MsgType: msg.Type(),
+		},
+		PriceCap: sdk.NewCoins(sdk.NewCoin(price.Denom, price.Amount.SubRaw(1))),
+	}
+	suite.akeeper.SetGrant(suite.ctx, grant)
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, atypes.ErrPriceCapExceeded))
+
+	grant.PriceCap = sdk.NewCoins(sdk.NewCoin(price.Denom, price.Amount.AddRaw(1)))
+	suite.akeeper.SetGrant(suite.ctx, grant)
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	_, exists := suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.True(t, exists)
+
+	found, ok := suite.akeeper.GetGrant(suite.ctx, grant.ID())
+	require.True(t, ok)
+	require.Equal(t, grant, found)
+}
+
+func TestCloseDeploymentDelegated(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, _ := suite.createActiveDeployment()
+	signer := testutil.AccAddress(t)
+
+	msg := types.MsgCloseDeployment{
+		ID:     deployment.ID(),
+		Signer: signer,
+	}
+
+	// grants scoped to another deployment do not apply
+	suite.akeeper.SetGrant(suite.ctx, atypes.Grant{
+		GrantID: atypes.GrantID{
+			Granter: deployment.Owner,
+			Grantee: signer,
+			MsgType: msg.Type(),
+			DSeq:    deployment.DSeq + 1,
+		},
+	})
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, atypes.ErrUnauthorized))
+
+	suite.akeeper.SetGrant(suite.ctx, atypes.Grant{
+		GrantID: atypes.GrantID{
+			Granter: deployment.Owner,
+			Grantee: signer,
+			MsgType: msg.Type(),
+			DSeq:    deployment.DSeq,
+		},
+	})
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	deployment, exists := suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.True(t, exists)
+	require.Equal(t, types.DeploymentClosed, deployment.State)
+}
+
+func TestUpdateDeploymentGroups(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createActiveDeployment()
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, groups[0].ID(), groups[0].GroupSpec)
+	require.NoError(t, err)
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), testutil.AccAddress(t), testutil.Coin(t))
+	require.NoError(t, err)
+	require.NoError(t, suite.mkeeper.CreateLease(suite.ctx, bid))
+	require.NoError(t, suite.mkeeper.OnBidMatched(suite.ctx, bid))
+	require.NoError(t, suite.mkeeper.OnOrderMatched(suite.ctx, order))
+
+	// unchanged groups are left as they are
+	_, err = suite.handler(suite.ctx, types.MsgUpdateDeployment{
+		ID:     deployment.ID(),
+		Groups: []types.GroupSpec{groups[0].GroupSpec},
+	})
+	require.NoError(t, err)
+	_, exists := suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(groups[0].ID(), 2))
+	require.False(t, exists)
+
+	spec := groups[0].GroupSpec
+	spec.Name = "unknown"
+	res, err := suite.handler(suite.ctx, types.MsgUpdateDeployment{
+		ID:     deployment.ID(),
+		Groups: []types.GroupSpec{spec},
+	})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrGroupNotFound))
+
+	spec = groups[0].GroupSpec
+	spec.Resources = append([]types.Resource{}, spec.Resources...)
+	spec.Resources[0].Unit.Memory++
+	res, err = suite.handler(suite.ctx, types.MsgUpdateDeployment{
+		ID:     deployment.ID(),
+		Groups: []types.GroupSpec{spec},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	group, exists := suite.dkeeper.GetGroup(suite.ctx, groups[0].ID())
+	require.True(t, exists)
+	require.Equal(t, spec, group.GroupSpec)
+	require.Equal(t, types.GroupMatched, group.State)
+
+	// the lease is amended in place
+	amendment, exists := suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(groups[0].ID(), 2))
+	require.True(t, exists)
+	require.Equal(t, mtypes.OrderOpen, amendment.State)
+	require.Equal(t, spec, amendment.Spec)
+	require.NotNil(t, amendment.Amends)
+	require.Equal(t, mtypes.MakeLeaseID(bid.ID()), *amendment.Amends)
+}
+
+func TestPauseStartGroup(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createActiveDeployment()
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, groups[0].ID(), groups[0].GroupSpec)
+	require.NoError(t, err)
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), testutil.AccAddress(t), testutil.Coin(t))
+	require.NoError(t, err)
+	require.NoError(t, suite.mkeeper.CreateLease(suite.ctx, bid))
+	require.NoError(t, suite.mkeeper.OnBidMatched(suite.ctx, bid))
+	require.NoError(t, suite.mkeeper.OnOrderMatched(suite.ctx, order))
+
+	// only paused groups may be started
+	res, err := suite.handler(suite.ctx, types.MsgStartGroup{ID: groups[0].ID()})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrGroupNotPaused))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	res, err = suite.handler(suite.ctx, types.MsgPauseGroup{ID: groups[0].ID()})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	t.Run("ensure event created", func(t *testing.T) {
+		iev := testutil.ParseDeploymentEvent(t, res.Events[:1])
+		require.Equal(t, types.EventGroupPause{ID: groups[0].ID()}, iev)
+	})
+
+	group, exists := suite.dkeeper.GetGroup(suite.ctx, groups[0].ID())
+	require.True(t, exists)
+	require.Equal(t, types.GroupPaused, group.State)
+	require.Equal(t, groups[0].GroupSpec, group.GroupSpec)
+
+	lease, exists := suite.mkeeper.GetLease(suite.ctx, mtypes.MakeLeaseID(bid.ID()))
+	require.True(t, exists)
+	require.Equal(t, mtypes.LeaseClosed, lease.State)
+
+	res, err = suite.handler(suite.ctx, types.MsgPauseGroup{ID: groups[0].ID()})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrGroupPaused))
+
+	res, err = suite.handler(suite.ctx, types.MsgStartGroup{ID: groups[0].ID()})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	group, exists = suite.dkeeper.GetGroup(suite.ctx, groups[0].ID())
+	require.True(t, exists)
+	require.Equal(t, types.GroupOrdered, group.State)
+
+	// the group is ordered anew from its specifications
+	next, exists := suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(groups[0].ID(), 2))
+	require.True(t, exists)
+	require.Equal(t, mtypes.OrderOpen, next.State)
+	require.Equal(t, groups[0].GroupSpec, next.Spec)
+
+	_, err = suite.handler(suite.ctx, types.MsgCloseDeployment{ID: deployment.ID()})
+	require.NoError(t, err)
+
+	res, err = suite.handler(suite.ctx, types.MsgPauseGroup{ID: groups[0].ID()})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrGroupClosed))
+}
+
+func TestTransferDeployment(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	deployment, groups := suite.createActiveDeployment()
+	owner := testutil.AccAddress(t)
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, groups[0].ID(), groups[0].GroupSpec)
+	require.NoError(t, err)
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), testutil.AccAddress(t), testutil.Coin(t))
+	require.NoError(t, err)
+	require.NoError(t, suite.mkeeper.CreateLease(suite.ctx, bid))
+
+	accept := types.MsgAcceptDeployment{
+		ID:       deployment.ID(),
+		NewOwner: owner,
+	}
+	require.Equal(t, []sdk.AccAddress{owner}, accept.GetSigners())
+
+	// accepting requires an offer to the new owner
+	res, err := suite.handler(suite.ctx, accept)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrTransferNotFound))
+
+	res, err = suite.handler(suite.ctx, types.MsgTransferDeployment{
+		ID:       deployment.ID(),
+		NewOwner: owner,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	res, err = suite.handler(suite.ctx, types.MsgAcceptDeployment{
+		ID:       deployment.ID(),
+		NewOwner: testutil.AccAddress(t),
+	})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrTransferNotFound))
+
+	res, err = suite.handler(suite.ctx, accept)
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	t.Run("ensure event created", func(t *testing.T) {
+		iev := testutil.ParseDeploymentEvent(t, res.Events[len(res.Events)-1:])
+		require.Equal(t, types.EventDeploymentTransfer{ID: deployment.ID(), NewOwner: owner}, iev)
+	})
+
+	_, exists := suite.dkeeper.GetDeployment(suite.ctx, deployment.ID())
+	require.False(t, exists)
+	_, exists = suite.mkeeper.GetLease(suite.ctx, mtypes.MakeLeaseID(bid.ID()))
+	require.False(t, exists)
+
+	id := types.DeploymentID{Owner: owner, DSeq: deployment.DSeq}
+	transferred, exists := suite.dkeeper.GetDeployment(suite.ctx, id)
+	require.True(t, exists)
+	require.Equal(t, types.DeploymentActive, transferred.State)
+	require.Len(t, suite.dkeeper.GetGroups(suite.ctx, id), len(groups))
+
+	gid := types.MakeGroupID(id, groups[0].GSeq)
+	_, exists = suite.mkeeper.GetOrder(suite.ctx, mtypes.MakeOrderID(gid, order.OSeq))
+	require.True(t, exists)
+	bid.Owner = owner
+	_, exists = suite.mkeeper.GetBid(suite.ctx, bid.ID())
+	require.True(t, exists)
+	_, exists = suite.mkeeper.GetLease(suite.ctx, mtypes.MakeLeaseID(bid.ID()))
+	require.True(t, exists)
+
+	// the offer is consumed by accepting it
+	res, err = suite.handler(suite.ctx, types.MsgAcceptDeployment{
+		ID:       id,
+		NewOwner: deployment.Owner,
+	})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrTransferNotFound))
+
+	// the previous owner may not create a deployment with the transferred ID
+	res, err = suite.handler(suite.ctx, types.MsgCreateDeployment{
+		ID:     deployment.ID(),
+		Groups: []types.GroupSpec{groups[0].GroupSpec},
+	})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrDeploymentTransferred))
+}
+
 func (st *testSuite) createDeployment() (types.Deployment, []types.Group) {
 	st.t.Helper()
 
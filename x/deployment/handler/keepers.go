@@ -9,5 +9,15 @@ import (
 // MarketKeeper Interface includes market methods
 type MarketKeeper interface {
 	CreateOrder(ctx sdk.Context, id types.GroupID, spec types.GroupSpec) (mtypes.Order, error)
-	OnGroupClosed(ctx sdk.Context, id types.GroupID)
+	OnGroupClosed(ctx sdk.Context, id types.GroupID) error
+	OnGroupPaused(ctx sdk.Context, id types.GroupID) error
+	OnGroupUpdated(ctx sdk.Context, group types.Group) error
+	WithOrdersForGroup(ctx sdk.Context, id types.GroupID, fn func(mtypes.Order) bool)
+	LeaseForOrder(ctx sdk.Context, oid mtypes.OrderID) (mtypes.Lease, bool)
+	OnDeploymentTransferred(ctx sdk.Context, id types.DeploymentID, owner sdk.AccAddress) error
+}
+
+// AuthzKeeper Interface includes authorization methods
+type AuthzKeeper interface {
+	Authorize(ctx sdk.Context, granter, grantee sdk.AccAddress, msgType string, dseq uint64, spend sdk.Coins) error
 }
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+// LeaseKeeper is the market state the deployment invariants are checked against
+type LeaseKeeper interface {
+	WithOrdersForGroup(ctx sdk.Context, id types.GroupID, fn func(mtypes.Order) bool)
+	LeaseForOrder(ctx sdk.Context, oid mtypes.OrderID) (mtypes.Lease, bool)
+}
+
+// RegisterInvariants registers the deployment module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, mkeeper LeaseKeeper) {
+	ir.RegisterRoute(types.ModuleName, "closed-deployments", ClosedDeploymentsInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "matched-groups", MatchedGroupsInvariant(k, mkeeper))
+}
+
+// ClosedDeploymentsInvariant checks that every group of a closed deployment is closed
+func ClosedDeploymentsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.WithDeployments(ctx, func(deployment types.Deployment) bool {
+			if deployment.State != types.DeploymentClosed {
+				return false
+			}
+			for _, group := range k.GetGroups(ctx, deployment.ID()) {
+				if group.State != types.GroupClosed {
+					count++
+					msg += fmt.Sprintf("\tgroup %v of closed deployment is %v\n", group.ID(), group.State)
+				}
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "closed-deployments",
+			fmt.Sprintf("open groups of closed deployments found %d\n%s", count, msg)), count != 0
+	}
+}
+
+// MatchedGroupsInvariant checks that every matched group has exactly one active lease
+func MatchedGroupsInvariant(k Keeper, mkeeper LeaseKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.WithDeploymentsActive(ctx, func(deployment types.Deployment) bool {
+			for _, group := range k.GetGroups(ctx, deployment.ID()) {
+				if group.State != types.GroupMatched {
+					continue
+				}
+
+				leases := 0
+				mkeeper.WithOrdersForGroup(ctx, group.ID(), func(order mtypes.Order) bool {
+					if lease, ok := mkeeper.LeaseForOrder(ctx, order.ID()); ok && lease.State == mtypes.LeaseActive {
+						leases++
+					}
+					return false
+				})
+
+				if leases != 1 {
+					count++
+					msg += fmt.Sprintf("\tmatched group %v has %d active leases\n", group.ID(), leases)
+				}
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "matched-groups",
+			fmt.Sprintf("matched groups without a single active lease found %d\n%s", count, msg)), count != 0
+	}
+}
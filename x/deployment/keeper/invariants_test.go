@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/deployment/keeper"
+	"github.com/ovrclk/akash/x/deployment/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+)
+
+type leaseKeeper struct {
+	leases []mtypes.Lease
+}
+
+func (k *leaseKeeper) WithOrdersForGroup(_ sdk.Context, id types.GroupID, fn func(mtypes.Order) bool) {
+	for _, lease := range k.leases {
+		if lease.GroupID().Equals(id) {
+			if fn(mtypes.Order{OrderID: lease.OrderID(), State: mtypes.OrderMatched}) {
+				return
+			}
+		}
+	}
+}
+
+func (k *leaseKeeper) LeaseForOrder(_ sdk.Context, oid mtypes.OrderID) (mtypes.Lease, bool) {
+	for _, lease := range k.leases {
+		if lease.OrderID().Equals(oid) {
+			return lease, true
+		}
+	}
+	return mtypes.Lease{}, false
+}
+
+func (k *leaseKeeper) add(t testing.TB, id types.GroupID, oseq uint32) {
+	lid := mtypes.MakeLeaseID(mtypes.MakeBidID(mtypes.MakeOrderID(id, oseq), testutil.AccAddress(t)))
+	k.leases = append(k.leases, mtypes.Lease{LeaseID: lid, State: mtypes.LeaseActive})
+}
+
+func Test_ClosedDeploymentsInvariant(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	groups := createActiveDeployment(t, ctx, k)
+
+	_, broken := keeper.ClosedDeploymentsInvariant(k)(ctx)
+	assert.False(t, broken)
+
+	// closed without closing its groups
+	deployment, ok := k.GetDeployment(ctx, groups[0].ID().DeploymentID())
+	require.True(t, ok)
+	deployment.State = types.DeploymentClosed
+	require.NoError(t, k.UpdateDeployment(ctx, deployment))
+
+	msg, broken := keeper.ClosedDeploymentsInvariant(k)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "open groups of closed deployments found 2")
+
+	for _, group := range groups {
+		require.NoError(t, k.OnDeploymentClosed(ctx, group))
+	}
+	_, broken = keeper.ClosedDeploymentsInvariant(k)(ctx)
+	assert.False(t, broken)
+}
+
+func Test_MatchedGroupsInvariant(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	groups := createActiveDeployment(t, ctx, k)
+	leases := &leaseKeeper{}
+
+	// matched without a lease
+	msg, broken := keeper.MatchedGroupsInvariant(k, leases)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "has 0 active leases")
+
+	for _, group := range groups {
+		leases.add(t, group.ID(), 1)
+	}
+	_, broken = keeper.MatchedGroupsInvariant(k, leases)(ctx)
+	assert.False(t, broken)
+
+	// matched twice
+	leases.add(t, groups[0].ID(), 2)
+	msg, broken = keeper.MatchedGroupsInvariant(k, leases)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "has 2 active leases")
+}
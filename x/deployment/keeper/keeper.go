@@ -1,8 +1,12 @@
 package keeper
 
 import (
+	"encoding/binary"
+
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/x/deployment/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -10,15 +14,21 @@ import (
 
 // Keeper of the deployment store
 type Keeper struct {
-	skey sdk.StoreKey
-	cdc  *codec.Codec
+	skey   sdk.StoreKey
+	cdc    *codec.Codec
+	pspace params.Subspace
 }
 
 // NewKeeper creates and returns an instance for deployment keeper
-func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey) Keeper {
+func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey, pspace params.Subspace) Keeper {
+	if !pspace.HasKeyTable() {
+		pspace = pspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		skey: skey,
-		cdc:  cdc,
+		skey:   skey,
+		cdc:    cdc,
+		pspace: pspace,
 	}
 }
 
@@ -27,6 +37,17 @@ func (k Keeper) Codec() *codec.Codec {
 	return k.cdc
 }
 
+// GetParams returns the deployment module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.pspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the deployment module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
 // GetDeployment returns deployment details with provided DeploymentID
 func (k Keeper) GetDeployment(ctx sdk.Context, id types.DeploymentID) (types.Deployment, bool) {
 	store := ctx.KVStore(k.skey)
@@ -94,7 +115,15 @@ func (k Keeper) Create(ctx sdk.Context, deployment types.Deployment, groups []ty
 		return types.ErrDeploymentExists
 	}
 
+	if store.Has(transferredKey(key)) {
+		return types.ErrDeploymentTransferred
+	}
+
 	store.Set(key, k.cdc.MustMarshalBinaryBare(deployment))
+	store.Set(deploymentStateIndexKey(deployment.State, key), key)
+	if deployment.State == types.DeploymentActive && deployment.ExpiresAt != 0 {
+		store.Set(deploymentExpiryIndexKey(deployment.ExpiresAt, key), key)
+	}
 
 	for _, group := range groups {
 		if !group.ID().DeploymentID().Equals(deployment.ID()) {
@@ -116,16 +145,147 @@ func (k Keeper) UpdateDeployment(ctx sdk.Context, deployment types.Deployment) e
 	store := ctx.KVStore(k.skey)
 	key := deploymentKey(deployment.ID())
 
+	buf := store.Get(key)
+	if buf == nil {
+		return types.ErrDeploymentNotFound
+	}
+
+	var prev types.Deployment
+	k.cdc.MustUnmarshalBinaryBare(buf, &prev)
+
+	if prev.State != deployment.State {
+		if err := prev.State.ValidateTransition(deployment.State); err != nil {
+			return err
+		}
+	}
+
+	if deployment.State == types.DeploymentClosed && prev.State != types.DeploymentClosed {
+		ctx.EventManager().EmitEvent(
+			types.EventDeploymentClose{ID: deployment.ID()}.ToSDKEvent(),
+		)
+	} else {
+		ctx.EventManager().EmitEvent(
+			types.EventDeploymentUpdate{ID: deployment.ID()}.ToSDKEvent(),
+		)
+	}
+
+	store.Delete(deploymentStateIndexKey(prev.State, key))
+	if prev.ExpiresAt != 0 {
+		store.Delete(deploymentExpiryIndexKey(prev.ExpiresAt, key))
+	}
+
+	if deployment.State == types.DeploymentClosed {
+		store.Delete(transferKey(key))
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(deployment))
+	store.Set(deploymentStateIndexKey(deployment.State, key), key)
+	if deployment.State == types.DeploymentActive && deployment.ExpiresAt != 0 {
+		store.Set(deploymentExpiryIndexKey(deployment.ExpiresAt, key), key)
+	}
+	return nil
+}
+
+// RequestTransfer records that the deployment with given ID is offered to owner,
+// replacing any earlier offer
+func (k Keeper) RequestTransfer(ctx sdk.Context, id types.DeploymentID, owner sdk.AccAddress) error {
+	store := ctx.KVStore(k.skey)
+	key := deploymentKey(id)
+
 	if !store.Has(key) {
 		return types.ErrDeploymentNotFound
 	}
 
+	store.Set(transferKey(key), owner.Bytes())
+	return nil
+}
+
+// GetTransfer returns the address the deployment with given ID is offered to
+func (k Keeper) GetTransfer(ctx sdk.Context, id types.DeploymentID) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.skey)
+	buf := store.Get(transferKey(deploymentKey(id)))
+	if buf == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(buf), true
+}
+
+// GetTransferred returns the address the deployment with given ID was transferred to
+func (k Keeper) GetTransferred(ctx sdk.Context, id types.DeploymentID) (sdk.AccAddress, bool) {
+	store := ctx.KVStore(k.skey)
+	buf := store.Get(transferredKey(deploymentKey(id)))
+	if buf == nil {
+		return nil, false
+	}
+	return sdk.AccAddress(buf), true
+}
+
+// SetTransferred records the deployment with given ID as transferred to owner
+func (k Keeper) SetTransferred(ctx sdk.Context, id types.DeploymentID, owner sdk.AccAddress) {
+	store := ctx.KVStore(k.skey)
+	store.Set(transferredKey(deploymentKey(id)), owner.Bytes())
+}
+
+// TransferDeployment moves the deployment and its groups to owner and clears the
+// pending transfer offer. The previous ID is recorded as transferred so that it
+// cannot be created again.
+func (k Keeper) TransferDeployment(ctx sdk.Context, deployment types.Deployment, owner sdk.AccAddress) (types.Deployment, error) {
+	store := ctx.KVStore(k.skey)
+	prevID := deployment.ID()
+	prevKey := deploymentKey(prevID)
+
+	if !store.Has(prevKey) {
+		return types.Deployment{}, types.ErrDeploymentNotFound
+	}
+
+	deployment.Owner = owner
+	key := deploymentKey(deployment.ID())
+	if store.Has(key) {
+		return types.Deployment{}, types.ErrDeploymentExists
+	}
+
+	groups := k.GetGroups(ctx, prevID)
+
+	store.Delete(prevKey)
+	store.Delete(transferKey(prevKey))
+	store.Set(transferredKey(prevKey), owner.Bytes())
+	store.Delete(transferredKey(key))
+	store.Delete(deploymentStateIndexKey(deployment.State, prevKey))
+	if deployment.ExpiresAt != 0 {
+		store.Delete(deploymentExpiryIndexKey(deployment.ExpiresAt, prevKey))
+	}
+
+	store.Set(key, k.cdc.MustMarshalBinaryBare(deployment))
+	store.Set(deploymentStateIndexKey(deployment.State, key), key)
+	if deployment.State == types.DeploymentActive && deployment.ExpiresAt != 0 {
+		store.Set(deploymentExpiryIndexKey(deployment.ExpiresAt, key), key)
+	}
+
+	for _, group := range groups {
+		store.Delete(groupKey(group.ID()))
+		group.Owner = owner
+		store.Set(groupKey(group.ID()), k.cdc.MustMarshalBinaryBare(group))
+	}
+
 	ctx.EventManager().EmitEvent(
-		types.EventDeploymentUpdate{ID: deployment.ID()}.ToSDKEvent(),
+		types.EventDeploymentTransfer{ID: prevID, NewOwner: owner}.ToSDKEvent(),
 	)
 
-	store.Set(key, k.cdc.MustMarshalBinaryBare(deployment))
-	return nil
+	return deployment, nil
+}
+
+// UpdateGroupSpec sets the specifications of the group with given ID. It returns updated group
+func (k Keeper) UpdateGroupSpec(ctx sdk.Context, id types.GroupID, spec types.GroupSpec) (types.Group, error) {
+	group, ok := k.GetGroup(ctx, id)
+	if !ok {
+		return types.Group{}, types.ErrGroupNotFound
+	}
+	if group.State == types.GroupClosed {
+		return types.Group{}, types.ErrGroupClosed
+	}
+	group.GroupSpec = spec
+	k.updateGroup(ctx, group)
+	return group, nil
 }
 
 // OnCloseGroup provides shutdown API for a Group
@@ -136,6 +296,9 @@ func (k Keeper) OnCloseGroup(ctx sdk.Context, group types.Group) error {
 	if !store.Has(key) {
 		return types.ErrGroupNotFound
 	}
+	if err := group.State.ValidateTransition(types.GroupClosed); err != nil {
+		return err
+	}
 	group.State = types.GroupClosed
 
 	ctx.EventManager().EmitEvent(
@@ -146,6 +309,28 @@ func (k Keeper) OnCloseGroup(ctx sdk.Context, group types.Group) error {
 	return nil
 }
 
+// OnPauseGroup updates group state to paused
+func (k Keeper) OnPauseGroup(ctx sdk.Context, group types.Group) error {
+	if err := k.transitionGroup(ctx, group, types.GroupPaused); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		types.EventGroupPause{ID: group.ID()}.ToSDKEvent(),
+	)
+	return nil
+}
+
+// OnStartGroup updates state of a paused group to open
+func (k Keeper) OnStartGroup(ctx sdk.Context, group types.Group) error {
+	if err := k.transitionGroup(ctx, group, types.GroupOpen); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		types.EventGroupStart{ID: group.ID()}.ToSDKEvent(),
+	)
+	return nil
+}
+
 // WithDeployments iterates all deployments in deployment store
 func (k Keeper) WithDeployments(ctx sdk.Context, fn func(types.Deployment) bool) {
 	store := ctx.KVStore(k.skey)
@@ -159,6 +344,24 @@ func (k Keeper) WithDeployments(ctx sdk.Context, fn func(types.Deployment) bool)
 	}
 }
 
+// WithTransferred iterates all deployment IDs that were transferred, with the
+// address each was transferred to
+func (k Keeper) WithTransferred(ctx sdk.Context, fn func(types.DeploymentID, sdk.AccAddress) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := sdk.KVStorePrefixIterator(store, transferredPrefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		suffix := iter.Key()[len(transferredPrefix):]
+		id := types.DeploymentID{
+			Owner: sdk.AccAddress(suffix[:sdk.AddrLen]),
+			DSeq:  binary.BigEndian.Uint64(suffix[sdk.AddrLen:]),
+		}
+		if stop := fn(id, sdk.AccAddress(iter.Value())); stop {
+			break
+		}
+	}
+}
+
 // WithDeploymentsActive filters to only those with State: Active
 func (k Keeper) WithDeploymentsActive(ctx sdk.Context, fn func(types.Deployment) bool) {
 	store := ctx.KVStore(k.skey)
@@ -175,44 +378,107 @@ func (k Keeper) WithDeploymentsActive(ctx sdk.Context, fn func(types.Deployment)
 	}
 }
 
+// WithDeploymentsExpired iterates active deployments expiring at or before height
+func (k Keeper) WithDeploymentsExpired(ctx sdk.Context, height int64, fn func(types.Deployment) bool) {
+	store := ctx.KVStore(k.skey)
+	iter := store.Iterator(deploymentExpiryIndexPrefix, deploymentExpiryIndexEndKey(height))
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var val types.Deployment
+		k.cdc.MustUnmarshalBinaryBare(store.Get(iter.Value()), &val)
+		if stop := fn(val); stop {
+			break
+		}
+	}
+}
+
+// PaginateDeployments iterates a page of deployments, walking the state index when
+// filtering by state and the owner prefix otherwise. fn reports whether the deployment matches.
+func (k Keeper) PaginateDeployments(ctx sdk.Context, owner sdk.AccAddress, state types.DeploymentState, byState bool,
+	page sdkutil.PageRequest, fn func(types.Deployment) bool) (sdkutil.PageResponse, error) {
+	store := ctx.KVStore(k.skey)
+
+	pfx := deploymentsForOwnerPrefix(owner)
+	if byState {
+		pfx = deploymentStateIndexPrefixKey(state, owner)
+	}
+
+	return sdkutil.Paginate(store, pfx, page, func(_, value []byte) (bool, error) {
+		if byState {
+			value = store.Get(value)
+		}
+		var val types.Deployment
+		k.cdc.MustUnmarshalBinaryBare(value, &val)
+		return fn(val), nil
+	})
+}
+
 // OnOrderCreated updates group state to group ordered
-func (k Keeper) OnOrderCreated(ctx sdk.Context, group types.Group) {
-	// TODO: assert state transition
-	group.State = types.GroupOrdered
-	k.updateGroup(ctx, group)
+func (k Keeper) OnOrderCreated(ctx sdk.Context, group types.Group) error {
+	if err := k.transitionGroup(ctx, group, types.GroupOrdered); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		types.EventGroupOrdered{ID: group.ID()}.ToSDKEvent(),
+	)
+	return nil
 }
 
 // OnLeaseCreated updates group state to group matched
-func (k Keeper) OnLeaseCreated(ctx sdk.Context, id types.GroupID) {
-	// TODO: assert state transition
-	group, _ := k.GetGroup(ctx, id)
-	group.State = types.GroupMatched
-	k.updateGroup(ctx, group)
+func (k Keeper) OnLeaseCreated(ctx sdk.Context, id types.GroupID) error {
+	group, ok := k.GetGroup(ctx, id)
+	if !ok {
+		return types.ErrGroupNotFound
+	}
+	return k.transitionGroup(ctx, group, types.GroupMatched)
 }
 
 // OnLeaseInsufficientFunds updates group state to group insufficient funds
-func (k Keeper) OnLeaseInsufficientFunds(ctx sdk.Context, id types.GroupID) {
-	// TODO: assert state transition
-	group, _ := k.GetGroup(ctx, id)
-	group.State = types.GroupInsufficientFunds
-	k.updateGroup(ctx, group)
+func (k Keeper) OnLeaseInsufficientFunds(ctx sdk.Context, id types.GroupID) error {
+	group, ok := k.GetGroup(ctx, id)
+	if !ok {
+		return types.ErrGroupNotFound
+	}
+	if err := k.transitionGroup(ctx, group, types.GroupInsufficientFunds); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		types.EventGroupInsufficientFunds{ID: id}.ToSDKEvent(),
+	)
+	return nil
 }
 
 // OnLeaseClosed updates group state to group opened
-func (k Keeper) OnLeaseClosed(ctx sdk.Context, id types.GroupID) {
-	// TODO: assert state transition
-	group, _ := k.GetGroup(ctx, id)
-	group.State = types.GroupOpen
-	k.updateGroup(ctx, group)
+func (k Keeper) OnLeaseClosed(ctx sdk.Context, id types.GroupID) error {
+	group, ok := k.GetGroup(ctx, id)
+	if !ok {
+		return types.ErrGroupNotFound
+	}
+	return k.transitionGroup(ctx, group, types.GroupOpen)
 }
 
-// OnDeploymentClosed updates group state to group closed
-func (k Keeper) OnDeploymentClosed(ctx sdk.Context, group types.Group) {
+// OnDeploymentClosed updates group state to group closed. Closed groups are left as they are.
+func (k Keeper) OnDeploymentClosed(ctx sdk.Context, group types.Group) error {
 	if group.State == types.GroupClosed {
-		return
+		return nil
 	}
-	group.State = types.GroupClosed
+	if err := k.transitionGroup(ctx, group, types.GroupClosed); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvent(
+		types.EventGroupClose{ID: group.ID()}.ToSDKEvent(),
+	)
+	return nil
+}
+
+// transitionGroup updates group state to state if the group may change to it
+func (k Keeper) transitionGroup(ctx sdk.Context, group types.Group, state types.GroupState) error {
+	if err := group.State.ValidateTransition(state); err != nil {
+		return err
+	}
+	group.State = state
 	k.updateGroup(ctx, group)
+	return nil
 }
 
 func (k Keeper) updateGroup(ctx sdk.Context, group types.Group) {
@@ -6,12 +6,14 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/testutil"
 	"github.com/ovrclk/akash/x/deployment/keeper"
 	"github.com/ovrclk/akash/x/deployment/types"
@@ -129,6 +131,114 @@ func Test_UpdateDeployment(t *testing.T) {
 	require.Equal(t, deployment, result)
 }
 
+func Test_TransferDeployment(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	deployment := testutil.Deployment(t)
+	deployment.ExpiresAt = 100
+	groups := testutil.DeploymentGroups(t, deployment.ID(), 0)
+	owner := testutil.AccAddress(t)
+
+	require.Error(t, keeper.RequestTransfer(ctx, deployment.ID(), owner))
+	require.NoError(t, keeper.Create(ctx, deployment, groups))
+
+	require.NoError(t, keeper.RequestTransfer(ctx, deployment.ID(), owner))
+	pending, ok := keeper.GetTransfer(ctx, deployment.ID())
+	require.True(t, ok)
+	require.Equal(t, owner, pending)
+
+	result, err := keeper.TransferDeployment(ctx, deployment, owner)
+	require.NoError(t, err)
+	require.Equal(t, owner, result.Owner)
+
+	_, ok = keeper.GetDeployment(ctx, deployment.ID())
+	require.False(t, ok)
+	require.Empty(t, keeper.GetGroups(ctx, deployment.ID()))
+	_, ok = keeper.GetTransfer(ctx, deployment.ID())
+	require.False(t, ok)
+
+	found, ok := keeper.GetDeployment(ctx, result.ID())
+	require.True(t, ok)
+	require.Equal(t, result, found)
+	require.Len(t, keeper.GetGroups(ctx, result.ID()), len(groups))
+
+	var expired []types.Deployment
+	keeper.WithDeploymentsExpired(ctx, deployment.ExpiresAt, func(d types.Deployment) bool {
+		expired = append(expired, d)
+		return false
+	})
+	require.Equal(t, []types.Deployment{result}, expired)
+
+	_, err = keeper.TransferDeployment(ctx, deployment, owner)
+	require.Error(t, err)
+
+	// the previous owner may not create a deployment with the transferred ID
+	transferred, ok := keeper.GetTransferred(ctx, deployment.ID())
+	require.True(t, ok)
+	require.Equal(t, owner, transferred)
+	require.True(t, types.ErrDeploymentTransferred.Is(keeper.Create(ctx, deployment, nil)))
+
+	// transferring it back makes the ID current again
+	back, err := keeper.TransferDeployment(ctx, result, deployment.Owner)
+	require.NoError(t, err)
+	require.Equal(t, deployment.ID(), back.ID())
+	_, ok = keeper.GetTransferred(ctx, deployment.ID())
+	require.False(t, ok)
+
+	var ids []types.DeploymentID
+	keeper.WithTransferred(ctx, func(id types.DeploymentID, to sdk.AccAddress) bool {
+		require.Equal(t, deployment.Owner, to)
+		ids = append(ids, id)
+		return false
+	})
+	require.Equal(t, []types.DeploymentID{result.ID()}, ids)
+}
+
+func Test_PaginateDeployments(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	var deployments []types.Deployment
+	for i := 0; i < 3; i++ {
+		deployment := testutil.Deployment(t)
+		err := keeper.Create(ctx, deployment, nil)
+		require.NoError(t, err)
+		deployments = append(deployments, deployment)
+	}
+
+	closed := deployments[0]
+	closed.State = types.DeploymentClosed
+	require.NoError(t, keeper.UpdateDeployment(ctx, closed))
+
+	var count int
+	page, err := keeper.PaginateDeployments(ctx, nil, types.DeploymentActive, true, sdkutil.PageRequest{Limit: 1},
+		func(deployment types.Deployment) bool {
+			assert.Equal(t, types.DeploymentActive, deployment.State)
+			count++
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+	require.NotEmpty(t, page.NextKey)
+
+	page, err = keeper.PaginateDeployments(ctx, nil, types.DeploymentActive, true,
+		sdkutil.PageRequest{Key: page.NextKey, Limit: 1}, func(types.Deployment) bool {
+			count++
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.Empty(t, page.NextKey)
+
+	var found []types.Deployment
+	_, err = keeper.PaginateDeployments(ctx, closed.Owner, 0, false, sdkutil.PageRequest{},
+		func(deployment types.Deployment) bool {
+			found = append(found, deployment)
+			return true
+		})
+	require.NoError(t, err)
+	require.Equal(t, []types.Deployment{closed}, found)
+}
+
 func Test_OnOrderCreated(t *testing.T) {
 	ctx, keeper := setupKeeper(t)
 	deployment := testutil.Deployment(t)
@@ -145,6 +255,7 @@ func Test_OnOrderCreated(t *testing.T) {
 	group, ok := keeper.GetGroup(ctx, groups[0].ID())
 	assert.True(t, ok)
 	assert.Equal(t, types.GroupOrdered, group.State)
+	assert.Equal(t, types.EventGroupOrdered{ID: group.ID()}, lastEvent(t, ctx))
 }
 
 func Test_OnLeaseCreated(t *testing.T) {
@@ -155,7 +266,11 @@ func Test_OnLeaseCreated(t *testing.T) {
 	err := keeper.Create(ctx, deployment, groups)
 	require.NoError(t, err)
 
-	keeper.OnLeaseCreated(ctx, groups[0].ID())
+	// groups are matched once ordered
+	require.Error(t, keeper.OnLeaseCreated(ctx, groups[0].ID()))
+
+	require.NoError(t, keeper.OnOrderCreated(ctx, groups[0]))
+	require.NoError(t, keeper.OnLeaseCreated(ctx, groups[0].ID()))
 	group, ok := keeper.GetGroup(ctx, groups[0].ID())
 	assert.True(t, ok)
 	assert.Equal(t, types.GroupMatched, group.State)
@@ -172,6 +287,7 @@ func Test_OnInsufficientFunds(t *testing.T) {
 		group, ok := keeper.GetGroup(ctx, groups[0].ID())
 		assert.True(t, ok)
 		assert.Equal(t, types.GroupInsufficientFunds, group.State)
+		assert.Equal(t, types.EventGroupInsufficientFunds{ID: group.ID()}, lastEvent(t, ctx))
 	})
 
 	t.Run("non-target group state unchanged", func(t *testing.T) {
@@ -305,13 +421,37 @@ func createDeploymentsWithState(t testing.TB, ctx sdk.Context, gState types.Grou
 }
 
 func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	ctx, keeper, _ := setupKeeperWithStore(t)
+	return ctx, keeper
+}
+
+// setupKeeperWithStore also returns the keeper's store key, for tests that
+// write the store directly
+func setupKeeperWithStore(t testing.TB) (sdk.Context, keeper.Keeper, sdk.StoreKey) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	pkey := sdk.NewKVStoreKey(params.StoreKey)
+	tkey := sdk.NewTransientStoreKey(params.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pkey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(app.MakeCodec(), key)
+	pspace := params.NewKeeper(app.MakeCodec(), pkey, tkey).Subspace(types.DefaultParamspace)
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key, pspace), key
+}
+
+func lastEvent(t testing.TB, ctx sdk.Context) sdkutil.ModuleEvent {
+	t.Helper()
+	events := ctx.EventManager().ABCIEvents()
+	require.NotEmpty(t, events)
+	ev, err := sdkutil.ParseEvent(sdk.StringifyEvent(events[len(events)-1]))
+	require.NoError(t, err)
+	mev, err := types.ParseEvent(ev)
+	require.NoError(t, err)
+	return mev
 }
@@ -4,12 +4,33 @@ import (
 	"bytes"
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/ovrclk/akash/x/deployment/types"
 )
 
 var (
+	// storeVersionKey holds the version of the store format
+	storeVersionKey = []byte{0x00}
+
 	deploymentPrefix = []byte{0x01}
 	groupPrefix      = []byte{0x02}
+
+	// deploymentStateIndexPrefix keys are the prefix, the deployment state and
+	// the deployment key suffix; values are the deployment key.
+	deploymentStateIndexPrefix = []byte{0x03}
+
+	// deploymentExpiryIndexPrefix keys are the prefix, the expiry height and
+	// the deployment key suffix; values are the deployment key.
+	deploymentExpiryIndexPrefix = []byte{0x04}
+
+	// transferPrefix keys are the prefix and the deployment key suffix; values
+	// are the address the deployment was offered to.
+	transferPrefix = []byte{0x05}
+
+	// transferredPrefix keys are the prefix and the key suffix of a deployment
+	// that was transferred away; values are the address it was transferred to.
+	transferredPrefix = []byte{0x06}
 )
 
 func deploymentKey(id types.DeploymentID) []byte {
@@ -19,6 +40,57 @@ func deploymentKey(id types.DeploymentID) []byte {
 	return buf.Bytes()
 }
 
+func deploymentStateIndexKey(state types.DeploymentState, key []byte) []byte {
+	buf := bytes.NewBuffer(deploymentStateIndexPrefix)
+	buf.WriteByte(byte(state))
+	buf.Write(key[len(deploymentPrefix):])
+	return buf.Bytes()
+}
+
+func deploymentStateIndexPrefixKey(state types.DeploymentState, owner sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(deploymentStateIndexPrefix)
+	buf.WriteByte(byte(state))
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
+
+func deploymentExpiryIndexKey(height int64, key []byte) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(deploymentExpiryIndexPrefix)
+	binary.Write(buf, binary.BigEndian, uint64(height))
+	buf.Write(key[len(deploymentPrefix):])
+	return buf.Bytes()
+}
+
+func deploymentExpiryIndexEndKey(height int64) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(deploymentExpiryIndexPrefix)
+	binary.Write(buf, binary.BigEndian, uint64(height+1))
+	return buf.Bytes()
+}
+
+func deploymentsForOwnerPrefix(owner sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(deploymentPrefix)
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
+
+func transferKey(key []byte) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(transferPrefix)
+	buf.Write(key[len(deploymentPrefix):])
+	return buf.Bytes()
+}
+
+func transferredKey(key []byte) []byte {
+	buf := bytes.NewBuffer([]byte{})
+	buf.Write(transferredPrefix)
+	buf.Write(key[len(deploymentPrefix):])
+	return buf.Bytes()
+}
+
 func groupKey(id types.GroupID) []byte {
 	buf := bytes.NewBuffer(groupPrefix)
 	buf.Write(id.Owner.Bytes())
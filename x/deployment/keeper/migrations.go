@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/deployment/types"
+)
+
+// StoreVersion returns the version of the deployment store format
+func (k Keeper) StoreVersion(ctx sdk.Context) uint64 {
+	return sdkutil.GetStoreVersion(ctx.KVStore(k.skey), storeVersionKey)
+}
+
+// SetStoreVersion records the version of the deployment store format
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	sdkutil.SetStoreVersion(ctx.KVStore(k.skey), storeVersionKey, version)
+}
+
+// RegisterMigrations registers the deployment store and its migrations with m
+func RegisterMigrations(m *sdkutil.Migrator, k Keeper) error {
+	m.RegisterModule(types.ModuleName, k, types.ConsensusVersion)
+	return m.RegisterMigration(types.ModuleName, 1, k.migrateV1)
+}
+
+// migrateV1 builds the deployment state and expiry indexes and sets the module
+// params, which version 1 stores do not have
+func (k Keeper) migrateV1(ctx sdk.Context) error {
+	store := ctx.KVStore(k.skey)
+
+	k.SetParams(ctx, types.DefaultParams())
+
+	var deployments []types.Deployment
+	k.WithDeployments(ctx, func(deployment types.Deployment) bool {
+		deployments = append(deployments, deployment)
+		return false
+	})
+
+	for _, deployment := range deployments {
+		key := deploymentKey(deployment.ID())
+		store.Set(deploymentStateIndexKey(deployment.State, key), key)
+		if deployment.State == types.DeploymentActive && deployment.ExpiresAt != 0 {
+			store.Set(deploymentExpiryIndexKey(deployment.ExpiresAt, key), key)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/deployment/keeper"
+	"github.com/ovrclk/akash/x/deployment/types"
+)
+
+func Test_MigrateV1(t *testing.T) {
+	ctx, dkeeper, key := setupKeeperWithStore(t)
+
+	expiring := testutil.Deployment(t)
+	expiring.ExpiresAt = 10
+	require.NoError(t, dkeeper.Create(ctx, expiring, nil))
+
+	closed := testutil.Deployment(t)
+	require.NoError(t, dkeeper.Create(ctx, closed, nil))
+	closed.State = types.DeploymentClosed
+	require.NoError(t, dkeeper.UpdateDeployment(ctx, closed))
+
+	// version 1 stores hold only deployments and groups.
+	store := ctx.KVStore(key)
+	var indexes [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if !bytes.HasPrefix(iter.Key(), []byte{0x01}) && !bytes.HasPrefix(iter.Key(), []byte{0x02}) {
+			indexes = append(indexes, append([]byte{}, iter.Key()...))
+		}
+	}
+	iter.Close()
+	require.NotEmpty(t, indexes)
+	for _, k := range indexes {
+		store.Delete(k)
+	}
+	require.Equal(t, sdkutil.InitialStoreVersion, dkeeper.StoreVersion(ctx))
+
+	// version 1 chains never set the module params
+	require.Panics(t, func() { dkeeper.GetParams(ctx) })
+
+	m := sdkutil.NewMigrator()
+	require.NoError(t, keeper.RegisterMigrations(m, dkeeper))
+	require.NoError(t, m.Migrate(ctx))
+	assert.Equal(t, types.ConsensusVersion, dkeeper.StoreVersion(ctx))
+	assert.Equal(t, types.DefaultParams(), dkeeper.GetParams(ctx))
+
+	for _, deployment := range []types.Deployment{expiring, closed} {
+		var found []types.Deployment
+		_, err := dkeeper.PaginateDeployments(ctx, nil, deployment.State, true, sdkutil.PageRequest{},
+			func(d types.Deployment) bool {
+				found = append(found, d)
+				return true
+			})
+		require.NoError(t, err)
+		require.Equal(t, []types.Deployment{deployment}, found)
+	}
+
+	var expired []types.Deployment
+	dkeeper.WithDeploymentsExpired(ctx, expiring.ExpiresAt, func(d types.Deployment) bool {
+		expired = append(expired, d)
+		return false
+	})
+	require.Equal(t, []types.Deployment{expiring}, expired)
+}
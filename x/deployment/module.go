@@ -86,15 +86,18 @@ type AppModule struct {
 	AppModuleBasic
 	keeper     keeper.Keeper
 	mkeeper    handler.MarketKeeper
+	akeeper    handler.AuthzKeeper
 	coinKeeper bank.Keeper
 }
 
 // NewAppModule creates a new AppModule Object
-func NewAppModule(k keeper.Keeper, mkeeper handler.MarketKeeper, bankKeeper bank.Keeper) AppModule {
+func NewAppModule(k keeper.Keeper, mkeeper handler.MarketKeeper, akeeper handler.AuthzKeeper,
+	bankKeeper bank.Keeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
 		mkeeper:        mkeeper,
+		akeeper:        akeeper,
 		coinKeeper:     bankKeeper,
 	}
 }
@@ -105,7 +108,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers module invariants
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper, am.mkeeper)
+}
 
 // Route returns the message routing key for the deployment module
 func (am AppModule) Route() string {
@@ -114,7 +119,7 @@ func (am AppModule) Route() string {
 
 // NewHandler returns an sdk.Handler for the deployment module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return handler.NewHandler(am.keeper, am.mkeeper)
+	return handler.NewHandler(am.keeper, am.mkeeper, am.akeeper)
 }
 
 // QuerierRoute returns the deployment module's querier route name.
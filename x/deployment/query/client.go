@@ -7,7 +7,7 @@ import (
 
 // Client interface
 type Client interface {
-	Deployments(DeploymentFilters) (Deployments, error)
+	Deployments(DeploymentFilters) (DeploymentsResponse, error)
 	Deployment(types.DeploymentID) (Deployment, error)
 	Group(types.GroupID) (Group, error)
 }
@@ -22,8 +22,8 @@ type client struct {
 	key string
 }
 
-func (c *client) Deployments(dfilters DeploymentFilters) (Deployments, error) {
-	var obj Deployments
+func (c *client) Deployments(dfilters DeploymentFilters) (DeploymentsResponse, error) {
+	var obj DeploymentsResponse
 	buf, err := NewRawClient(c.ctx, c.key).Deployments(dfilters)
 	if err != nil {
 		return obj, err
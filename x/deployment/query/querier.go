@@ -24,27 +24,39 @@ func NewQuerier(keeper keeper.Keeper) sdk.Querier {
 	}
 }
 
-func queryDeployments(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+func queryDeployments(ctx sdk.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	// isValidState denotes whether given state flag is valid or not
 	filters, isValidState, err := parseDepFiltersPath(path)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
 	}
 
+	page, err := sdkutil.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	var values Deployments
-	keeper.WithDeployments(ctx, func(deployment types.Deployment) bool {
-		if filters.Accept(deployment, isValidState) {
+	pagination, err := keeper.PaginateDeployments(ctx, filters.Owner, filters.State, isValidState, page,
+		func(deployment types.Deployment) bool {
+			if !filters.Accept(deployment, isValidState) {
+				return false
+			}
 			value := Deployment{
 				Deployment: deployment,
 				Groups:     keeper.GetGroups(ctx, deployment.ID()),
 			}
 			values = append(values, value)
-		}
+			return true
+		})
+	if err != nil {
+		return nil, err
+	}
 
-		return false
+	return sdkutil.RenderQueryResponse(keeper.Codec(), DeploymentsResponse{
+		Deployments: values,
+		Pagination:  pagination,
 	})
-
-	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
 }
 
 func queryDeployment(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
@@ -25,7 +25,7 @@ type rawclient struct {
 }
 
 func (c *rawclient) Deployments(dfilters DeploymentFilters) ([]byte, error) {
-	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getDeploymentsPath(dfilters)), nil)
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getDeploymentsPath(dfilters)), dfilters.Page.Bytes())
 	if err != nil {
 		return []byte{}, err
 	}
@@ -2,10 +2,12 @@ package query
 
 import (
 	"bytes"
+	"encoding/base64"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/x/deployment/types"
 )
 
@@ -16,6 +18,7 @@ type DeploymentFilters struct {
 	StateFlagVal string
 	// Actual state value decoded from DeploymentStateMap
 	State types.DeploymentState
+	Page  sdkutil.PageRequest
 }
 
 // Accept returns whether deployment filters valid or not
@@ -42,8 +45,9 @@ func (d Deployment) String() string {
 	DSeq:    %d
 	State:   %v
 	Version: %s
+	Expires At: %d
 	Num Groups: %d
-	`, d.Owner, d.DSeq, d.State, d.Version, len(d.Groups))
+	`, d.Owner, d.DSeq, d.State, d.Version, d.ExpiresAt, len(d.Groups))
 }
 
 // Deployments represents slice of deployment struct
@@ -66,6 +70,19 @@ func (ds Deployments) String() string {
 	return buf.String()
 }
 
+// DeploymentsResponse is a page of deployments
+type DeploymentsResponse struct {
+	Deployments Deployments          `json:"deployments"`
+	Pagination  sdkutil.PageResponse `json:"pagination"`
+}
+
+func (r DeploymentsResponse) String() string {
+	if len(r.Pagination.NextKey) == 0 {
+		return r.Deployments.String()
+	}
+	return fmt.Sprintf("%s\n\nNext page key: %s", r.Deployments, base64.StdEncoding.EncodeToString(r.Pagination.NextKey))
+}
+
 // Group stores group ID, state and other specifications
 type Group types.Group
 
@@ -76,4 +93,5 @@ type GroupFilters struct {
 	StateFlagVal string
 	// Actual state value decoded from GroupStateMap
 	State types.GroupState
+	Page  sdkutil.PageRequest
 }
@@ -13,12 +13,15 @@ type GenesisDeployment struct {
 
 // GenesisState stores slice of genesis deployment instance
 type GenesisState struct {
+	Params      types.Params        `json:"params"`
 	Deployments []GenesisDeployment `json:"deployments"`
 }
 
 // RandomizedGenState generates a random GenesisState for supply
 func RandomizedGenState(simState *module.SimulationState) {
-	deploymentGenesis := GenesisState{}
+	deploymentGenesis := GenesisState{
+		Params: types.DefaultParams(),
+	}
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(deploymentGenesis)
 }
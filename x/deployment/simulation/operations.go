@@ -149,7 +149,7 @@ func SimulateMsgUpdateDeployment(ak govtypes.AccountKeeper, k keeper.Keeper) sim
 		chainID string) (simulation.OperationMsg, []simulation.FutureOperation, error) {
 		var deployments []types.Deployment
 
-		k.WithDeployments(ctx, func(deployment types.Deployment) bool {
+		k.WithDeploymentsActive(ctx, func(deployment types.Deployment) bool {
 			deployments = append(deployments, deployment)
 
 			return false
@@ -32,11 +32,12 @@ func _() {
 	_ = x[GroupMatched-3]
 	_ = x[GroupInsufficientFunds-4]
 	_ = x[GroupClosed-5]
+	_ = x[GroupPaused-6]
 }
 
-const _GroupState_name = "openorderedmatchedinsufficient-fundsclosed"
+const _GroupState_name = "openorderedmatchedinsufficient-fundsclosedpaused"
 
-var _GroupState_index = [...]uint8{0, 4, 11, 18, 36, 42}
+var _GroupState_index = [...]uint8{0, 4, 11, 18, 36, 42, 48}
 
 func (i GroupState) String() string {
 	i -= 1
@@ -16,6 +16,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgUpdateDeployment{}, ModuleName+"/"+msgTypeUpdateDeployment, nil)
 	cdc.RegisterConcrete(MsgCloseDeployment{}, ModuleName+"/"+msgTypeCloseDeployment, nil)
 	cdc.RegisterConcrete(MsgCloseGroup{}, ModuleName+"/"+msgTypeCloseGroup, nil)
+	cdc.RegisterConcrete(MsgPauseGroup{}, ModuleName+"/"+msgTypePauseGroup, nil)
+	cdc.RegisterConcrete(MsgStartGroup{}, ModuleName+"/"+msgTypeStartGroup, nil)
+	cdc.RegisterConcrete(MsgTransferDeployment{}, ModuleName+"/"+msgTypeTransferDeployment, nil)
+	cdc.RegisterConcrete(MsgAcceptDeployment{}, ModuleName+"/"+msgTypeAcceptDeployment, nil)
 }
 
 // MustMarshalJSON panics if an error occurs. Besides that it behaves exactly like MarshalJSON
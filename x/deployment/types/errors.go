@@ -20,6 +20,16 @@ const (
 	errGroupNotFound
 	errGroupClosed
 	errGroupNotOpen
+	errInvalidPriceDenom
+	errInvalidExpiry
+	errInvalidStateTransition
+	errInvalidSigner
+	errInvalidTransfer
+	errTransferNotFound
+	errGroupPaused
+	errGroupNotPaused
+	errMixedPriceDenoms
+	errDeploymentTransferred
 )
 
 var (
@@ -53,4 +63,24 @@ var (
 	ErrGroupClosed = sdkerrors.Register(ModuleName, errGroupClosed, "Group already closed")
 	// ErrGroupNotOpen indicates the Group state has progressed beyond initial Open.
 	ErrGroupNotOpen = sdkerrors.Register(ModuleName, errGroupNotOpen, "Group not open")
+	// ErrInvalidPriceDenom is the error when a group is priced in a denomination that is not whitelisted
+	ErrInvalidPriceDenom = sdkerrors.Register(ModuleName, errInvalidPriceDenom, "Invalid price denomination")
+	// ErrInvalidExpiry is the error when a deployment expiry is in the past or shortens the current one
+	ErrInvalidExpiry = sdkerrors.Register(ModuleName, errInvalidExpiry, "Invalid expiry")
+	// ErrInvalidStateTransition is the error when a deployment or group may not change to a state
+	ErrInvalidStateTransition = sdkerrors.Register(ModuleName, errInvalidStateTransition, "Invalid state transition")
+	// ErrInvalidSigner is the error when the delegated signer of a message is not a valid address
+	ErrInvalidSigner = sdkerrors.Register(ModuleName, errInvalidSigner, "Invalid signer")
+	// ErrInvalidTransfer is the error when a deployment transfer is malformed
+	ErrInvalidTransfer = sdkerrors.Register(ModuleName, errInvalidTransfer, "Invalid transfer")
+	// ErrTransferNotFound is the error when a deployment was not offered to the accepting owner
+	ErrTransferNotFound = sdkerrors.Register(ModuleName, errTransferNotFound, "Transfer not found")
+	// ErrGroupPaused is the error when a group is paused already
+	ErrGroupPaused = sdkerrors.Register(ModuleName, errGroupPaused, "Group already paused")
+	// ErrGroupNotPaused is the error when starting a group that is not paused
+	ErrGroupNotPaused = sdkerrors.Register(ModuleName, errGroupNotPaused, "Group not paused")
+	// ErrMixedPriceDenoms is the error when the resources of a group are priced in different denominations
+	ErrMixedPriceDenoms = sdkerrors.Register(ModuleName, errMixedPriceDenoms, "Mixed price denominations")
+	// ErrDeploymentTransferred is the error when creating a deployment with the ID of one that was transferred
+	ErrDeploymentTransferred = sdkerrors.Register(ModuleName, errDeploymentTransferred, "Deployment transferred")
 )
@@ -8,13 +8,19 @@ import (
 )
 
 const (
-	evActionDeploymentCreate = "deployment-create"
-	evActionDeploymentUpdate = "deployment-update"
-	evActionDeploymentClose  = "deployment-close"
-	evActionGroupClose       = "group-close"
-	evOwnerKey               = "owner"
-	evDSeqKey                = "dseq"
-	evGSeqKey                = "gseq"
+	evActionDeploymentCreate   = "deployment-create"
+	evActionDeploymentUpdate   = "deployment-update"
+	evActionDeploymentClose    = "deployment-close"
+	evActionGroupClose         = "group-close"
+	evActionGroupPause         = "group-pause"
+	evActionGroupStart         = "group-start"
+	evActionGroupOrdered       = "group-ordered"
+	evActionGroupInsufficient  = "group-insufficient-funds"
+	evActionDeploymentTransfer = "deployment-transfer"
+	evOwnerKey                 = "owner"
+	evNewOwnerKey              = "new-owner"
+	evDSeqKey                  = "dseq"
+	evGSeqKey                  = "gseq"
 )
 
 // EventDeploymentCreate struct
@@ -103,6 +109,66 @@ func (ev EventGroupClose) ToSDKEvent() sdk.Event {
 	)
 }
 
+// EventGroupPause provides SDK event to signal a group was paused
+type EventGroupPause struct {
+	ID GroupID
+}
+
+// ToSDKEvent produces the SDK notification for Event
+func (ev EventGroupPause) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionGroupPause),
+		}, GroupIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventGroupStart provides SDK event to signal a paused group was started
+type EventGroupStart struct {
+	ID GroupID
+}
+
+// ToSDKEvent produces the SDK notification for Event
+func (ev EventGroupStart) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionGroupStart),
+		}, GroupIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventGroupOrdered provides SDK event to signal an order was created for a group
+type EventGroupOrdered struct {
+	ID GroupID
+}
+
+// ToSDKEvent produces the SDK notification for Event
+func (ev EventGroupOrdered) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionGroupOrdered),
+		}, GroupIDEVAttributes(ev.ID)...)...,
+	)
+}
+
+// EventGroupInsufficientFunds provides SDK event to signal the lease of a group ran out of funds
+type EventGroupInsufficientFunds struct {
+	ID GroupID
+}
+
+// ToSDKEvent produces the SDK notification for Event
+func (ev EventGroupInsufficientFunds) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionGroupInsufficient),
+		}, GroupIDEVAttributes(ev.ID)...)...,
+	)
+}
+
 // GroupIDEVAttributes returns event attribues for given GroupID
 func GroupIDEVAttributes(id GroupID) []sdk.Attribute {
 	return append(DeploymentIDEVAttributes(id.DeploymentID()),
@@ -128,6 +194,24 @@ func ParseEVGroupID(attrs []sdk.Attribute) (GroupID, error) {
 	}, nil
 }
 
+// EventDeploymentTransfer struct
+type EventDeploymentTransfer struct {
+	// ID is the deployment ID under the previous owner
+	ID       DeploymentID
+	NewOwner sdk.AccAddress
+}
+
+// ToSDKEvent method creates new sdk event for EventDeploymentTransfer struct
+func (ev EventDeploymentTransfer) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionDeploymentTransfer),
+			sdk.NewAttribute(evNewOwnerKey, ev.NewOwner.String()),
+		}, DeploymentIDEVAttributes(ev.ID)...)...,
+	)
+}
+
 // ParseEvent parses event and returns details of event and error if occurred
 // TODO: Enable returning actual events.
 func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
@@ -162,6 +246,40 @@ func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
 			return nil, err
 		}
 		return EventGroupClose{ID: gid}, nil
+	case evActionGroupPause:
+		gid, err := ParseEVGroupID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventGroupPause{ID: gid}, nil
+	case evActionGroupStart:
+		gid, err := ParseEVGroupID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventGroupStart{ID: gid}, nil
+	case evActionGroupOrdered:
+		gid, err := ParseEVGroupID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventGroupOrdered{ID: gid}, nil
+	case evActionGroupInsufficient:
+		gid, err := ParseEVGroupID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventGroupInsufficientFunds{ID: gid}, nil
+	case evActionDeploymentTransfer:
+		did, err := ParseEVDeploymentID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		owner, err := sdkutil.GetAccAddress(ev.Attributes, evNewOwnerKey)
+		if err != nil {
+			return nil, err
+		}
+		return EventDeploymentTransfer{ID: did, NewOwner: owner}, nil
 	default:
 		return nil, sdkutil.ErrUnknownAction
 	}
@@ -9,4 +9,8 @@ const (
 
 	// RouterKey is the message route for deployment
 	RouterKey = ModuleName
+
+	// ConsensusVersion is the version of the deployment store format.
+	// Version 2 adds deployment state and expiry indexes.
+	ConsensusVersion uint64 = 2
 )
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -9,13 +10,27 @@ const (
 	msgTypeUpdateDeployment = "update-deployment"
 	msgTypeCloseDeployment  = "close-deployment"
 	msgTypeCloseGroup       = "close-group"
+	msgTypePauseGroup       = "pause-group"
+	msgTypeStartGroup       = "start-group"
+
+	msgTypeTransferDeployment = "transfer-deployment"
+	msgTypeAcceptDeployment   = "accept-deployment"
 )
 
+// DelegableMsgTypes returns the types of the messages an owner may authorize
+// another account to sign on their behalf
+func DelegableMsgTypes() []string {
+	return []string{msgTypeCreateDeployment, msgTypeUpdateDeployment, msgTypeCloseDeployment}
+}
+
 // MsgCreateDeployment defines an SDK message for creating deployment
 type MsgCreateDeployment struct {
 	ID DeploymentID `json:"id"`
 	// Version []byte      `json:"version"`
 	Groups []GroupSpec `json:"groups"`
+	Expiry Expiry      `json:"expiry"`
+	// Signer, when set, signs on behalf of the owner under an authorization grant
+	Signer sdk.AccAddress `json:"signer,omitempty"`
 }
 
 // Route implements the sdk.Msg interface
@@ -31,7 +46,7 @@ func (msg MsgCreateDeployment) GetSignBytes() []byte {
 
 // GetSigners defines whose signature is required
 func (msg MsgCreateDeployment) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.ID.Owner}
+	return []sdk.AccAddress{signer(msg.ID.Owner, msg.Signer)}
 }
 
 // ValidateBasic does basic validation like check owner and groups length
@@ -39,9 +54,15 @@ func (msg MsgCreateDeployment) ValidateBasic() error {
 	if err := msg.ID.Validate(); err != nil {
 		return err
 	}
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
 	if len(msg.Groups) == 0 {
 		return ErrInvalidGroups
 	}
+	if err := msg.Expiry.Validate(); err != nil {
+		return err
+	}
 	// TODO: version
 	return nil
 }
@@ -50,6 +71,12 @@ func (msg MsgCreateDeployment) ValidateBasic() error {
 type MsgUpdateDeployment struct {
 	ID      DeploymentID
 	Version sdk.AccAddress
+	// Expiry, when set, extends the expiry of the deployment
+	Expiry Expiry
+	// Groups, when set, update the specifications of the groups of the same name
+	Groups []GroupSpec `json:"groups,omitempty"`
+	// Signer, when set, signs on behalf of the owner under an authorization grant
+	Signer sdk.AccAddress `json:"signer,omitempty"`
 }
 
 // Route implements the sdk.Msg interface
@@ -64,6 +91,27 @@ func (msg MsgUpdateDeployment) ValidateBasic() error {
 		return err
 	}
 
+	if err := msg.Expiry.Validate(); err != nil {
+		return err
+	}
+
+	if err := validateSigner(msg.Signer); err != nil {
+		return err
+	}
+
+	names := make(map[string]bool, len(msg.Groups))
+	for _, spec := range msg.Groups {
+		if names[spec.GetName()] {
+			return errors.Wrapf(ErrInvalidGroups, "duplicate group %v", spec.GetName())
+		}
+		names[spec.GetName()] = true
+	}
+
+	// expiry extensions and group updates do not require a new version
+	if msg.Version.Empty() && (!msg.Expiry.Empty() || len(msg.Groups) > 0) {
+		return nil
+	}
+
 	if err := sdk.VerifyAddressFormat(msg.Version); err != nil {
 		return ErrEmptyVersion
 	}
@@ -78,12 +126,14 @@ func (msg MsgUpdateDeployment) GetSignBytes() []byte {
 
 // GetSigners defines whose signature is required
 func (msg MsgUpdateDeployment) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.ID.Owner}
+	return []sdk.AccAddress{signer(msg.ID.Owner, msg.Signer)}
 }
 
 // MsgCloseDeployment defines an SDK message for closing deployment
 type MsgCloseDeployment struct {
 	ID DeploymentID
+	// Signer, when set, signs on behalf of the owner under an authorization grant
+	Signer sdk.AccAddress `json:"signer,omitempty"`
 }
 
 // Route implements the sdk.Msg interface
@@ -97,7 +147,7 @@ func (msg MsgCloseDeployment) ValidateBasic() error {
 	if err := msg.ID.Validate(); err != nil {
 		return err
 	}
-	return nil
+	return validateSigner(msg.Signer)
 }
 
 // GetSignBytes encodes the message for signing
@@ -107,7 +157,7 @@ func (msg MsgCloseDeployment) GetSignBytes() []byte {
 
 // GetSigners defines whose signature is required
 func (msg MsgCloseDeployment) GetSigners() []sdk.AccAddress {
-	return []sdk.AccAddress{msg.ID.Owner}
+	return []sdk.AccAddress{signer(msg.ID.Owner, msg.Signer)}
 }
 
 // MsgCloseGroup defines SDK message to close a single Group within a Deployment.
@@ -138,3 +188,143 @@ func (msg MsgCloseGroup) GetSignBytes() []byte {
 func (msg MsgCloseGroup) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.ID.Owner}
 }
+
+// MsgPauseGroup defines SDK message to pause a single Group within a Deployment.
+// Its lease is closed while its specifications are kept.
+type MsgPauseGroup struct {
+	ID GroupID
+}
+
+// Route implements the sdk.Msg interface for routing
+func (msg MsgPauseGroup) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface exposing message type
+func (msg MsgPauseGroup) Type() string { return msgTypePauseGroup }
+
+// ValidateBasic calls underlying GroupID.Validate() check and returns result
+func (msg MsgPauseGroup) ValidateBasic() error {
+	return msg.ID.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgPauseGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgPauseGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ID.Owner}
+}
+
+// MsgStartGroup defines SDK message to start a paused Group, ordering it anew.
+type MsgStartGroup struct {
+	ID GroupID
+}
+
+// Route implements the sdk.Msg interface for routing
+func (msg MsgStartGroup) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface exposing message type
+func (msg MsgStartGroup) Type() string { return msgTypeStartGroup }
+
+// ValidateBasic calls underlying GroupID.Validate() check and returns result
+func (msg MsgStartGroup) ValidateBasic() error {
+	return msg.ID.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgStartGroup) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgStartGroup) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ID.Owner}
+}
+
+// MsgTransferDeployment defines an SDK message for offering a deployment to a new owner.
+// The transfer takes place once the new owner accepts it; a later offer replaces it.
+type MsgTransferDeployment struct {
+	ID       DeploymentID   `json:"id"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgTransferDeployment) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgTransferDeployment) Type() string { return msgTypeTransferDeployment }
+
+// ValidateBasic does basic validation
+func (msg MsgTransferDeployment) ValidateBasic() error {
+	return validateTransfer(msg.ID, msg.NewOwner)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgTransferDeployment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgTransferDeployment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.ID.Owner}
+}
+
+// MsgAcceptDeployment defines an SDK message for the new owner accepting a deployment transfer
+type MsgAcceptDeployment struct {
+	// ID is the deployment ID under the current owner
+	ID       DeploymentID   `json:"id"`
+	NewOwner sdk.AccAddress `json:"new_owner"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgAcceptDeployment) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgAcceptDeployment) Type() string { return msgTypeAcceptDeployment }
+
+// ValidateBasic does basic validation
+func (msg MsgAcceptDeployment) ValidateBasic() error {
+	return validateTransfer(msg.ID, msg.NewOwner)
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgAcceptDeployment) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgAcceptDeployment) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.NewOwner}
+}
+
+// signer returns the address signing for owner: the delegated signer if set, the owner otherwise
+func signer(owner, delegate sdk.AccAddress) sdk.AccAddress {
+	if delegate.Empty() {
+		return owner
+	}
+	return delegate
+}
+
+func validateSigner(signer sdk.AccAddress) error {
+	if signer.Empty() {
+		return nil
+	}
+	if err := sdk.VerifyAddressFormat(signer); err != nil {
+		return errors.Wrap(ErrInvalidSigner, err.Error())
+	}
+	return nil
+}
+
+func validateTransfer(id DeploymentID, owner sdk.AccAddress) error {
+	if err := id.Validate(); err != nil {
+		return err
+	}
+	if err := sdk.VerifyAddressFormat(owner); err != nil {
+		return errors.Wrap(ErrInvalidTransfer, "invalid new owner address")
+	}
+	if owner.Equals(id.Owner) {
+		return errors.Wrap(ErrInvalidTransfer, "new owner is the current owner")
+	}
+	return nil
+}
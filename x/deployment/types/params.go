@@ -0,0 +1,89 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// DefaultParamspace is the paramstore subspace of the deployment module
+	DefaultParamspace = ModuleName
+
+	// DefaultPriceDenom is the denomination deployments are priced in by default
+	DefaultPriceDenom = "akash"
+)
+
+var (
+	// KeyPriceDenoms is the paramstore key of the denomination whitelist
+	KeyPriceDenoms = []byte("PriceDenoms")
+)
+
+// Params defines the parameters of the deployment module
+type Params struct {
+	// PriceDenoms lists the denominations deployment groups may be priced in
+	PriceDenoms []string `json:"price_denoms" yaml:"price_denoms"`
+}
+
+// ParamKeyTable returns the key table of the deployment module params
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default deployment module params
+func DefaultParams() Params {
+	return Params{
+		PriceDenoms: []string{DefaultPriceDenom},
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyPriceDenoms, &p.PriceDenoms, validatePriceDenoms),
+	}
+}
+
+// Validate returns error if params are invalid
+func (p Params) Validate() error {
+	return validatePriceDenoms(p.PriceDenoms)
+}
+
+// IsPriceDenom returns true if deployments may be priced in denom
+func (p Params) IsPriceDenom(denom string) bool {
+	for _, d := range p.PriceDenoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Deployment Params:
+	Price Denoms: %v`, p.PriceDenoms)
+}
+
+func validatePriceDenoms(i interface{}) error {
+	denoms, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if len(denoms) == 0 {
+		return fmt.Errorf("price denoms cannot be empty")
+	}
+
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return err
+		}
+		if seen[denom] {
+			return fmt.Errorf("duplicate price denom %s", denom)
+		}
+		seen[denom] = true
+	}
+	return nil
+}
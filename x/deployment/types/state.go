@@ -0,0 +1,39 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+)
+
+// deploymentTransitions lists the states each deployment state may change to
+var deploymentTransitions = map[DeploymentState][]DeploymentState{
+	DeploymentActive: {DeploymentClosed},
+}
+
+// groupTransitions lists the states each group state may change to
+var groupTransitions = map[GroupState][]GroupState{
+	GroupOpen:              {GroupOrdered, GroupClosed, GroupPaused},
+	GroupOrdered:           {GroupMatched, GroupClosed, GroupPaused},
+	GroupMatched:           {GroupOpen, GroupInsufficientFunds, GroupClosed, GroupPaused},
+	GroupInsufficientFunds: {GroupOpen, GroupClosed, GroupPaused},
+	GroupPaused:            {GroupOpen, GroupClosed},
+}
+
+// ValidateTransition returns ErrInvalidStateTransition unless a deployment in state s may change to next
+func (s DeploymentState) ValidateTransition(next DeploymentState) error {
+	for _, state := range deploymentTransitions[s] {
+		if state == next {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidStateTransition, "deployment %v -> %v", s, next)
+}
+
+// ValidateTransition returns ErrInvalidStateTransition unless a group in state s may change to next
+func (s GroupState) ValidateTransition(next GroupState) error {
+	for _, state := range groupTransitions[s] {
+		if state == next {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidStateTransition, "group %v -> %v", s, next)
+}
@@ -2,8 +2,10 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/ovrclk/akash/types"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 )
 
 //go:generate stringer -linecomment -output=autogen_stringer.go -type=DeploymentState,GroupState
@@ -29,6 +31,8 @@ type Deployment struct {
 	DeploymentID `json:"id"`
 	State        DeploymentState `json:"state"`
 	Version      []byte          `json:"version"`
+	// ExpiresAt is the block height at which the deployment is closed; zero never expires
+	ExpiresAt int64 `json:"expires_at,omitempty"`
 }
 
 // ID method returns DeploymentID details of specific deployment
@@ -36,6 +40,11 @@ func (obj Deployment) ID() DeploymentID {
 	return obj.DeploymentID
 }
 
+// HasExpired returns true if the deployment expires at or before height
+func (obj Deployment) HasExpired(height int64) bool {
+	return obj.ExpiresAt != 0 && obj.ExpiresAt <= height
+}
+
 // GroupState defines state of group
 type GroupState uint8
 
@@ -50,12 +59,29 @@ const (
 	GroupInsufficientFunds // insufficient-funds
 	// GroupClosed is used when state of group is closed
 	GroupClosed // closed
+	// GroupPaused is used when group is paused by its owner
+	GroupPaused // paused
 )
 
+// SignedBy lists the auditors that must have signed a group's requirements
+type SignedBy struct {
+	// all of these auditors must have signed the requirements
+	AllOf []string `json:"all-of,omitempty"`
+
+	// at least one of these auditors must have signed the requirements
+	AnyOf []string `json:"any-of,omitempty"`
+}
+
+// Empty returns true when no auditor signatures are required
+func (s SignedBy) Empty() bool {
+	return len(s.AllOf) == 0 && len(s.AnyOf) == 0
+}
+
 // GroupSpec stores group specifications
 type GroupSpec struct {
 	Name         string          `json:"name"`
 	Requirements []sdk.Attribute `json:"requirements"`
+	SignedBy     SignedBy        `json:"signed-by,omitempty"`
 	Resources    []Resource      `json:"resources"`
 }
 
@@ -76,17 +102,22 @@ func (g GroupSpec) GetName() string {
 	return g.Name
 }
 
-// Price method returns price of group
-func (g GroupSpec) Price() sdk.Coin {
+// Price method returns price of group.
+// All resources of a group must be priced in the same denomination.
+func (g GroupSpec) Price() (sdk.Coin, error) {
 	var price sdk.Coin
 	for idx, resource := range g.Resources {
+		rprice := resource.FullPrice()
 		if idx == 0 {
-			price = resource.FullPrice()
+			price = rprice
 			continue
 		}
-		price = price.Add(resource.FullPrice())
+		if rprice.Denom != price.Denom {
+			return sdk.Coin{}, sdkerrors.Wrapf(ErrMixedPriceDenoms, "group %v: %v and %v", g.GetName(), price.Denom, rprice.Denom)
+		}
+		price = price.Add(rprice)
 	}
-	return price
+	return price, nil
 }
 
 // MatchAttributes method compares provided attributes with specific group attributes
@@ -103,6 +134,34 @@ loop:
 	return true
 }
 
+// MatchRequirements method checks the group requirements against a provider. When the group
+// lists auditors in SignedBy only attributes signed by those auditors are considered;
+// otherwise the provider's self-declared attributes are used.
+func (g GroupSpec) MatchRequirements(attrs []sdk.Attribute, audited atypes.Providers) bool {
+	if g.SignedBy.Empty() {
+		return g.MatchAttributes(attrs)
+	}
+
+	for _, auditor := range g.SignedBy.AllOf {
+		signed, _ := audited.SignedBy(auditor)
+		if !g.MatchAttributes(signed) {
+			return false
+		}
+	}
+
+	if len(g.SignedBy.AnyOf) == 0 {
+		return true
+	}
+
+	for _, auditor := range g.SignedBy.AnyOf {
+		if signed, ok := audited.SignedBy(auditor); ok && g.MatchAttributes(signed) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Group stores groupID, state and other specifications
 type Group struct {
 	GroupID   `json:"id"`
@@ -125,6 +184,28 @@ func (g Group) ValidateOrderable() error {
 	}
 }
 
+// ValidatePausable provides error response if group is closed or paused already, else nil.
+func (g Group) ValidatePausable() error {
+	switch g.State {
+	case GroupClosed:
+		return ErrGroupClosed
+	case GroupPaused:
+		return ErrGroupPaused
+	default:
+		return nil
+	}
+}
+
+// ValidateStartable provides error response unless group is paused
+func (g Group) ValidateStartable() error {
+	switch g.State {
+	case GroupPaused:
+		return nil
+	default:
+		return ErrGroupNotPaused
+	}
+}
+
 // ValidateClosable provides error response if group is already closed,
 // and thus should not be closed again, else nil.
 func (g Group) ValidateClosable() error {
@@ -157,3 +238,35 @@ func (r Resource) GetCount() uint32 {
 func (r Resource) FullPrice() sdk.Coin {
 	return sdk.NewCoin(r.Price.Denom, r.Price.Amount.MulRaw(int64(r.Count)))
 }
+
+// Expiry declares when a deployment is closed automatically, either at an
+// absolute block height or a number of blocks after the current one.
+// At most one of the two may be set; an empty Expiry never expires.
+type Expiry struct {
+	Height   int64 `json:"height,omitempty"`
+	Duration int64 `json:"duration,omitempty"`
+}
+
+// Empty returns true if no expiry is set
+func (e Expiry) Empty() bool {
+	return e.Height == 0 && e.Duration == 0
+}
+
+// Validate returns error if the expiry is negative or sets both height and duration
+func (e Expiry) Validate() error {
+	if e.Height < 0 || e.Duration < 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiry, "negative expiry")
+	}
+	if e.Height != 0 && e.Duration != 0 {
+		return sdkerrors.Wrap(ErrInvalidExpiry, "height and duration are mutually exclusive")
+	}
+	return nil
+}
+
+// ExpiresAt returns the block height the expiry resolves to, relative to height
+func (e Expiry) ExpiresAt(height int64) int64 {
+	if e.Duration != 0 {
+		return height + e.Duration
+	}
+	return e.Height
+}
@@ -3,9 +3,12 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/testutil"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	"github.com/ovrclk/akash/x/deployment/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type gStateTest struct {
@@ -54,3 +57,86 @@ func TestGroupState(t *testing.T) {
 		assert.Equal(t, group.ValidateClosable(), test.expValidateClosable, group.State)
 	}
 }
+
+func TestGroupSpecMatchRequirements(t *testing.T) {
+	attrs := []sdk.Attribute{sdk.NewAttribute("region", "us-west")}
+	auditor1 := testutil.AccAddress(t)
+	auditor2 := testutil.AccAddress(t)
+
+	audited := atypes.Providers{
+		{Owner: testutil.AccAddress(t), Auditor: auditor1, Attributes: attrs},
+	}
+
+	spec := types.GroupSpec{Requirements: attrs}
+	assert.True(t, spec.MatchRequirements(attrs, nil))
+
+	spec.SignedBy = types.SignedBy{AllOf: []string{auditor1.String()}}
+	assert.True(t, spec.MatchRequirements(attrs, audited))
+	assert.False(t, spec.MatchRequirements(attrs, nil))
+
+	spec.SignedBy = types.SignedBy{AllOf: []string{auditor1.String(), auditor2.String()}}
+	assert.False(t, spec.MatchRequirements(attrs, audited))
+
+	spec.SignedBy = types.SignedBy{AnyOf: []string{auditor1.String(), auditor2.String()}}
+	assert.True(t, spec.MatchRequirements(attrs, audited))
+
+	spec.SignedBy = types.SignedBy{AnyOf: []string{auditor2.String()}}
+	assert.False(t, spec.MatchRequirements(attrs, audited))
+}
+
+func TestGroupSpecPriceMixedDenoms(t *testing.T) {
+	gspec := types.GroupSpec{
+		Resources: []types.Resource{
+			{Count: 2, Price: sdk.NewCoin("akash", sdk.NewInt(10))},
+			{Count: 1, Price: sdk.NewCoin("other", sdk.NewInt(5))},
+			{Count: 1, Price: sdk.NewCoin("akash", sdk.NewInt(3))},
+		},
+	}
+	_, err := gspec.Price()
+	assert.True(t, types.ErrMixedPriceDenoms.Is(err))
+
+	gspec.Resources = append(gspec.Resources[:1], gspec.Resources[2])
+	price, err := gspec.Price()
+	require.NoError(t, err)
+	assert.Equal(t, sdk.NewCoin("akash", sdk.NewInt(23)), price)
+}
+
+func TestParamsValidate(t *testing.T) {
+	assert.NoError(t, types.DefaultParams().Validate())
+	assert.NoError(t, types.Params{PriceDenoms: []string{"akash", "ibcatom"}}.Validate())
+	assert.Error(t, types.Params{}.Validate())
+	assert.Error(t, types.Params{PriceDenoms: []string{"akash", "akash"}}.Validate())
+	assert.Error(t, types.Params{PriceDenoms: []string{"A"}}.Validate())
+
+	params := types.DefaultParams()
+	assert.True(t, params.IsPriceDenom(types.DefaultPriceDenom))
+	assert.False(t, params.IsPriceDenom("other"))
+}
+
+func TestGroupStateTransitions(t *testing.T) {
+	assert.NoError(t, types.GroupOpen.ValidateTransition(types.GroupOrdered))
+	assert.NoError(t, types.GroupOrdered.ValidateTransition(types.GroupMatched))
+	assert.NoError(t, types.GroupMatched.ValidateTransition(types.GroupInsufficientFunds))
+	assert.NoError(t, types.GroupInsufficientFunds.ValidateTransition(types.GroupOpen))
+
+	for _, state := range []types.GroupState{
+		types.GroupOpen, types.GroupOrdered, types.GroupMatched, types.GroupInsufficientFunds,
+	} {
+		assert.NoError(t, state.ValidateTransition(types.GroupClosed), state.String())
+		assert.True(t, types.ErrInvalidStateTransition.Is(types.GroupClosed.ValidateTransition(state)), state.String())
+	}
+
+	for _, state := range []types.GroupState{
+		types.GroupOpen, types.GroupOrdered, types.GroupMatched, types.GroupInsufficientFunds,
+	} {
+		assert.NoError(t, state.ValidateTransition(types.GroupPaused), state.String())
+	}
+	assert.NoError(t, types.GroupPaused.ValidateTransition(types.GroupOpen))
+	assert.NoError(t, types.GroupPaused.ValidateTransition(types.GroupClosed))
+	assert.True(t, types.ErrInvalidStateTransition.Is(types.GroupClosed.ValidateTransition(types.GroupPaused)))
+	assert.True(t, types.ErrInvalidStateTransition.Is(types.GroupPaused.ValidateTransition(types.GroupMatched)))
+	assert.Equal(t, "paused", types.GroupPaused.String())
+
+	assert.True(t, types.ErrInvalidStateTransition.Is(types.GroupOpen.ValidateTransition(types.GroupMatched)))
+	assert.True(t, types.ErrInvalidStateTransition.Is(types.DeploymentClosed.ValidateTransition(types.DeploymentActive)))
+}
@@ -20,4 +20,7 @@ type (
 var (
 	// NewKeeper creates new keeper instance of market module
 	NewKeeper = keeper.NewKeeper
+
+	// RegisterMigrations registers the market store migrations
+	RegisterMigrations = keeper.RegisterMigrations
 )
@@ -107,6 +107,7 @@ func ProviderFromFlagsWithoutCtx(flags *pflag.FlagSet) (sdk.AccAddress, error) {
 func AddOrderFilterFlags(flags *pflag.FlagSet) {
 	flags.String("owner", "", "order owner address to filter")
 	flags.String("state", "", "order state to filter (open,matched,closed)")
+	dcli.AddPaginationFlags(flags)
 }
 
 // OrderFiltersFromFlags returns OrderFilters with given flags and error if occurred
@@ -118,6 +119,7 @@ func OrderFiltersFromFlags(flags *pflag.FlagSet) (query.OrderFilters, error) {
 	ofilters := query.OrderFilters{
 		Owner:        gfilters.Owner,
 		StateFlagVal: gfilters.StateFlagVal,
+		Page:         gfilters.Page,
 	}
 	return ofilters, nil
 }
@@ -126,6 +128,8 @@ func OrderFiltersFromFlags(flags *pflag.FlagSet) (query.OrderFilters, error) {
 func AddBidFilterFlags(flags *pflag.FlagSet) {
 	flags.String("owner", "", "bid owner address to filter")
 	flags.String("state", "", "bid state to filter (open,matched,lost,closed)")
+	flags.String("provider", "", "bid provider address to filter")
+	dcli.AddPaginationFlags(flags)
 }
 
 // BidFiltersFromFlags returns BidFilters with given flags and error if occurred
@@ -134,9 +138,19 @@ func BidFiltersFromFlags(flags *pflag.FlagSet) (query.BidFilters, error) {
 	if err != nil {
 		return query.BidFilters{}, err
 	}
+	provider, err := flags.GetString("provider")
+	if err != nil {
+		return query.BidFilters{}, err
+	}
+	paddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return query.BidFilters{}, err
+	}
 	bfilters := query.BidFilters{
 		Owner:        ofilters.Owner,
+		Provider:     paddr,
 		StateFlagVal: ofilters.StateFlagVal,
+		Page:         ofilters.Page,
 	}
 	return bfilters, nil
 }
@@ -145,6 +159,8 @@ func BidFiltersFromFlags(flags *pflag.FlagSet) (query.BidFilters, error) {
 func AddLeaseFilterFlags(flags *pflag.FlagSet) {
 	flags.String("owner", "", "lease owner address to filter")
 	flags.String("state", "", "lease state to filter (active,insufficient-funds,closed)")
+	flags.String("provider", "", "lease provider address to filter")
+	dcli.AddPaginationFlags(flags)
 }
 
 // LeaseFiltersFromFlags returns LeaseFilters with given flags and error if occurred
@@ -153,9 +169,19 @@ func LeaseFiltersFromFlags(flags *pflag.FlagSet) (query.LeaseFilters, error) {
 	if err != nil {
 		return query.LeaseFilters{}, err
 	}
+	provider, err := flags.GetString("provider")
+	if err != nil {
+		return query.LeaseFilters{}, err
+	}
+	paddr, err := sdk.AccAddressFromBech32(provider)
+	if err != nil {
+		return query.LeaseFilters{}, err
+	}
 	lfilters := query.LeaseFilters{
 		Owner:        ofilters.Owner,
+		Provider:     paddr,
 		StateFlagVal: ofilters.StateFlagVal,
+		Page:         ofilters.Page,
 	}
 	return lfilters, nil
 }
@@ -23,6 +23,7 @@ func GetQueryCmd(key string, cdc *codec.Codec) *cobra.Command {
 		getOrderCmd(key, cdc),
 		getBidCmd(key, cdc),
 		getLeaseCmd(key, cdc),
+		cmdGetStats(key, cdc),
 	)...)
 
 	return cmd
@@ -0,0 +1,59 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	"github.com/ovrclk/akash/x/market/query"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+func cmdGetStats(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Query price statistics of active leases and open bids",
+		Long: "Query the p10, median and p90 prices of active leases and open bids normalized " +
+			"per milli CPU, per GiB of memory and per GiB of storage",
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+
+			sfilters, err := StatsFiltersFromFlags(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			obj, err := query.NewClient(ctx, key).Stats(sfilters)
+			if err != nil {
+				return err
+			}
+			return ctx.PrintOutput(obj)
+		},
+	}
+	AddStatsFilterFlags(cmd.Flags())
+	return cmd
+}
+
+// AddStatsFilterFlags add flags to filter market statistics
+func AddStatsFilterFlags(flags *pflag.FlagSet) {
+	flags.String("denom", dtypes.DefaultPriceDenom, "denomination of the prices to compute statistics over")
+	flags.String("group-by", "", "provider attribute to break statistics down by (e.g. region)")
+}
+
+// StatsFiltersFromFlags returns StatsFilters with given flags and error if occurred
+func StatsFiltersFromFlags(flags *pflag.FlagSet) (query.StatsFilters, error) {
+	denom, err := flags.GetString("denom")
+	if err != nil {
+		return query.StatsFilters{}, err
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return query.StatsFilters{}, err
+	}
+	attribute, err := flags.GetString("group-by")
+	if err != nil {
+		return query.StatsFilters{}, err
+	}
+	return query.StatsFilters{Denom: denom, Attribute: attribute}, nil
+}
@@ -6,6 +6,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	drest "github.com/ovrclk/akash/x/deployment/client/rest"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/query"
 	"github.com/ovrclk/akash/x/market/types"
 )
@@ -42,6 +43,7 @@ func OrderFiltersFromRequest(r *http.Request) (query.OrderFilters, string) {
 	ofilters := query.OrderFilters{
 		Owner:        gfilters.Owner,
 		StateFlagVal: gfilters.StateFlagVal,
+		Page:         gfilters.Page,
 	}
 	return ofilters, ""
 }
@@ -76,9 +78,16 @@ func BidFiltersFromRequest(r *http.Request) (query.BidFilters, string) {
 		return query.BidFilters{}, errMsg
 	}
 
+	provider, errMsg := providerFilterFromRequest(r)
+	if len(errMsg) != 0 {
+		return query.BidFilters{}, errMsg
+	}
+
 	bfilters := query.BidFilters{
 		Owner:        ofilters.Owner,
+		Provider:     provider,
 		StateFlagVal: ofilters.StateFlagVal,
+		Page:         ofilters.Page,
 	}
 	return bfilters, ""
 }
@@ -100,9 +109,42 @@ func LeaseFiltersFromRequest(r *http.Request) (query.LeaseFilters, string) {
 		return query.LeaseFilters{}, errMsg
 	}
 
+	provider, errMsg := providerFilterFromRequest(r)
+	if len(errMsg) != 0 {
+		return query.LeaseFilters{}, errMsg
+	}
+
 	lfilters := query.LeaseFilters{
 		Owner:        bfilters.Owner,
+		Provider:     provider,
 		StateFlagVal: bfilters.StateFlagVal,
+		Page:         bfilters.Page,
 	}
 	return lfilters, ""
 }
+
+// providerFilterFromRequest returns the optional provider filter param in request
+func providerFilterFromRequest(r *http.Request) (sdk.AccAddress, string) {
+	provider, err := sdk.AccAddressFromBech32(r.URL.Query().Get("provider"))
+	if err != nil {
+		return sdk.AccAddress{}, err.Error()
+	}
+	return provider, ""
+}
+
+// StatsFiltersFromRequest returns StatsFilters with given params in request
+func StatsFiltersFromRequest(r *http.Request) (query.StatsFilters, string) {
+	sfilters := query.StatsFilters{
+		Denom:     r.URL.Query().Get("denom"),
+		Attribute: r.URL.Query().Get("group-by"),
+	}
+
+	if sfilters.Denom == "" {
+		sfilters.Denom = dtypes.DefaultPriceDenom
+	}
+
+	if err := sdk.ValidateDenom(sfilters.Denom); err != nil {
+		return query.StatsFilters{}, err.Error()
+	}
+	return sfilters, ""
+}
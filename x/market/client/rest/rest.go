@@ -29,6 +29,9 @@ func RegisterRoutes(ctx context.CLIContext, r *mux.Router, ns string) {
 
 	// Get single order info
 	r.HandleFunc(fmt.Sprintf("/%s/lease/info", ns), getLeaseHandler(ctx, ns)).Methods("GET")
+
+	// Get price statistics of leases and bids
+	r.HandleFunc(fmt.Sprintf("/%s/stats", ns), getStatsHandler(ctx, ns)).Methods("GET")
 }
 
 func listOrdersHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
@@ -140,3 +143,22 @@ func getLeaseHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
 		rest.PostProcessResponse(w, ctx, res)
 	}
 }
+
+func getStatsHandler(ctx context.CLIContext, ns string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+
+		sfilters, errMsg := StatsFiltersFromRequest(r)
+
+		if len(errMsg) != 0 {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, errMsg)
+			return
+		}
+
+		res, err := query.NewRawClient(ctx, ns).Stats(sfilters)
+		if err != nil {
+			rest.WriteErrorResponse(w, http.StatusNotFound, "Not Found")
+			return
+		}
+		rest.PostProcessResponse(w, ctx, res)
+	}
+}
@@ -26,6 +26,7 @@ func DefaultGenesisState() GenesisState {
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetStoreVersion(ctx, types.ConsensusVersion)
 	return []abci.ValidatorUpdate{}
 }
 
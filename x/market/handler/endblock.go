@@ -34,8 +34,15 @@ func transferFundsForActiveLeases(ctx sdk.Context, keepers Keepers) error {
 		amt := sdk.NewCoins(lease.Price)
 
 		if !keepers.Bank.HasCoins(ctx, lease.Owner, amt) {
-			keepers.Deployment.OnLeaseInsufficientFunds(ctx, lease.GroupID())
-			keepers.Market.OnInsufficientFunds(ctx, lease)
+			// the group and the lease change together or not at all
+			cctx, write := ctx.CacheContext()
+			cctx = cctx.WithEventManager(sdk.NewEventManager())
+			if err := leaseInsufficientFunds(cctx, keepers, lease); err != nil {
+				ctx.Logger().Error("updating lease with insufficient funds", "err", err, "lease", lease.ID())
+				return false
+			}
+			write()
+			ctx.EventManager().EmitEvents(cctx.EventManager().Events())
 			return false
 		}
 
@@ -57,6 +64,14 @@ func transferFundsForActiveLeases(ctx sdk.Context, keepers Keepers) error {
 	return nil
 }
 
+// leaseInsufficientFunds moves the lease and its group out of the matched state
+func leaseInsufficientFunds(ctx sdk.Context, keepers Keepers, lease types.Lease) error {
+	if err := keepers.Deployment.OnLeaseInsufficientFunds(ctx, lease.GroupID()); err != nil {
+		return err
+	}
+	return keepers.Market.OnInsufficientFunds(ctx, lease)
+}
+
 var errNoBids error = errors.New("no bids to pick winner from")
 
 func pickBidWinner(bids []types.Bid) (winner *types.Bid, err error) {
@@ -121,6 +136,19 @@ func matchOrders(ctx sdk.Context, keepers Keepers) error {
 			return false
 		})
 
+		// amendments are matched or declined once they may be
+		if order.IsAmendment() {
+			cctx, write := ctx.CacheContext()
+			cctx = cctx.WithEventManager(sdk.NewEventManager())
+			if err := matchAmendment(cctx, keepers, order, bids); err != nil {
+				ctx.Logger().Error("matching amendment", "err", err, "order", order.ID())
+				return false
+			}
+			write()
+			ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+			return false
+		}
+
 		// no open bids
 		if len(bids) == 0 {
 			return false
@@ -132,28 +160,87 @@ func matchOrders(ctx sdk.Context, keepers Keepers) error {
 			panic(pErr.Error())
 		}
 
-		// create lease
-		keepers.Market.CreateLease(ctx, *winner)
+		// match in a cache so that a rejected transition leaves the order unmatched
+		cctx, write := ctx.CacheContext()
+		cctx = cctx.WithEventManager(sdk.NewEventManager())
+		if err := matchOrder(cctx, keepers, order, *winner, bids); err != nil {
+			ctx.Logger().Error("matching order", "err", err, "order", order.ID())
+			return false
+		}
+		write()
+		ctx.EventManager().EmitEvents(cctx.EventManager().Events())
+
+		return false
+	})
+	return nil
+}
+
+// matchOrder creates a lease for the winning bid of an order and updates the
+// states of the order, its bids and its group
+func matchOrder(ctx sdk.Context, keepers Keepers, order types.Order, winner types.Bid, bids []types.Bid) error {
+	// create lease
+	if err := keepers.Market.CreateLease(ctx, winner); err != nil {
+		return err
+	}
 
-		// set winning bid state to matched
-		keepers.Market.OnBidMatched(ctx, *winner)
+	// set winning bid state to matched
+	if err := keepers.Market.OnBidMatched(ctx, winner); err != nil {
+		return err
+	}
 
-		// set losing bids to state lost
-		// Set all but winning bid to State: Lost
-		for _, bid := range bids {
-			if winner.Equals(bid.BidID) {
-				continue // skip setting state to lost
-			}
-			keepers.Market.OnBidLost(ctx, bid)
+	// set losing bids to state lost
+	// Set all but winning bid to State: Lost
+	for _, bid := range bids {
+		if winner.Equals(bid.BidID) {
+			continue // skip setting state to lost
 		}
+		if err := keepers.Market.OnBidLost(ctx, bid); err != nil {
+			return err
+		}
+	}
 
-		// set order state to matched
-		keepers.Market.OnOrderMatched(ctx, order)
+	// set order state to matched
+	if err := keepers.Market.OnOrderMatched(ctx, order); err != nil {
+		return err
+	}
 
-		// notify group of match
-		keepers.Deployment.OnLeaseCreated(ctx, order.GroupID())
+	// notify group of match
+	return keepers.Deployment.OnLeaseCreated(ctx, order.GroupID())
+}
 
-		return false
-	})
-	return nil
+// matchAmendment amends the lease of an amendment order to the bid of its provider.
+// Without a bid the amendment is declined: the lease is closed and its group reopened
+// so that the updated group is ordered anew.
+func matchAmendment(ctx sdk.Context, keepers Keepers, order types.Order, bids []types.Bid) error {
+	lease, ok := keepers.Market.GetLease(ctx, *order.Amends)
+	if !ok {
+		return types.ErrLeaseNotFound
+	}
+
+	if len(bids) > 0 {
+		return keepers.Market.OnLeaseAmended(ctx, lease, order, bids[0])
+	}
+
+	if err := keepers.Market.OnOrderClosed(ctx, order); err != nil {
+		return err
+	}
+
+	if lease.State != types.LeaseActive {
+		return nil
+	}
+
+	if err := keepers.Market.OnLeaseClosedWithReason(ctx, lease, types.LeaseClosedReasonAmendmentDeclined); err != nil {
+		return err
+	}
+	if bid, ok := keepers.Market.GetBid(ctx, lease.ID().BidID()); ok {
+		if err := keepers.Market.OnBidClosed(ctx, bid); err != nil {
+			return err
+		}
+	}
+	if prev, ok := keepers.Market.GetOrder(ctx, lease.OrderID()); ok {
+		if err := keepers.Market.OnOrderClosed(ctx, prev); err != nil {
+			return err
+		}
+	}
+	return keepers.Deployment.OnLeaseClosed(ctx, order.GroupID())
 }
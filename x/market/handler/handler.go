@@ -34,11 +34,24 @@ func handleMsgCreateBid(ctx sdk.Context, keepers Keepers, msg types.MsgCreateBid
 		return nil, err
 	}
 
+	if order.IsAmendment() && !order.Amends.Provider.Equals(msg.Provider) {
+		return nil, types.ErrAmendmentProvider
+	}
+
 	if !msg.Price.IsValid() {
 		return nil, types.ErrBidInvalidPrice
 	}
 
-	if order.Price().IsLT(msg.Price) {
+	price, err := order.Price()
+	if err != nil {
+		return nil, err
+	}
+
+	if price.Denom != msg.Price.Denom {
+		return nil, types.ErrBidInvalidDenom
+	}
+
+	if price.IsLT(msg.Price) {
 		return nil, types.ErrBidOverOrder
 	}
 
@@ -47,7 +60,11 @@ func handleMsgCreateBid(ctx sdk.Context, keepers Keepers, msg types.MsgCreateBid
 		return nil, types.ErrEmptyProvider
 	}
 
-	if !order.MatchAttributes(prov.Attributes) {
+	if prov.Draining {
+		return nil, ptypes.ErrProviderDraining
+	}
+
+	if !order.MatchRequirements(prov.Attributes, keepers.Audit.GetProviderAttributes(ctx, prov.Owner)) {
 		return nil, types.ErrAttributeMismatch
 	}
 
@@ -72,7 +89,9 @@ func handleMsgCloseBid(ctx sdk.Context, keepers Keepers, msg types.MsgCloseBid)
 	}
 
 	if bid.State == types.BidOpen {
-		keepers.Market.OnBidClosed(ctx, bid)
+		if err := keepers.Market.OnBidClosed(ctx, bid); err != nil {
+			return nil, err
+		}
 		return &sdk.Result{
 			Events: ctx.EventManager().Events(),
 		}, nil
@@ -91,10 +110,18 @@ func handleMsgCloseBid(ctx sdk.Context, keepers Keepers, msg types.MsgCloseBid)
 		return nil, types.ErrBidNotMatched
 	}
 
-	keepers.Market.OnBidClosed(ctx, bid)
-	keepers.Market.OnLeaseClosed(ctx, lease)
-	keepers.Market.OnOrderClosed(ctx, order)
-	keepers.Deployment.OnLeaseClosed(ctx, order.GroupID())
+	if err := keepers.Market.OnBidClosed(ctx, bid); err != nil {
+		return nil, err
+	}
+	if err := keepers.Market.OnLeaseClosed(ctx, lease); err != nil {
+		return nil, err
+	}
+	if err := keepers.Market.OnOrderClosed(ctx, order); err != nil {
+		return nil, err
+	}
+	if err := keepers.Deployment.OnLeaseClosed(ctx, order.GroupID()); err != nil {
+		return nil, err
+	}
 
 	return &sdk.Result{
 		Events: ctx.EventManager().Events(),
@@ -107,14 +134,24 @@ func handleMsgCloseOrder(ctx sdk.Context, keepers Keepers, msg types.MsgCloseOrd
 		return nil, types.ErrUnknownOrder
 	}
 
+	if order.State == types.OrderClosed {
+		return nil, types.ErrOrderClosed
+	}
+
 	lease, found := keepers.Market.LeaseForOrder(ctx, order.ID())
 	if !found {
 		return nil, types.ErrNoLeaseForOrder
 	}
 
-	keepers.Market.OnOrderClosed(ctx, order)
-	keepers.Market.OnLeaseClosed(ctx, lease)
-	keepers.Deployment.OnLeaseClosed(ctx, order.GroupID())
+	if err := keepers.Market.OnOrderClosed(ctx, order); err != nil {
+		return nil, err
+	}
+	if err := keepers.Market.OnLeaseClosed(ctx, lease); err != nil {
+		return nil, err
+	}
+	if err := keepers.Deployment.OnLeaseClosed(ctx, order.GroupID()); err != nil {
+		return nil, err
+	}
 	return &sdk.Result{
 		Events: ctx.EventManager().Events(),
 	}, nil
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -16,6 +17,8 @@ import (
 
 	"github.com/ovrclk/akash/app"
 	"github.com/ovrclk/akash/testutil"
+	akeeper "github.com/ovrclk/akash/x/audit/keeper"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dkeeper "github.com/ovrclk/akash/x/deployment/keeper"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/handler"
@@ -32,6 +35,7 @@ type testSuite struct {
 	mkeeper keeper.Keeper
 	dkeeper dkeeper.Keeper
 	pkeeper pkeeper.Keeper
+	akeeper akeeper.Keeper
 	bkeeper bank.Keeper
 
 	handler sdk.Handler
@@ -45,12 +49,14 @@ func setupTestSuite(t *testing.T) *testSuite {
 	mKey := sdk.NewKVStoreKey(types.StoreKey)
 	dKey := sdk.NewKVStoreKey(dtypes.StoreKey)
 	pKey := sdk.NewKVStoreKey(ptypes.StoreKey)
+	aKey := sdk.NewKVStoreKey(atypes.StoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(aKey, sdk.StoreTypeIAVL, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
@@ -58,13 +64,18 @@ func setupTestSuite(t *testing.T) *testSuite {
 	suite.ctx = sdk.NewContext(suite.ms, abci.Header{}, true, testutil.Logger(t))
 
 	suite.mkeeper = keeper.NewKeeper(app.MakeCodec(), mKey)
-	suite.dkeeper = dkeeper.NewKeeper(app.MakeCodec(), dKey)
-	suite.pkeeper = pkeeper.NewKeeper(app.MakeCodec(), pKey)
+	suite.dkeeper = dkeeper.NewKeeper(app.MakeCodec(), dKey, params.NewKeeper(app.MakeCodec(),
+		sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)).Subspace(dtypes.DefaultParamspace))
+	suite.pkeeper = pkeeper.NewKeeper(app.MakeCodec(), pKey, params.NewKeeper(app.MakeCodec(),
+		sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)).Subspace(ptypes.DefaultParamspace))
+	suite.akeeper = akeeper.NewKeeper(app.MakeCodec(), aKey, params.NewKeeper(app.MakeCodec(),
+		sdk.NewKVStoreKey(params.StoreKey), sdk.NewTransientStoreKey(params.TStoreKey)).Subspace(atypes.DefaultParamspace))
 
 	suite.handler = handler.NewHandler(handler.Keepers{
 		Market:     suite.mkeeper,
 		Deployment: suite.dkeeper,
 		Provider:   suite.pkeeper,
+		Audit:      suite.akeeper,
 		Bank:       suite.bkeeper,
 	})
 
@@ -189,6 +200,22 @@ func TestCreateBidOverprice(t *testing.T) {
 	require.EqualError(t, err, types.ErrBidOverOrder.Error())
 }
 
+func TestCreateBidInvalidDenom(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createOrder(nil)
+
+	msg := types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: suite.createProvider(gspec.Requirements).Owner,
+		Price:    sdk.NewCoin("unlisted", sdk.NewInt(1)),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrBidInvalidDenom.Error())
+}
+
 func TestCreateBidInvalidProvider(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -221,6 +248,59 @@ func TestCreateBidInvalidAttributes(t *testing.T) {
 	require.EqualError(t, err, types.ErrAttributeMismatch.Error())
 }
 
+func TestCreateBidDrainingProvider(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	order, gspec := suite.createOrder(testutil.Resources(t))
+
+	prov := suite.createProvider(gspec.Requirements)
+	prov.Draining = true
+	err := suite.pkeeper.Update(suite.ctx, prov)
+	require.NoError(t, err)
+
+	msg := types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: prov.Owner,
+		Price:    sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(1)),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, ptypes.ErrProviderDraining.Error())
+}
+
+func TestCreateBidSignedBy(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 0)
+	auditor := testutil.AccAddress(t)
+	group.SignedBy = dtypes.SignedBy{AllOf: []string{auditor.String()}}
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+
+	prov := suite.createProvider(group.Requirements)
+
+	msg := types.MsgCreateBid{
+		Order:    order.ID(),
+		Provider: prov.Owner,
+		Price:    sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(1)),
+	}
+
+	res, err := suite.handler(suite.ctx, msg)
+	require.Nil(t, res)
+	require.EqualError(t, err, types.ErrAttributeMismatch.Error())
+
+	suite.akeeper.CreateOrUpdateProviderAttributes(suite.ctx, atypes.ProviderID{
+		Owner:   prov.Owner,
+		Auditor: auditor,
+	}, group.Requirements)
+
+	res, err = suite.handler(suite.ctx, msg)
+	require.NotNil(t, res)
+	require.NoError(t, err)
+}
+
 func TestCreateBidAlreadyExists(t *testing.T) {
 	suite := setupTestSuite(t)
 
@@ -282,7 +362,7 @@ func TestCloseOrderValid(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("ensure event created", func(t *testing.T) {
-		iev := testutil.ParseMarketEvent(t, res.Events[3:4])
+		iev := testutil.ParseMarketEvent(t, res.Events[5:6])
 		require.IsType(t, types.EventOrderClosed{}, iev)
 
 		dev := iev.(types.EventOrderClosed)
@@ -337,7 +417,7 @@ func TestCloseBidValid(t *testing.T) {
 	require.NoError(t, err)
 
 	t.Run("ensure event created", func(t *testing.T) {
-		iev := testutil.ParseMarketEvent(t, res.Events[3:4])
+		iev := testutil.ParseMarketEvent(t, res.Events[5:6])
 		require.IsType(t, types.EventBidClosed{}, iev)
 
 		dev := iev.(types.EventBidClosed)
@@ -372,11 +452,11 @@ func TestCloseBidWithStateOpen(t *testing.T) {
 func TestCloseBidNotActiveLease(t *testing.T) {
 	suite := setupTestSuite(t)
 
-	lease, bid, _ := suite.createLease()
+	lid, bid, _ := suite.createLease()
 
-	suite.mkeeper.OnLeaseClosed(suite.ctx, types.Lease{
-		LeaseID: lease,
-	})
+	lease, ok := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+	require.NoError(t, suite.mkeeper.OnLeaseClosed(suite.ctx, lease))
 	msg := types.MsgCloseBid{
 		BidID: bid.ID(),
 	}
@@ -408,13 +488,149 @@ func TestCloseBidUnknownOrder(t *testing.T) {
 	require.EqualError(t, err, types.ErrUnknownOrderForBid.Error())
 }
 
+func TestCreateBidAmendment(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	lid, _, order := suite.createLease()
+	lease, ok := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+
+	amendment, err := suite.mkeeper.CreateAmendment(suite.ctx, lease, order.Spec)
+	require.NoError(t, err)
+
+	// other providers may not bid
+	other := suite.createProvider(order.Spec.Requirements).Owner
+	res, err := suite.handler(suite.ctx, types.MsgCreateBid{
+		Order:    amendment.ID(),
+		Provider: other,
+		Price:    sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(1)),
+	})
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrAmendmentProvider))
+
+	require.NoError(t, suite.pkeeper.Create(suite.ctx, ptypes.Provider{
+		Owner:      lid.Provider,
+		HostURI:    "thinker://tailor.soldier?sailor",
+		Attributes: order.Spec.Requirements,
+	}))
+	res, err = suite.handler(suite.ctx, types.MsgCreateBid{
+		Order:    amendment.ID(),
+		Provider: lid.Provider,
+		Price:    sdk.NewCoin(testutil.CoinDenom, sdk.NewInt(1)),
+	})
+	require.NotNil(t, res)
+	require.NoError(t, err)
+}
+
+func TestEndBlockAmendment(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	lid, _, order := suite.createLease()
+	lease, ok := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+
+	spec := order.Spec
+	spec.Resources = append([]dtypes.Resource{}, spec.Resources...)
+	spec.Resources[0].Count++
+
+	amendment, err := suite.mkeeper.CreateAmendment(suite.ctx, lease, spec)
+	require.NoError(t, err)
+
+	price := sdk.NewCoin(testutil.CoinDenom, lease.Price.Amount.AddRaw(1))
+	_, err = suite.mkeeper.CreateBid(suite.ctx, amendment.ID(), lid.Provider, price)
+	require.NoError(t, err)
+
+	// amendments are not matched before they start
+	require.NoError(t, handler.OnEndBlock(suite.ctx, suite.keepers()))
+	lease, ok = suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+	require.NotEqual(t, price, lease.Price)
+
+	ctx := suite.ctx.WithBlockHeight(amendment.StartAt)
+	require.NoError(t, handler.OnEndBlock(ctx, suite.keepers()))
+
+	lease, ok = suite.mkeeper.GetLease(ctx, lid)
+	require.True(t, ok)
+	require.Equal(t, types.LeaseActive, lease.State)
+	require.Equal(t, price, lease.Price)
+
+	order, ok = suite.mkeeper.GetOrder(ctx, lid.OrderID())
+	require.True(t, ok)
+	require.Equal(t, types.OrderMatched, order.State)
+	require.Equal(t, spec, order.Spec)
+
+	amendment, ok = suite.mkeeper.GetOrder(ctx, amendment.ID())
+	require.True(t, ok)
+	require.Equal(t, types.OrderClosed, amendment.State)
+}
+
+func TestEndBlockAmendmentDeclined(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	lid, _, order := suite.createLease()
+	lease, ok := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+
+	amendment, err := suite.mkeeper.CreateAmendment(suite.ctx, lease, order.Spec)
+	require.NoError(t, err)
+
+	ctx := suite.ctx.WithBlockHeight(amendment.StartAt)
+	require.NoError(t, handler.OnEndBlock(ctx, suite.keepers()))
+
+	lease, ok = suite.mkeeper.GetLease(ctx, lid)
+	require.True(t, ok)
+	require.Equal(t, types.LeaseClosed, lease.State)
+
+	for _, id := range []types.OrderID{lid.OrderID(), amendment.ID()} {
+		order, ok := suite.mkeeper.GetOrder(ctx, id)
+		require.True(t, ok)
+		require.Equal(t, types.OrderClosed, order.State)
+	}
+
+	// the group is ordered anew
+	group, ok := suite.dkeeper.GetGroup(ctx, lid.GroupID())
+	require.True(t, ok)
+	require.Equal(t, dtypes.GroupOpen, group.State)
+}
+
+func TestEndBlockInsufficientFunds(t *testing.T) {
+	suite := setupTestSuite(t)
+	keepers := suite.keepers()
+	keepers.Bank = brokeBank{}
+
+	lid, _, _ := suite.createLease()
+	require.NoError(t, handler.OnEndBlock(suite.ctx, keepers))
+
+	lease, ok := suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+	require.Equal(t, types.LeaseInsufficientFunds, lease.State)
+	group, ok := suite.dkeeper.GetGroup(suite.ctx, lid.GroupID())
+	require.True(t, ok)
+	require.Equal(t, dtypes.GroupInsufficientFunds, group.State)
+
+	// a rejected group transition leaves the lease unchanged
+	lid, _, _ = suite.createLease()
+	group, ok = suite.dkeeper.GetGroup(suite.ctx, lid.GroupID())
+	require.True(t, ok)
+	require.NoError(t, suite.dkeeper.OnPauseGroup(suite.ctx, group))
+	require.NoError(t, handler.OnEndBlock(suite.ctx, keepers))
+
+	lease, ok = suite.mkeeper.GetLease(suite.ctx, lid)
+	require.True(t, ok)
+	require.Equal(t, types.LeaseActive, lease.State)
+	group, ok = suite.dkeeper.GetGroup(suite.ctx, lid.GroupID())
+	require.True(t, ok)
+	require.Equal(t, dtypes.GroupPaused, group.State)
+}
+
 func (st *testSuite) createLease() (types.LeaseID, types.Bid, types.Order) {
 	st.t.Helper()
 	bid, order := st.createBid()
 
-	st.mkeeper.CreateLease(st.ctx, bid)
-	st.mkeeper.OnBidMatched(st.ctx, bid)
-	st.mkeeper.OnOrderMatched(st.ctx, order)
+	require.NoError(st.t, st.mkeeper.CreateLease(st.ctx, bid))
+	require.NoError(st.t, st.mkeeper.OnBidMatched(st.ctx, bid))
+	require.NoError(st.t, st.mkeeper.OnOrderMatched(st.ctx, order))
+	require.NoError(st.t, st.dkeeper.OnLeaseCreated(st.ctx, order.GroupID()))
 
 	lid := types.MakeLeaseID(bid.ID())
 	return lid, bid, order
@@ -435,9 +651,15 @@ func (st *testSuite) createBid() (types.Bid, types.Order) {
 
 func (st *testSuite) createOrder(resources []dtypes.Resource) (types.Order, dtypes.GroupSpec) {
 	st.t.Helper()
-	group := testutil.DeploymentGroup(st.t, testutil.DeploymentID(st.t), 0)
+	deployment := testutil.Deployment(st.t)
+	group := testutil.DeploymentGroup(st.t, deployment.ID(), 0)
+	group.State = dtypes.GroupOrdered
 
 	group.Resources = resources
+	// the deployment's events are not part of the market events under test
+	dctx := st.ctx.WithEventManager(sdk.NewEventManager())
+	require.NoError(st.t, st.dkeeper.Create(dctx, deployment, []dtypes.Group{group}))
+
 	order, err := st.mkeeper.CreateOrder(st.ctx, group.ID(), group.GroupSpec)
 	require.NoError(st.t, err)
 	require.Equal(st.t, group.ID(), order.ID().GroupID())
@@ -447,6 +669,37 @@ func (st *testSuite) createOrder(resources []dtypes.Resource) (types.Order, dtyp
 	return order, group.GroupSpec
 }
 
+// keepers returns the suite keepers with a bank that pays for every lease
+func (st *testSuite) keepers() handler.Keepers {
+	return handler.Keepers{
+		Market:     st.mkeeper,
+		Deployment: st.dkeeper,
+		Provider:   st.pkeeper,
+		Audit:      st.akeeper,
+		Bank:       paidBank{},
+	}
+}
+
+type paidBank struct {
+	bank.Keeper
+}
+
+func (paidBank) HasCoins(sdk.Context, sdk.AccAddress, sdk.Coins) bool {
+	return true
+}
+
+func (paidBank) SendCoins(sdk.Context, sdk.AccAddress, sdk.AccAddress, sdk.Coins) error {
+	return nil
+}
+
+type brokeBank struct {
+	bank.Keeper
+}
+
+func (brokeBank) HasCoins(sdk.Context, sdk.AccAddress, sdk.Coins) bool {
+	return false
+}
+
 func (st *testSuite) createProvider(attr []sdk.Attribute) ptypes.Provider {
 	st.t.Helper()
 
@@ -3,6 +3,7 @@ package handler
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/keeper"
 	ptypes "github.com/ovrclk/akash/x/provider/types"
@@ -14,12 +15,17 @@ type ProviderKeeper interface {
 	WithProviders(ctx sdk.Context, fn func(ptypes.Provider) bool)
 }
 
+// AuditKeeper Interface includes audit methods
+type AuditKeeper interface {
+	GetProviderAttributes(ctx sdk.Context, owner sdk.AccAddress) atypes.Providers
+}
+
 // DeploymentKeeper Interface includes deployment methods
 type DeploymentKeeper interface {
 	GetGroup(ctx sdk.Context, id dtypes.GroupID) (dtypes.Group, bool)
-	OnLeaseCreated(ctx sdk.Context, id dtypes.GroupID)
-	OnLeaseInsufficientFunds(ctx sdk.Context, id dtypes.GroupID)
-	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID)
+	OnLeaseCreated(ctx sdk.Context, id dtypes.GroupID) error
+	OnLeaseInsufficientFunds(ctx sdk.Context, id dtypes.GroupID) error
+	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID) error
 }
 
 // Keepers include all modules keepers
@@ -27,5 +33,6 @@ type Keepers struct {
 	Market     keeper.Keeper
 	Deployment DeploymentKeeper
 	Provider   ProviderKeeper
+	Audit      AuditKeeper
 	Bank       bank.Keeper
 }
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	"github.com/ovrclk/akash/x/market/types"
+)
+
+// GroupKeeper is the deployment state the market invariants are checked against
+type GroupKeeper interface {
+	GetGroup(ctx sdk.Context, id dtypes.GroupID) (dtypes.Group, bool)
+}
+
+// RegisterInvariants registers the market module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, dkeeper GroupKeeper) {
+	ir.RegisterRoute(types.ModuleName, "active-leases", ActiveLeasesInvariant(k, dkeeper))
+	ir.RegisterRoute(types.ModuleName, "matched-orders", MatchedOrdersInvariant(k))
+}
+
+// ActiveLeasesInvariant checks that every active lease belongs to a matched group
+// and was created for a matched bid
+func ActiveLeasesInvariant(k Keeper, dkeeper GroupKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.WithLeases(ctx, func(lease types.Lease) bool {
+			if lease.State != types.LeaseActive {
+				return false
+			}
+
+			group, ok := dkeeper.GetGroup(ctx, lease.GroupID())
+			switch {
+			case !ok:
+				count++
+				msg += fmt.Sprintf("\tlease %v: group not found\n", lease.ID())
+			case group.State != dtypes.GroupMatched:
+				count++
+				msg += fmt.Sprintf("\tlease %v: group is %v\n", lease.ID(), group.State)
+			}
+
+			bid, ok := k.GetBid(ctx, lease.ID().BidID())
+			switch {
+			case !ok:
+				count++
+				msg += fmt.Sprintf("\tlease %v: bid not found\n", lease.ID())
+			case bid.State != types.BidMatched:
+				count++
+				msg += fmt.Sprintf("\tlease %v: bid is %v\n", lease.ID(), bid.State)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "active-leases",
+			fmt.Sprintf("inconsistent active leases found %d\n%s", count, msg)), count != 0
+	}
+}
+
+// MatchedOrdersInvariant checks that no order has more than one matched bid and
+// that every matched order has a lease for its matched bid
+func MatchedOrdersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		k.WithOrders(ctx, func(order types.Order) bool {
+			var matched []types.Bid
+			k.WithBidsForOrder(ctx, order.ID(), func(bid types.Bid) bool {
+				if bid.State == types.BidMatched {
+					matched = append(matched, bid)
+				}
+				return false
+			})
+
+			if len(matched) > 1 {
+				count++
+				msg += fmt.Sprintf("\torder %v: %d matched bids\n", order.ID(), len(matched))
+			}
+
+			if order.State != types.OrderMatched {
+				return false
+			}
+
+			if len(matched) == 0 {
+				count++
+				msg += fmt.Sprintf("\torder %v: matched without a matched bid\n", order.ID())
+				return false
+			}
+
+			for _, bid := range matched {
+				if _, ok := k.GetLease(ctx, bid.ID().LeaseID()); !ok {
+					count++
+					msg += fmt.Sprintf("\torder %v: no lease for matched bid %v\n", order.ID(), bid.ID())
+				}
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "matched-orders",
+			fmt.Sprintf("inconsistent matched orders found %d\n%s", count, msg)), count != 0
+	}
+}
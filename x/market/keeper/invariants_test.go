@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	"github.com/ovrclk/akash/x/market/keeper"
+)
+
+type groupKeeper struct {
+	groups []dtypes.Group
+}
+
+func (k *groupKeeper) GetGroup(_ sdk.Context, id dtypes.GroupID) (dtypes.Group, bool) {
+	for _, group := range k.groups {
+		if group.ID().Equals(id) {
+			return group, true
+		}
+	}
+	return dtypes.Group{}, false
+}
+
+func (k *groupKeeper) set(id dtypes.GroupID, state dtypes.GroupState) {
+	for idx := range k.groups {
+		if k.groups[idx].ID().Equals(id) {
+			k.groups[idx].State = state
+			return
+		}
+	}
+	k.groups = append(k.groups, dtypes.Group{GroupID: id, State: state})
+}
+
+func Test_ActiveLeasesInvariant(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	groups := &groupKeeper{}
+	lid := createLease(t, ctx, k)
+
+	groups.set(lid.GroupID(), dtypes.GroupMatched)
+	_, broken := keeper.ActiveLeasesInvariant(k, groups)(ctx)
+	assert.False(t, broken)
+
+	groups.set(lid.GroupID(), dtypes.GroupClosed)
+	msg, broken := keeper.ActiveLeasesInvariant(k, groups)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "group is closed")
+
+	// closed leases of closed groups are consistent
+	lease, ok := k.GetLease(ctx, lid)
+	require.True(t, ok)
+	k.OnLeaseClosed(ctx, lease)
+	_, broken = keeper.ActiveLeasesInvariant(k, groups)(ctx)
+	assert.False(t, broken)
+}
+
+func Test_MatchedOrdersInvariant(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	createLease(t, ctx, k)
+
+	_, broken := keeper.MatchedOrdersInvariant(k)(ctx)
+	assert.False(t, broken)
+
+	// matched without a lease
+	bid, order := createBid(t, ctx, k)
+	k.OnBidMatched(ctx, bid)
+	k.OnOrderMatched(ctx, order)
+	msg, broken := keeper.MatchedOrdersInvariant(k)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "no lease for matched bid")
+}
+
+func Test_MatchedOrdersInvariantMatchedBids(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	order, _ := createOrder(t, ctx, k)
+
+	for i := 0; i < 2; i++ {
+		bid, err := k.CreateBid(ctx, order.ID(), testutil.AccAddress(t), sdk.NewInt64Coin("foo", 10))
+		require.NoError(t, err)
+		k.CreateLease(ctx, bid)
+		k.OnBidMatched(ctx, bid)
+	}
+	k.OnOrderMatched(ctx, order)
+
+	msg, broken := keeper.MatchedOrdersInvariant(k)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "2 matched bids")
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ovrclk/akash/sdkutil"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/types"
 	"github.com/pkg/errors"
@@ -31,8 +32,6 @@ func (k Keeper) Codec() *codec.Codec {
 
 // CreateOrder creates a new order with given group id and specifications. It returns created order
 func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.GroupSpec) (types.Order, error) {
-	store := ctx.KVStore(k.skey)
-
 	oseq := uint32(1)
 	var err error
 
@@ -55,10 +54,11 @@ func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.Gro
 		StartAt: ctx.BlockHeight() + orderTTL, // TODO: check overflow
 	}
 
-	key := orderKey(order.ID())
+	if ctx.KVStore(k.skey).Has(orderKey(order.ID())) {
+		return types.Order{}, types.ErrOrderExists
+	}
 
-	// XXX TODO: check not overwrite
-	store.Set(key, k.cdc.MustMarshalBinaryBare(order))
+	k.updateOrder(ctx, order)
 
 	ctx.Logger().Info("created order", "order", order.ID())
 	ctx.EventManager().EmitEvent(
@@ -67,6 +67,47 @@ func (k Keeper) CreateOrder(ctx sdk.Context, gid dtypes.GroupID, spec dtypes.Gro
 	return order, nil
 }
 
+// CreateAmendment creates an order amending the given lease to the specifications of its group.
+// It returns created order
+func (k Keeper) CreateAmendment(ctx sdk.Context, lease types.Lease, spec dtypes.GroupSpec) (types.Order, error) {
+	oseq := uint32(1)
+	var err error
+
+	k.WithOrdersForGroup(ctx, lease.GroupID(), func(order types.Order) bool {
+		if order.State == types.OrderOpen {
+			err = types.ErrOrderActive
+			return true
+		}
+		oseq++
+		return false
+	})
+
+	if err != nil {
+		return types.Order{}, errors.Wrap(err, "create amendment: open order exists")
+	}
+
+	amends := lease.ID()
+	order := types.Order{
+		OrderID: types.MakeOrderID(lease.GroupID(), oseq),
+		Spec:    spec,
+		State:   types.OrderOpen,
+		StartAt: ctx.BlockHeight() + orderTTL, // TODO: check overflow
+		Amends:  &amends,
+	}
+
+	if ctx.KVStore(k.skey).Has(orderKey(order.ID())) {
+		return types.Order{}, types.ErrOrderExists
+	}
+
+	k.updateOrder(ctx, order)
+
+	ctx.Logger().Info("created amendment", "order", order.ID(), "lease", lease.ID())
+	ctx.EventManager().EmitEvent(
+		types.EventOrderCreated{ID: order.ID()}.ToSDKEvent(),
+	)
+	return order, nil
+}
+
 // CreateBid creates a bid for a order with given orderID, price for bid and provider
 func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAddress, price sdk.Coin) (types.Bid, error) {
 	store := ctx.KVStore(k.skey)
@@ -83,8 +124,7 @@ func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAd
 		return types.Bid{}, types.ErrBidExists
 	}
 
-	// XXX TODO: check not overwrite
-	store.Set(key, k.cdc.MustMarshalBinaryBare(bid))
+	k.updateBid(ctx, bid)
 
 	ctx.EventManager().EmitEvent(
 		types.EventBidCreated{
@@ -97,18 +137,18 @@ func (k Keeper) CreateBid(ctx sdk.Context, oid types.OrderID, provider sdk.AccAd
 }
 
 // CreateLease creates lease for bid with given bidID
-func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
-	store := ctx.KVStore(k.skey)
-
+func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) error {
 	lease := types.Lease{
 		LeaseID: types.LeaseID(bid.ID()),
 		State:   types.LeaseActive,
 		Price:   bid.Price,
 	}
-	key := leaseKey(lease.ID())
 
-	// XXX TODO: check not overwrite
-	store.Set(key, k.cdc.MustMarshalBinaryBare(lease))
+	if ctx.KVStore(k.skey).Has(leaseKey(lease.ID())) {
+		return types.ErrLeaseExists
+	}
+
+	k.updateLease(ctx, lease)
 	ctx.Logger().Info("created lease", "lease", lease.ID())
 	ctx.EventManager().EmitEvent(
 		types.EventLeaseCreated{
@@ -116,35 +156,63 @@ func (k Keeper) CreateLease(ctx sdk.Context, bid types.Bid) {
 			Price: lease.Price,
 		}.ToSDKEvent(),
 	)
+	return nil
 }
 
 // OnOrderMatched updates order state to matched
-func (k Keeper) OnOrderMatched(ctx sdk.Context, order types.Order) {
-	// TODO: assert state transition
+func (k Keeper) OnOrderMatched(ctx sdk.Context, order types.Order) error {
+	if err := order.State.ValidateTransition(types.OrderMatched); err != nil {
+		return err
+	}
 	order.State = types.OrderMatched
 	k.updateOrder(ctx, order)
+	ctx.EventManager().EmitEvent(
+		types.EventOrderMatched{ID: order.ID()}.ToSDKEvent(),
+	)
+	return nil
 }
 
 // OnBidMatched updates bid state to matched
-func (k Keeper) OnBidMatched(ctx sdk.Context, bid types.Bid) {
-	// TODO: assert state transition
+func (k Keeper) OnBidMatched(ctx sdk.Context, bid types.Bid) error {
+	if err := bid.State.ValidateTransition(types.BidMatched); err != nil {
+		return err
+	}
 	bid.State = types.BidMatched
 	k.updateBid(ctx, bid)
+	ctx.EventManager().EmitEvent(
+		types.EventBidMatched{
+			ID:    bid.ID(),
+			Price: bid.Price,
+		}.ToSDKEvent(),
+	)
+	return nil
 }
 
 // OnBidLost updates bid state to bid lost
-func (k Keeper) OnBidLost(ctx sdk.Context, bid types.Bid) {
-	// TODO: assert state transition
+func (k Keeper) OnBidLost(ctx sdk.Context, bid types.Bid) error {
+	if err := bid.State.ValidateTransition(types.BidLost); err != nil {
+		return err
+	}
 	bid.State = types.BidLost
 	k.updateBid(ctx, bid)
+	ctx.EventManager().EmitEvent(
+		types.EventBidLost{
+			ID:    bid.ID(),
+			Price: bid.Price,
+		}.ToSDKEvent(),
+	)
+	return nil
 }
 
-// OnBidClosed updates bid state to closed
-func (k Keeper) OnBidClosed(ctx sdk.Context, bid types.Bid) {
-	// TODO: assert state transition
+// OnBidClosed updates bid state to closed. Bids that were lost or closed
+// already are left as they are.
+func (k Keeper) OnBidClosed(ctx sdk.Context, bid types.Bid) error {
 	switch bid.State {
 	case types.BidClosed, types.BidLost:
-		return
+		return nil
+	}
+	if err := bid.State.ValidateTransition(types.BidClosed); err != nil {
+		return err
 	}
 	bid.State = types.BidClosed
 	k.updateBid(ctx, bid)
@@ -154,28 +222,32 @@ func (k Keeper) OnBidClosed(ctx sdk.Context, bid types.Bid) {
 			Price: bid.Price,
 		}.ToSDKEvent(),
 	)
+	return nil
 }
 
-// OnOrderClosed updates order state to closed
-func (k Keeper) OnOrderClosed(ctx sdk.Context, order types.Order) {
-	// TODO: assert state transition
+// OnOrderClosed updates order state to closed. Closed orders are left as they are.
+func (k Keeper) OnOrderClosed(ctx sdk.Context, order types.Order) error {
 	if order.State == types.OrderClosed {
-		return
+		return nil
+	}
+	if err := order.State.ValidateTransition(types.OrderClosed); err != nil {
+		return err
 	}
-
 	order.State = types.OrderClosed
 	k.updateOrder(ctx, order)
 	ctx.EventManager().EmitEvent(
 		types.EventOrderClosed{ID: order.ID()}.ToSDKEvent(),
 	)
+	return nil
 }
 
 // OnInsufficientFunds updates lease state to insufficient funds
-func (k Keeper) OnInsufficientFunds(ctx sdk.Context, lease types.Lease) {
-	// TODO: assert state transition
-	switch lease.State {
-	case types.LeaseClosed, types.LeaseInsufficientFunds:
-		return
+func (k Keeper) OnInsufficientFunds(ctx sdk.Context, lease types.Lease) error {
+	if lease.State == types.LeaseInsufficientFunds {
+		return nil
+	}
+	if err := lease.State.ValidateTransition(types.LeaseInsufficientFunds); err != nil {
+		return err
 	}
 	lease.State = types.LeaseInsufficientFunds
 	k.updateLease(ctx, lease)
@@ -185,40 +257,183 @@ func (k Keeper) OnInsufficientFunds(ctx sdk.Context, lease types.Lease) {
 			Price: lease.Price,
 		}.ToSDKEvent(),
 	)
+	return nil
 }
 
 // OnLeaseClosed updates lease state to closed
-func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease) {
-	// TODO: assert state transition
+func (k Keeper) OnLeaseClosed(ctx sdk.Context, lease types.Lease) error {
+	return k.OnLeaseClosedWithReason(ctx, lease, "")
+}
+
+// OnLeaseClosedWithReason updates lease state to closed and records why it was closed
+// in the emitted event. Leases that ran out of funds or were closed already are
+// left as they are.
+func (k Keeper) OnLeaseClosedWithReason(ctx sdk.Context, lease types.Lease, reason string) error {
 	switch lease.State {
 	case types.LeaseClosed, types.LeaseInsufficientFunds:
-		return
+		return nil
+	}
+	if err := lease.State.ValidateTransition(types.LeaseClosed); err != nil {
+		return err
 	}
 	lease.State = types.LeaseClosed
 	k.updateLease(ctx, lease)
-	ctx.Logger().Info("closed lease", "lease", lease.ID())
+	ctx.Logger().Info("closed lease", "lease", lease.ID(), "reason", reason)
 	ctx.EventManager().EmitEvent(
 		types.EventLeaseClosed{
-			ID:    lease.ID(),
-			Price: lease.Price,
+			ID:     lease.ID(),
+			Price:  lease.Price,
+			Reason: reason,
 		}.ToSDKEvent(),
 	)
+	return nil
 }
 
 // OnGroupClosed updates state of all orders, bids and leases in group to closed
-func (k Keeper) OnGroupClosed(ctx sdk.Context, id dtypes.GroupID) {
+func (k Keeper) OnGroupClosed(ctx sdk.Context, id dtypes.GroupID) error {
+	return k.closeGroup(ctx, id, "")
+}
+
+// OnGroupPaused updates state of all orders, bids and leases in group to closed,
+// recording that the group was paused as the reason its leases were closed
+func (k Keeper) OnGroupPaused(ctx sdk.Context, id dtypes.GroupID) error {
+	return k.closeGroup(ctx, id, types.LeaseClosedReasonGroupPaused)
+}
+
+func (k Keeper) closeGroup(ctx sdk.Context, id dtypes.GroupID, reason string) error {
+	var err error
 	k.WithOrdersForGroup(ctx, id, func(order types.Order) bool {
-		k.OnOrderClosed(ctx, order)
+		if err = k.OnOrderClosed(ctx, order); err != nil {
+			return true
+		}
 		k.WithBidsForOrder(ctx, order.ID(), func(bid types.Bid) bool {
-			k.OnBidClosed(ctx, bid)
+			if err = k.OnBidClosed(ctx, bid); err != nil {
+				return true
+			}
 			if lease, ok := k.GetLease(ctx, types.LeaseID(bid.ID())); ok {
-				// TODO: emit events
-				k.OnLeaseClosed(ctx, lease)
+				err = k.OnLeaseClosedWithReason(ctx, lease, reason)
 			}
-			return false
+			return err != nil
 		})
+		return err != nil
+	})
+	return err
+}
+
+// OnGroupUpdated moves the market of the group to its updated specifications. Open orders
+// are replaced and active leases are amended.
+func (k Keeper) OnGroupUpdated(ctx sdk.Context, group dtypes.Group) error {
+	var (
+		open  []types.Order
+		lease *types.Lease
+	)
+	k.WithOrdersForGroup(ctx, group.ID(), func(order types.Order) bool {
+		switch order.State {
+		case types.OrderOpen:
+			open = append(open, order)
+		case types.OrderMatched:
+			if val, ok := k.LeaseForOrder(ctx, order.ID()); ok && val.State == types.LeaseActive {
+				lease = &val
+			}
+		}
 		return false
 	})
+
+	for _, order := range open {
+		if err := k.closeOrder(ctx, order); err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case lease != nil:
+		_, err := k.CreateAmendment(ctx, *lease, group.GroupSpec)
+		return err
+	case len(open) > 0 && !open[0].IsAmendment():
+		_, err := k.CreateOrder(ctx, group.ID(), group.GroupSpec)
+		return err
+	}
+	return nil
+}
+
+// OnLeaseAmended updates the lease and its order to the price of the winning bid of the
+// amendment order and the specifications it was placed for, then closes the amendment order
+func (k Keeper) OnLeaseAmended(ctx sdk.Context, lease types.Lease, amendment types.Order, bid types.Bid) error {
+	order, ok := k.GetOrder(ctx, lease.OrderID())
+	if !ok {
+		return types.ErrUnknownOrder
+	}
+
+	if err := k.OnBidMatched(ctx, bid); err != nil {
+		return err
+	}
+
+	lease.Price = bid.Price
+	k.updateLease(ctx, lease)
+
+	order.Spec = amendment.Spec
+	k.updateOrder(ctx, order)
+
+	ctx.Logger().Info("amended lease", "lease", lease.ID(), "order", amendment.ID())
+	ctx.EventManager().EmitEvent(
+		types.EventLeaseAmended{
+			ID:    lease.ID(),
+			Price: lease.Price,
+		}.ToSDKEvent(),
+	)
+
+	return k.OnOrderClosed(ctx, amendment)
+}
+
+// OnDeploymentTransferred moves the orders, bids and leases of the deployment with given ID to owner
+func (k Keeper) OnDeploymentTransferred(ctx sdk.Context, id dtypes.DeploymentID, owner sdk.AccAddress) error {
+	store := ctx.KVStore(k.skey)
+
+	var (
+		orders []types.Order
+		bids   []types.Bid
+		leases []types.Lease
+	)
+	k.withPrefix(ctx, deploymentPrefix(orderPrefix, id), func(buf []byte) {
+		var val types.Order
+		k.cdc.MustUnmarshalBinaryBare(buf, &val)
+		orders = append(orders, val)
+	})
+	k.withPrefix(ctx, deploymentPrefix(bidPrefix, id), func(buf []byte) {
+		var val types.Bid
+		k.cdc.MustUnmarshalBinaryBare(buf, &val)
+		bids = append(bids, val)
+	})
+	k.withPrefix(ctx, deploymentPrefix(leasePrefix, id), func(buf []byte) {
+		var val types.Lease
+		k.cdc.MustUnmarshalBinaryBare(buf, &val)
+		leases = append(leases, val)
+	})
+
+	for _, order := range orders {
+		key := orderKey(order.ID())
+		store.Delete(key)
+		store.Delete(indexKey(stateIndexPrefix(orderStateIndexPrefix, uint8(order.State)), key))
+		order.Owner = owner
+		k.updateOrder(ctx, order)
+	}
+	for _, bid := range bids {
+		key := bidKey(bid.ID())
+		store.Delete(key)
+		store.Delete(indexKey(stateIndexPrefix(bidStateIndexPrefix, uint8(bid.State)), key))
+		store.Delete(providerIndexKey(bidProviderIndexPrefix, bid.Provider, key))
+		bid.Owner = owner
+		k.updateBid(ctx, bid)
+	}
+	for _, lease := range leases {
+		key := leaseKey(lease.ID())
+		store.Delete(key)
+		store.Delete(indexKey(stateIndexPrefix(leaseStateIndexPrefix, uint8(lease.State)), key))
+		store.Delete(providerIndexKey(leaseProviderIndexPrefix, lease.Provider, key))
+		lease.Owner = owner
+		k.updateLease(ctx, lease)
+	}
+	return nil
 }
 
 // GetOrder returns order with given orderID from market store
@@ -352,20 +567,151 @@ func (k Keeper) WithBidsForOrder(ctx sdk.Context, id types.OrderID, fn func(type
 	}
 }
 
+// PaginateOrders iterates a page of orders, walking the state index when filtering
+// by state and the owner prefix otherwise. fn reports whether the order matches.
+func (k Keeper) PaginateOrders(ctx sdk.Context, owner sdk.AccAddress, state types.OrderState, byState bool,
+	page sdkutil.PageRequest, fn func(types.Order) bool) (sdkutil.PageResponse, error) {
+	pfx, indexed := orderPrefix, false
+	if byState {
+		pfx, indexed = stateIndexPrefix(orderStateIndexPrefix, uint8(state)), true
+	}
+	if !owner.Empty() {
+		pfx = ownerPrefix(pfx, owner)
+	}
+
+	return k.paginate(ctx, pfx, indexed, page, func(buf []byte) bool {
+		var val types.Order
+		k.cdc.MustUnmarshalBinaryBare(buf, &val)
+		return fn(val)
+	})
+}
+
+// PaginateBids iterates a page of bids, walking the provider index when filtering
+// by provider, the state index when filtering by state and the owner prefix otherwise.
+// fn reports whether the bid matches.
+func (k Keeper) PaginateBids(ctx sdk.Context, owner, provider sdk.AccAddress, state types.BidState, byState bool,
+	page sdkutil.PageRequest, fn func(types.Bid) bool) (sdkutil.PageResponse, error) {
+	pfx, indexed := bidPrefix, false
+	switch {
+	case !provider.Empty():
+		pfx, indexed = providerIndexPrefix(bidProviderIndexPrefix, provider), true
+	case byState:
+		pfx, indexed = stateIndexPrefix(bidStateIndexPrefix, uint8(state)), true
+		if !owner.Empty() {
+			pfx = ownerPrefix(pfx, owner)
+		}
+	case !owner.Empty():
+		pfx = ownerPrefix(pfx, owner)
+	}
+
+	return k.paginate(ctx, pfx, indexed, page, func(buf []byte) bool {
+		var val types.Bid
+		k.cdc.MustUnmarshalBinaryBare(buf, &val)
+		return fn(val)
+	})
+}
+
+// PaginateLeases iterates a page of leases, walking the provider index when filtering
+// by provider, the state index when filtering by state and the owner prefix otherwise.
+// fn reports whether the lease matches.
+func (k Keeper) PaginateLeases(ctx sdk.Context, owner, provider sdk.AccAddress, state types.LeaseState, byState bool,
+	page sdkutil.PageRequest, fn func(types.Lease) bool) (sdkutil.PageResponse, error) {
+	pfx, indexed := leasePrefix, false
+	switch {
+	case !provider.Empty():
+		pfx, indexed = providerIndexPrefix(leaseProviderIndexPrefix, provider), true
+	case byState:
+		pfx, indexed = stateIndexPrefix(leaseStateIndexPrefix, uint8(state)), true
+		if !owner.Empty() {
+			pfx = ownerPrefix(pfx, owner)
+		}
+	case !owner.Empty():
+		pfx = ownerPrefix(pfx, owner)
+	}
+
+	return k.paginate(ctx, pfx, indexed, page, func(buf []byte) bool {
+		var val types.Lease
+		k.cdc.MustUnmarshalBinaryBare(buf, &val)
+		return fn(val)
+	})
+}
+
+// paginate walks pfx; entries of indexed prefixes hold the primary key of the record.
+func (k Keeper) paginate(ctx sdk.Context, pfx []byte, indexed bool,
+	page sdkutil.PageRequest, fn func([]byte) bool) (sdkutil.PageResponse, error) {
+	store := ctx.KVStore(k.skey)
+	return sdkutil.Paginate(store, pfx, page, func(_, value []byte) (bool, error) {
+		if indexed {
+			value = store.Get(value)
+		}
+		return fn(value), nil
+	})
+}
+
+// closeOrder closes the order along with its bids
+func (k Keeper) closeOrder(ctx sdk.Context, order types.Order) error {
+	if err := k.OnOrderClosed(ctx, order); err != nil {
+		return err
+	}
+	var err error
+	k.WithBidsForOrder(ctx, order.ID(), func(bid types.Bid) bool {
+		err = k.OnBidClosed(ctx, bid)
+		return err != nil
+	})
+	return err
+}
+
+// withPrefix calls fn with the value of every record under pfx. Records to be
+// rewritten are collected by fn and written after it returns, as the store must
+// not be written to while it is iterated.
+func (k Keeper) withPrefix(ctx sdk.Context, pfx []byte, fn func([]byte)) {
+	iter := sdk.KVStorePrefixIterator(ctx.KVStore(k.skey), pfx)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		fn(iter.Value())
+	}
+}
+
 func (k Keeper) updateOrder(ctx sdk.Context, order types.Order) {
 	store := ctx.KVStore(k.skey)
 	key := orderKey(order.ID())
+
+	if buf := store.Get(key); buf != nil {
+		var prev types.Order
+		k.cdc.MustUnmarshalBinaryBare(buf, &prev)
+		store.Delete(indexKey(stateIndexPrefix(orderStateIndexPrefix, uint8(prev.State)), key))
+	}
+
 	store.Set(key, k.cdc.MustMarshalBinaryBare(order))
+	store.Set(indexKey(stateIndexPrefix(orderStateIndexPrefix, uint8(order.State)), key), key)
 }
 
 func (k Keeper) updateBid(ctx sdk.Context, bid types.Bid) {
 	store := ctx.KVStore(k.skey)
 	key := bidKey(bid.ID())
+
+	if buf := store.Get(key); buf != nil {
+		var prev types.Bid
+		k.cdc.MustUnmarshalBinaryBare(buf, &prev)
+		store.Delete(indexKey(stateIndexPrefix(bidStateIndexPrefix, uint8(prev.State)), key))
+	}
+
 	store.Set(key, k.cdc.MustMarshalBinaryBare(bid))
+	store.Set(indexKey(stateIndexPrefix(bidStateIndexPrefix, uint8(bid.State)), key), key)
+	store.Set(providerIndexKey(bidProviderIndexPrefix, bid.Provider, key), key)
 }
 
 func (k Keeper) updateLease(ctx sdk.Context, lease types.Lease) {
 	store := ctx.KVStore(k.skey)
 	key := leaseKey(lease.ID())
+
+	if buf := store.Get(key); buf != nil {
+		var prev types.Lease
+		k.cdc.MustUnmarshalBinaryBare(buf, &prev)
+		store.Delete(indexKey(stateIndexPrefix(leaseStateIndexPrefix, uint8(prev.State)), key))
+	}
+
 	store.Set(key, k.cdc.MustMarshalBinaryBare(lease))
+	store.Set(indexKey(stateIndexPrefix(leaseStateIndexPrefix, uint8(lease.State)), key), key)
+	store.Set(providerIndexKey(leaseProviderIndexPrefix, lease.Provider, key), key)
 }
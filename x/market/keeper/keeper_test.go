@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/testutil"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/keeper"
@@ -182,6 +183,7 @@ func Test_OnOrderMatched(t *testing.T) {
 	result, ok := keeper.GetOrder(ctx, id.OrderID())
 	require.True(t, ok)
 	assert.Equal(t, types.OrderMatched, result.State)
+	assert.Equal(t, types.EventOrderMatched{ID: id.OrderID()}, lastEvent(t, ctx))
 }
 
 func Test_OnBidMatched(t *testing.T) {
@@ -201,6 +203,7 @@ func Test_OnBidLost(t *testing.T) {
 	result, ok := keeper.GetBid(ctx, bid.ID())
 	require.True(t, ok)
 	assert.Equal(t, types.BidLost, result.State)
+	assert.Equal(t, types.EventBidLost{ID: bid.ID(), Price: bid.Price}, lastEvent(t, ctx))
 }
 
 func Test_OnOrderClosed(t *testing.T) {
@@ -261,6 +264,252 @@ func Test_OnGroupClosed(t *testing.T) {
 	assert.Equal(t, types.OrderClosed, order.State)
 }
 
+func Test_InvalidTransitions(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+
+	lease, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	bid, ok := keeper.GetBid(ctx, id.BidID())
+	require.True(t, ok)
+	order, ok := keeper.GetOrder(ctx, id.OrderID())
+	require.True(t, ok)
+
+	assert.True(t, types.ErrLeaseExists.Is(keeper.CreateLease(ctx, bid)))
+	assert.True(t, types.ErrInvalidStateTransition.Is(keeper.OnBidLost(ctx, bid)))
+	assert.True(t, types.ErrInvalidStateTransition.Is(keeper.OnOrderMatched(ctx, order)))
+
+	require.NoError(t, keeper.OnLeaseClosed(ctx, lease))
+	lease, ok = keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	assert.True(t, types.ErrInvalidStateTransition.Is(keeper.OnInsufficientFunds(ctx, lease)))
+
+	// closing again is a no-op
+	events := len(ctx.EventManager().Events())
+	require.NoError(t, keeper.OnLeaseClosed(ctx, lease))
+	assert.Len(t, ctx.EventManager().Events(), events)
+}
+
+func Test_PaginateOrders(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	for i := 0; i < 3; i++ {
+		createOrder(t, ctx, keeper)
+	}
+	closed, _ := createOrder(t, ctx, keeper)
+	keeper.OnOrderClosed(ctx, closed)
+
+	var count int
+	page, err := keeper.PaginateOrders(ctx, nil, types.OrderOpen, true, sdkutil.PageRequest{Limit: 2},
+		func(order types.Order) bool {
+			assert.Equal(t, types.OrderOpen, order.State)
+			count++
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+	require.NotEmpty(t, page.NextKey)
+
+	page, err = keeper.PaginateOrders(ctx, nil, types.OrderOpen, true,
+		sdkutil.PageRequest{Key: page.NextKey, Limit: 2}, func(types.Order) bool {
+			count++
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 3, count)
+	assert.Empty(t, page.NextKey)
+
+	// state index follows state transitions
+	count = 0
+	_, err = keeper.PaginateOrders(ctx, nil, types.OrderClosed, true, sdkutil.PageRequest{},
+		func(order types.Order) bool {
+			assert.Equal(t, closed.ID(), order.ID())
+			count++
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	count = 0
+	_, err = keeper.PaginateOrders(ctx, closed.Owner, 0, false, sdkutil.PageRequest{},
+		func(types.Order) bool {
+			count++
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func Test_PaginateLeasesByProvider(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+	createLease(t, ctx, keeper)
+
+	var leases []types.Lease
+	_, err := keeper.PaginateLeases(ctx, nil, id.Provider, 0, false, sdkutil.PageRequest{},
+		func(lease types.Lease) bool {
+			leases = append(leases, lease)
+			return true
+		})
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	assert.Equal(t, id, leases[0].ID())
+
+	var bids []types.Bid
+	_, err = keeper.PaginateBids(ctx, nil, id.Provider, 0, false, sdkutil.PageRequest{},
+		func(bid types.Bid) bool {
+			bids = append(bids, bid)
+			return true
+		})
+	require.NoError(t, err)
+	require.Len(t, bids, 1)
+	assert.Equal(t, id.BidID(), bids[0].ID())
+}
+
+func Test_OnDeploymentTransferred(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+	other := createLease(t, ctx, keeper)
+	owner := testutil.AccAddress(t)
+
+	require.NoError(t, keeper.OnDeploymentTransferred(ctx, id.DeploymentID(), owner))
+
+	_, ok := keeper.GetLease(ctx, id)
+	require.False(t, ok)
+	_, ok = keeper.GetOrder(ctx, id.OrderID())
+	require.False(t, ok)
+
+	id.Owner = owner
+	_, ok = keeper.GetOrder(ctx, id.OrderID())
+	require.True(t, ok)
+	_, ok = keeper.GetBid(ctx, id.BidID())
+	require.True(t, ok)
+	lease, ok := keeper.LeaseForOrder(ctx, id.OrderID())
+	require.True(t, ok)
+	assert.Equal(t, id, lease.ID())
+
+	_, ok = keeper.GetLease(ctx, other)
+	require.True(t, ok)
+
+	var leases []types.Lease
+	_, err := keeper.PaginateLeases(ctx, nil, id.Provider, 0, false, sdkutil.PageRequest{},
+		func(lease types.Lease) bool {
+			leases = append(leases, lease)
+			return true
+		})
+	require.NoError(t, err)
+	require.Len(t, leases, 1)
+	assert.Equal(t, id, leases[0].ID())
+}
+
+func Test_OnGroupUpdated(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	// open orders are replaced
+	order, gspec := createOrder(t, ctx, keeper)
+	group := dtypes.Group{GroupID: order.GroupID(), State: dtypes.GroupOrdered, GroupSpec: gspec}
+	group.Resources[0].Count++
+
+	require.NoError(t, keeper.OnGroupUpdated(ctx, group))
+
+	prev, ok := keeper.GetOrder(ctx, order.ID())
+	require.True(t, ok)
+	assert.Equal(t, types.OrderClosed, prev.State)
+
+	next, ok := keeper.GetOrder(ctx, types.MakeOrderID(order.GroupID(), 2))
+	require.True(t, ok)
+	assert.Equal(t, types.OrderOpen, next.State)
+	assert.Equal(t, group.GroupSpec, next.Spec)
+	assert.False(t, next.IsAmendment())
+
+	// active leases are amended
+	id := createLease(t, ctx, keeper)
+	lorder, ok := keeper.GetOrder(ctx, id.OrderID())
+	require.True(t, ok)
+	group = dtypes.Group{GroupID: id.GroupID(), State: dtypes.GroupMatched, GroupSpec: lorder.Spec}
+	group.Resources[0].Count++
+
+	require.NoError(t, keeper.OnGroupUpdated(ctx, group))
+
+	amendment, ok := keeper.GetOrder(ctx, types.MakeOrderID(id.GroupID(), 2))
+	require.True(t, ok)
+	assert.Equal(t, types.OrderOpen, amendment.State)
+	require.True(t, amendment.IsAmendment())
+	assert.Equal(t, id, *amendment.Amends)
+
+	// an open amendment is superseded
+	require.NoError(t, keeper.OnGroupUpdated(ctx, group))
+
+	amendment, ok = keeper.GetOrder(ctx, amendment.ID())
+	require.True(t, ok)
+	assert.Equal(t, types.OrderClosed, amendment.State)
+
+	next, ok = keeper.GetOrder(ctx, types.MakeOrderID(id.GroupID(), 3))
+	require.True(t, ok)
+	assert.Equal(t, types.OrderOpen, next.State)
+	assert.Equal(t, id, *next.Amends)
+}
+
+func Test_OnLeaseAmended(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	id := createLease(t, ctx, keeper)
+
+	lease, ok := keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	order, ok := keeper.GetOrder(ctx, id.OrderID())
+	require.True(t, ok)
+
+	spec := order.Spec
+	spec.Resources = append([]dtypes.Resource{}, spec.Resources...)
+	spec.Resources[0].Count++
+
+	amendment, err := keeper.CreateAmendment(ctx, lease, spec)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(2), amendment.ID().OSeq)
+
+	// one open order per group
+	_, err = keeper.CreateAmendment(ctx, lease, spec)
+	require.Error(t, err)
+
+	price := lease.Price.Add(sdk.NewCoin(lease.Price.Denom, sdk.NewInt(1)))
+	bid, err := keeper.CreateBid(ctx, amendment.ID(), id.Provider, price)
+	require.NoError(t, err)
+
+	require.NoError(t, keeper.OnLeaseAmended(ctx, lease, amendment, bid))
+
+	lease, ok = keeper.GetLease(ctx, id)
+	require.True(t, ok)
+	assert.Equal(t, types.LeaseActive, lease.State)
+	assert.Equal(t, price, lease.Price)
+
+	order, ok = keeper.GetOrder(ctx, id.OrderID())
+	require.True(t, ok)
+	assert.Equal(t, types.OrderMatched, order.State)
+	assert.Equal(t, spec, order.Spec)
+
+	amendment, ok = keeper.GetOrder(ctx, amendment.ID())
+	require.True(t, ok)
+	assert.Equal(t, types.OrderClosed, amendment.State)
+
+	// the amendment order is closed after the lease is amended
+	events := ctx.EventManager().ABCIEvents()
+	ev, err := sdkutil.ParseEvent(sdk.StringifyEvent(events[len(events)-2]))
+	require.NoError(t, err)
+	mev, err := types.ParseEvent(ev)
+	require.NoError(t, err)
+	assert.Equal(t, types.EventLeaseAmended{ID: id, Price: price}, mev)
+}
+
+func lastEvent(t testing.TB, ctx sdk.Context) sdkutil.ModuleEvent {
+	t.Helper()
+	events := ctx.EventManager().ABCIEvents()
+	require.NotEmpty(t, events)
+	ev, err := sdkutil.ParseEvent(sdk.StringifyEvent(events[len(events)-1]))
+	require.NoError(t, err)
+	mev, err := types.ParseEvent(ev)
+	require.NoError(t, err)
+	return mev
+}
+
 func createLease(t testing.TB, ctx sdk.Context, keeper keeper.Keeper) types.LeaseID {
 	t.Helper()
 	bid, order := createBid(t, ctx, keeper)
@@ -298,6 +547,14 @@ func createOrder(t testing.TB, ctx sdk.Context, keeper keeper.Keeper) (types.Ord
 }
 
 func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	ctx, keeper, _ := setupKeeperWithStore(t)
+	return ctx, keeper
+}
+
+// setupKeeperWithStore also returns the keeper's store key, for tests that
+// write the store directly
+func setupKeeperWithStore(t testing.TB) (sdk.Context, keeper.Keeper, sdk.StoreKey) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
 	db := dbm.NewMemDB()
@@ -305,5 +562,5 @@ func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
 	ms.LoadLatestVersion()
 	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(app.MakeCodec(), key)
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key), key
 }
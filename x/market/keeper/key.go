@@ -4,14 +4,27 @@ import (
 	"bytes"
 	"encoding/binary"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	"github.com/ovrclk/akash/x/market/types"
 )
 
 var (
+	// storeVersionKey holds the version of the store format
+	storeVersionKey = []byte{0x00}
+
 	orderPrefix = []byte{0x01, 0x00}
 	bidPrefix   = []byte{0x02, 0x00}
 	leasePrefix = []byte{0x03, 0x00}
+
+	// secondary indexes. keys are the index prefix, the indexed value and
+	// the primary key suffix; values are the primary key.
+	orderStateIndexPrefix    = []byte{0x01, 0x01}
+	bidStateIndexPrefix      = []byte{0x02, 0x01}
+	bidProviderIndexPrefix   = []byte{0x02, 0x02}
+	leaseStateIndexPrefix    = []byte{0x03, 0x01}
+	leaseProviderIndexPrefix = []byte{0x03, 0x02}
 )
 
 func orderKey(id types.OrderID) []byte {
@@ -43,6 +56,13 @@ func leaseKey(id types.LeaseID) []byte {
 	return buf.Bytes()
 }
 
+func deploymentPrefix(pfx []byte, id dtypes.DeploymentID) []byte {
+	buf := bytes.NewBuffer(append([]byte{}, pfx...))
+	buf.Write(id.Owner.Bytes())
+	binary.Write(buf, binary.BigEndian, id.DSeq)
+	return buf.Bytes()
+}
+
 func ordersForGroupPrefix(id dtypes.GroupID) []byte {
 	buf := bytes.NewBuffer(orderPrefix)
 	buf.Write(id.Owner.Bytes())
@@ -59,3 +79,27 @@ func bidsForOrderPrefix(id types.OrderID) []byte {
 	binary.Write(buf, binary.BigEndian, id.OSeq)
 	return buf.Bytes()
 }
+
+func ownerPrefix(pfx []byte, owner sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer(append([]byte{}, pfx...))
+	buf.Write(owner.Bytes())
+	return buf.Bytes()
+}
+
+func stateIndexPrefix(pfx []byte, state uint8) []byte {
+	return append(append([]byte{}, pfx...), state)
+}
+
+func providerIndexPrefix(pfx []byte, provider sdk.AccAddress) []byte {
+	return ownerPrefix(pfx, provider)
+}
+
+func providerIndexKey(pfx []byte, provider sdk.AccAddress, primary []byte) []byte {
+	return indexKey(providerIndexPrefix(pfx, provider), primary)
+}
+
+// indexKey returns the index entry key for the given primary key
+func indexKey(indexPrefix []byte, primary []byte) []byte {
+	// primary keys share a two byte table prefix
+	return append(append([]byte{}, indexPrefix...), primary[2:]...)
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/market/types"
+)
+
+// StoreVersion returns the version of the market store format
+func (k Keeper) StoreVersion(ctx sdk.Context) uint64 {
+	return sdkutil.GetStoreVersion(ctx.KVStore(k.skey), storeVersionKey)
+}
+
+// SetStoreVersion records the version of the market store format
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	sdkutil.SetStoreVersion(ctx.KVStore(k.skey), storeVersionKey, version)
+}
+
+// RegisterMigrations registers the market store and its migrations with m
+func RegisterMigrations(m *sdkutil.Migrator, k Keeper) error {
+	m.RegisterModule(types.ModuleName, k, types.ConsensusVersion)
+	return m.RegisterMigration(types.ModuleName, 1, k.migrateV1)
+}
+
+// migrateV1 builds the state and provider indexes of orders, bids and leases,
+// which version 1 stores do not have
+func (k Keeper) migrateV1(ctx sdk.Context) error {
+	var (
+		orders []types.Order
+		bids   []types.Bid
+		leases []types.Lease
+	)
+	k.WithOrders(ctx, func(order types.Order) bool {
+		orders = append(orders, order)
+		return false
+	})
+	k.WithBids(ctx, func(bid types.Bid) bool {
+		bids = append(bids, bid)
+		return false
+	})
+	k.WithLeases(ctx, func(lease types.Lease) bool {
+		leases = append(leases, lease)
+		return false
+	})
+
+	for _, order := range orders {
+		k.updateOrder(ctx, order)
+	}
+	for _, bid := range bids {
+		k.updateBid(ctx, bid)
+	}
+	for _, lease := range leases {
+		k.updateLease(ctx, lease)
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package keeper_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/market/keeper"
+	"github.com/ovrclk/akash/x/market/types"
+)
+
+func Test_MigrateV1(t *testing.T) {
+	ctx, mkeeper, key := setupKeeperWithStore(t)
+	cdc := mkeeper.Codec()
+	price := sdk.NewInt64Coin("foo", 10)
+
+	id := testutil.LeaseID(t)
+	matched := types.Order{OrderID: id.OrderID(), State: types.OrderMatched}
+	open := types.Order{OrderID: testutil.OrderID(t), State: types.OrderOpen}
+	bid := types.Bid{BidID: id.BidID(), State: types.BidMatched, Price: price}
+	lease := types.Lease{LeaseID: id, State: types.LeaseActive, Price: price}
+
+	// version 1 stores hold only the orders, bids and leases themselves.
+	store := ctx.KVStore(key)
+	orderKey := v1Key(0x01, id.Owner, id.DSeq, id.GSeq, id.OSeq, nil)
+	openKey := v1Key(0x01, open.Owner, open.DSeq, open.GSeq, open.OSeq, nil)
+	bidKey := v1Key(0x02, id.Owner, id.DSeq, id.GSeq, id.OSeq, id.Provider)
+	leaseKey := v1Key(0x03, id.Owner, id.DSeq, id.GSeq, id.OSeq, id.Provider)
+	store.Set(orderKey, cdc.MustMarshalBinaryBare(matched))
+	store.Set(openKey, cdc.MustMarshalBinaryBare(open))
+	store.Set(bidKey, cdc.MustMarshalBinaryBare(bid))
+	store.Set(leaseKey, cdc.MustMarshalBinaryBare(lease))
+	require.Equal(t, sdkutil.InitialStoreVersion, mkeeper.StoreVersion(ctx))
+
+	m := sdkutil.NewMigrator()
+	require.NoError(t, keeper.RegisterMigrations(m, mkeeper))
+	require.NoError(t, m.Migrate(ctx))
+	assert.Equal(t, types.ConsensusVersion, mkeeper.StoreVersion(ctx))
+
+	// records keep their keys and gain state and provider index entries
+	// holding their primary key.
+	assert.Equal(t, cdc.MustMarshalBinaryBare(matched), store.Get(orderKey))
+	assert.Equal(t, cdc.MustMarshalBinaryBare(open), store.Get(openKey))
+	assert.Equal(t, cdc.MustMarshalBinaryBare(bid), store.Get(bidKey))
+	assert.Equal(t, cdc.MustMarshalBinaryBare(lease), store.Get(leaseKey))
+
+	indexes := map[string][]byte{
+		string(v1Index([]byte{0x01, 0x01, byte(types.OrderMatched)}, orderKey)): orderKey,
+		string(v1Index([]byte{0x01, 0x01, byte(types.OrderOpen)}, openKey)):     openKey,
+		string(v1Index([]byte{0x02, 0x01, byte(types.BidMatched)}, bidKey)):     bidKey,
+		string(v1Index(append([]byte{0x02, 0x02}, id.Provider...), bidKey)):     bidKey,
+		string(v1Index([]byte{0x03, 0x01, byte(types.LeaseActive)}, leaseKey)):  leaseKey,
+		string(v1Index(append([]byte{0x03, 0x02}, id.Provider...), leaseKey)):   leaseKey,
+	}
+	for k, primary := range indexes {
+		assert.Equal(t, primary, store.Get([]byte(k)))
+	}
+
+	var count int
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		count++
+	}
+	iter.Close()
+	// four records, their indexes and the store version.
+	assert.Equal(t, 4+len(indexes)+1, count)
+
+	var orders []types.Order
+	_, err := mkeeper.PaginateOrders(ctx, nil, types.OrderOpen, true, sdkutil.PageRequest{},
+		func(order types.Order) bool {
+			orders = append(orders, order)
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Order{open}, orders)
+
+	var leases []types.Lease
+	_, err = mkeeper.PaginateLeases(ctx, nil, id.Provider, 0, false, sdkutil.PageRequest{},
+		func(lease types.Lease) bool {
+			leases = append(leases, lease)
+			return true
+		})
+	require.NoError(t, err)
+	assert.Equal(t, []types.Lease{lease}, leases)
+
+	// migrating a current store is a no-op.
+	require.NoError(t, m.Migrate(ctx))
+	assert.Equal(t, types.ConsensusVersion, mkeeper.StoreVersion(ctx))
+}
+
+// v1Key returns the version 1 key of an order (with a nil provider), bid or lease
+func v1Key(table byte, owner sdk.AccAddress, dseq uint64, gseq, oseq uint32, provider sdk.AccAddress) []byte {
+	buf := bytes.NewBuffer([]byte{table, 0x00})
+	buf.Write(owner.Bytes())
+	binary.Write(buf, binary.BigEndian, dseq)
+	binary.Write(buf, binary.BigEndian, gseq)
+	binary.Write(buf, binary.BigEndian, oseq)
+	buf.Write(provider.Bytes())
+	return buf.Bytes()
+}
+
+func v1Index(pfx []byte, primary []byte) []byte {
+	return append(append([]byte{}, pfx...), primary[2:]...)
+}
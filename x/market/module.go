@@ -90,6 +90,7 @@ func NewAppModule(
 	keeper keeper.Keeper,
 	dkeeper handler.DeploymentKeeper,
 	pkeeper handler.ProviderKeeper,
+	akeeper handler.AuditKeeper,
 	bkeeper bank.Keeper,
 ) AppModule {
 	return AppModule{
@@ -98,6 +99,7 @@ func NewAppModule(
 			Market:     keeper,
 			Deployment: dkeeper,
 			Provider:   pkeeper,
+			Audit:      akeeper,
 			Bank:       bkeeper,
 		},
 	}
@@ -109,7 +111,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers module invariants
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keepers.Market, am.keepers.Deployment)
+}
 
 // Route returns the message routing key for the market module.
 func (am AppModule) Route() string {
@@ -128,7 +132,7 @@ func (am AppModule) QuerierRoute() string {
 
 // NewQuerierHandler returns the sdk.Querier for market module
 func (am AppModule) NewQuerierHandler() sdk.Querier {
-	return query.NewQuerier(am.keepers.Market)
+	return query.NewQuerier(am.keepers.Market, am.keepers.Provider)
 }
 
 // BeginBlock performs no-op
@@ -7,12 +7,13 @@ import (
 
 // Client interface
 type Client interface {
-	Orders(filters OrderFilters) (Orders, error)
+	Orders(filters OrderFilters) (OrdersResponse, error)
 	Order(id types.OrderID) (Order, error)
-	Bids(filters BidFilters) (Bids, error)
+	Bids(filters BidFilters) (BidsResponse, error)
 	Bid(id types.BidID) (Bid, error)
-	Leases(filters LeaseFilters) (Leases, error)
+	Leases(filters LeaseFilters) (LeasesResponse, error)
 	Lease(id types.LeaseID) (Lease, error)
+	Stats(filters StatsFilters) (MarketStats, error)
 }
 
 // NewClient creates a client instance with provided context and key
@@ -25,8 +26,8 @@ type client struct {
 	key string
 }
 
-func (c *client) Orders(ofilters OrderFilters) (Orders, error) {
-	var obj Orders
+func (c *client) Orders(ofilters OrderFilters) (OrdersResponse, error) {
+	var obj OrdersResponse
 	buf, err := NewRawClient(c.ctx, c.key).Orders(ofilters)
 	if err != nil {
 		return obj, err
@@ -43,8 +44,8 @@ func (c *client) Order(id types.OrderID) (Order, error) {
 	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
 }
 
-func (c *client) Bids(bfilters BidFilters) (Bids, error) {
-	var obj Bids
+func (c *client) Bids(bfilters BidFilters) (BidsResponse, error) {
+	var obj BidsResponse
 	buf, err := NewRawClient(c.ctx, c.key).Bids(bfilters)
 	if err != nil {
 		return obj, err
@@ -61,8 +62,8 @@ func (c *client) Bid(id types.BidID) (Bid, error) {
 	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
 }
 
-func (c *client) Leases(lfilters LeaseFilters) (Leases, error) {
-	var obj Leases
+func (c *client) Leases(lfilters LeaseFilters) (LeasesResponse, error) {
+	var obj LeasesResponse
 	buf, err := NewRawClient(c.ctx, c.key).Leases(lfilters)
 	if err != nil {
 		return obj, err
@@ -78,3 +79,12 @@ func (c *client) Lease(id types.LeaseID) (Lease, error) {
 	}
 	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
 }
+
+func (c *client) Stats(sfilters StatsFilters) (MarketStats, error) {
+	var obj MarketStats
+	buf, err := NewRawClient(c.ctx, c.key).Stats(sfilters)
+	if err != nil {
+		return obj, err
+	}
+	return obj, c.ctx.Codec.UnmarshalJSON(buf, &obj)
+}
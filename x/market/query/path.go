@@ -18,12 +18,16 @@ const (
 	bidPath    = "bid"
 	leasesPath = "leases"
 	leasePath  = "lease"
+	statsPath  = "stats"
 )
 
 var (
 	ErrInvalidPath = errors.New("query: invalid path")
 	ErrOwnerValue  = errors.New("query: invalid owner value")
 	ErrStateValue  = errors.New("query: invalid state value")
+
+	ErrProviderValue = errors.New("query: invalid provider value")
+	ErrDenomValue    = errors.New("query: invalid denom value")
 )
 
 // getOrdersPath returns orders path for queries
@@ -38,7 +42,7 @@ func OrderPath(id types.OrderID) string {
 
 //getBidsPath returns bids path for queries
 func getBidsPath(bfilters BidFilters) string {
-	return fmt.Sprintf("%s/%s/%v", bidsPath, bfilters.Owner, bfilters.StateFlagVal)
+	return fmt.Sprintf("%s/%s/%v/%s", bidsPath, bfilters.Owner, bfilters.StateFlagVal, bfilters.Provider)
 }
 
 // getBidPath return bid path of given bid id for queries
@@ -48,7 +52,7 @@ func getBidPath(id types.BidID) string {
 
 // getLeasesPath returns leases path for queries
 func getLeasesPath(lfilters LeaseFilters) string {
-	return fmt.Sprintf("%s/%s/%v", leasesPath, lfilters.Owner, lfilters.StateFlagVal)
+	return fmt.Sprintf("%s/%s/%v/%s", leasesPath, lfilters.Owner, lfilters.StateFlagVal, lfilters.Provider)
 }
 
 // LeasePath return lease path of given lease id for queries
@@ -56,6 +60,11 @@ func LeasePath(id types.LeaseID) string {
 	return fmt.Sprintf("%s/%s/%s", leasePath, orderParts(id.OrderID()), id.Provider)
 }
 
+// getStatsPath returns market statistics path for queries
+func getStatsPath(sfilters StatsFilters) string {
+	return fmt.Sprintf("%s/%s/%s", statsPath, sfilters.Denom, sfilters.Attribute)
+}
+
 func orderParts(id types.OrderID) string {
 	return fmt.Sprintf("%s/%v/%v/%v", id.Owner, id.DSeq, id.GSeq, id.OSeq)
 }
@@ -151,8 +160,14 @@ func parseBidFiltersPath(parts []string) (BidFilters, bool, error) {
 		return BidFilters{}, false, ErrStateValue
 	}
 
+	provider, err := parseProviderFilter(parts[2:])
+	if err != nil {
+		return BidFilters{}, false, err
+	}
+
 	return BidFilters{
 		Owner:        owner,
+		Provider:     provider,
 		StateFlagVal: parts[1],
 		State:        state,
 	}, ok, nil
@@ -191,9 +206,51 @@ func parseLeaseFiltersPath(parts []string) (LeaseFilters, bool, error) {
 		return LeaseFilters{}, false, ErrStateValue
 	}
 
+	provider, err := parseProviderFilter(parts[2:])
+	if err != nil {
+		return LeaseFilters{}, false, err
+	}
+
 	return LeaseFilters{
 		Owner:        owner,
+		Provider:     provider,
 		StateFlagVal: parts[1],
 		State:        state,
 	}, ok, nil
 }
+
+// parseProviderFilter returns the optional provider filter of bid and lease list queries
+func parseProviderFilter(parts []string) (sdk.AccAddress, error) {
+	if len(parts) == 0 {
+		return sdk.AccAddress{}, nil
+	}
+
+	provider, err := sdk.AccAddressFromBech32(parts[0])
+	if err != nil {
+		return sdk.AccAddress{}, err
+	}
+
+	if !provider.Empty() && sdk.VerifyAddressFormat(provider) != nil {
+		return sdk.AccAddress{}, ErrProviderValue
+	}
+
+	return provider, nil
+}
+
+// parseStatsFiltersPath returns StatsFilters details with provided queries, and return
+// error if occurred due to wrong query
+func parseStatsFiltersPath(parts []string) (StatsFilters, error) {
+	if len(parts) < 1 {
+		return StatsFilters{}, ErrInvalidPath
+	}
+
+	if err := sdk.ValidateDenom(parts[0]); err != nil {
+		return StatsFilters{}, ErrDenomValue
+	}
+
+	filters := StatsFilters{Denom: parts[0]}
+	if len(parts) > 1 {
+		filters.Attribute = parts[1]
+	}
+	return filters, nil
+}
@@ -10,7 +10,7 @@ import (
 )
 
 // NewQuerier creates and returns a new market querier instance
-func NewQuerier(keeper keeper.Keeper) sdk.Querier {
+func NewQuerier(keeper keeper.Keeper, pkeeper ProviderKeeper) sdk.Querier {
 	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, error) {
 		switch path[0] {
 		case ordersPath:
@@ -25,27 +25,42 @@ func NewQuerier(keeper keeper.Keeper) sdk.Querier {
 			return queryLeases(ctx, path[1:], req, keeper)
 		case leasePath:
 			return queryLease(ctx, path[1:], req, keeper)
+		case statsPath:
+			return queryStats(ctx, path[1:], req, keeper, pkeeper)
 		}
 		return []byte{}, sdkerrors.ErrUnknownRequest
 	}
 }
 
-func queryOrders(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+func queryOrders(ctx sdk.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	// isValidState denotes whether given state flag is valid or not
 	filters, isValidState, err := parseOrderFiltersPath(path)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
 	}
 
+	page, err := sdkutil.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	var values Orders
-	keeper.WithOrders(ctx, func(obj types.Order) bool {
-		if filters.Accept(obj, isValidState) {
-			values = append(values, Order(obj))
-		}
+	pagination, err := keeper.PaginateOrders(ctx, filters.Owner, filters.State, isValidState, page,
+		func(obj types.Order) bool {
+			if filters.Accept(obj, isValidState) {
+				values = append(values, Order(obj))
+				return true
+			}
+			return false
+		})
+	if err != nil {
+		return nil, err
+	}
 
-		return false
+	return sdkutil.RenderQueryResponse(keeper.Codec(), OrdersResponse{
+		Orders:     values,
+		Pagination: pagination,
 	})
-	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
 }
 
 func queryOrder(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
@@ -65,20 +80,35 @@ func queryOrder(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keep
 	return sdkutil.RenderQueryResponse(keeper.Codec(), value)
 }
 
-func queryBids(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+func queryBids(ctx sdk.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	// isValidState denotes whether given state flag is valid or not
 	filters, isValidState, err := parseBidFiltersPath(path)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
 	}
+
+	page, err := sdkutil.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	var values Bids
-	keeper.WithBids(ctx, func(obj types.Bid) bool {
-		if filters.Accept(obj, isValidState) {
-			values = append(values, Bid(obj))
-		}
-		return false
+	pagination, err := keeper.PaginateBids(ctx, filters.Owner, filters.Provider, filters.State, isValidState, page,
+		func(obj types.Bid) bool {
+			if filters.Accept(obj, isValidState) {
+				values = append(values, Bid(obj))
+				return true
+			}
+			return false
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkutil.RenderQueryResponse(keeper.Codec(), BidsResponse{
+		Bids:       values,
+		Pagination: pagination,
 	})
-	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
 }
 
 func queryBid(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
@@ -98,20 +128,35 @@ func queryBid(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper
 	return sdkutil.RenderQueryResponse(keeper.Codec(), value)
 }
 
-func queryLeases(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
+func queryLeases(ctx sdk.Context, path []string, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
 	// isValidState denotes whether given state flag is valid or not
 	filters, isValidState, err := parseLeaseFiltersPath(path)
 	if err != nil {
 		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
 	}
+
+	page, err := sdkutil.ParsePageRequest(req.Data)
+	if err != nil {
+		return nil, err
+	}
+
 	var values Leases
-	keeper.WithLeases(ctx, func(obj types.Lease) bool {
-		if filters.Accept(obj, isValidState) {
-			values = append(values, Lease(obj))
-		}
-		return false
+	pagination, err := keeper.PaginateLeases(ctx, filters.Owner, filters.Provider, filters.State, isValidState, page,
+		func(obj types.Lease) bool {
+			if filters.Accept(obj, isValidState) {
+				values = append(values, Lease(obj))
+				return true
+			}
+			return false
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return sdkutil.RenderQueryResponse(keeper.Codec(), LeasesResponse{
+		Leases:     values,
+		Pagination: pagination,
 	})
-	return sdkutil.RenderQueryResponse(keeper.Codec(), values)
 }
 
 func queryLease(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper) ([]byte, error) {
@@ -130,3 +175,18 @@ func queryLease(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keep
 
 	return sdkutil.RenderQueryResponse(keeper.Codec(), value)
 }
+
+func queryStats(ctx sdk.Context, path []string, _ abci.RequestQuery, keeper keeper.Keeper,
+	pkeeper ProviderKeeper) ([]byte, error) {
+	filters, err := parseStatsFiltersPath(path)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	stats, err := computeStats(ctx, keeper, pkeeper, filters)
+	if err != nil {
+		return nil, sdkerrors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	return sdkutil.RenderQueryResponse(keeper.Codec(), stats)
+}
@@ -15,6 +15,7 @@ type RawClient interface {
 	Bid(id types.BidID) ([]byte, error)
 	Leases(filters LeaseFilters) ([]byte, error)
 	Lease(id types.LeaseID) ([]byte, error)
+	Stats(filters StatsFilters) ([]byte, error)
 }
 
 // NewRawClient creates a raw client instance with provided context and key
@@ -28,7 +29,7 @@ type rawclient struct {
 }
 
 func (c *rawclient) Orders(ofilters OrderFilters) ([]byte, error) {
-	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getOrdersPath(ofilters)), nil)
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getOrdersPath(ofilters)), ofilters.Page.Bytes())
 	if err != nil {
 		return []byte{}, err
 	}
@@ -44,7 +45,7 @@ func (c *rawclient) Order(id types.OrderID) ([]byte, error) {
 }
 
 func (c *rawclient) Bids(bfilters BidFilters) ([]byte, error) {
-	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getBidsPath(bfilters)), nil)
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getBidsPath(bfilters)), bfilters.Page.Bytes())
 	if err != nil {
 		return []byte{}, err
 	}
@@ -60,7 +61,7 @@ func (c *rawclient) Bid(id types.BidID) ([]byte, error) {
 }
 
 func (c *rawclient) Leases(lfilters LeaseFilters) ([]byte, error) {
-	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getLeasesPath(lfilters)), nil)
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getLeasesPath(lfilters)), lfilters.Page.Bytes())
 	if err != nil {
 		return []byte{}, err
 	}
@@ -74,3 +75,11 @@ func (c *rawclient) Lease(id types.LeaseID) ([]byte, error) {
 	}
 	return buf, nil
 }
+
+func (c *rawclient) Stats(sfilters StatsFilters) ([]byte, error) {
+	buf, _, err := c.ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", c.key, getStatsPath(sfilters)), nil)
+	if err != nil {
+		return []byte{}, err
+	}
+	return buf, nil
+}
@@ -0,0 +1,275 @@
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/types/unit"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	"github.com/ovrclk/akash/x/market/keeper"
+	"github.com/ovrclk/akash/x/market/types"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+// ProviderKeeper is the provider keeper used to break market statistics
+// down by provider attributes
+type ProviderKeeper interface {
+	Get(ctx sdk.Context, id sdk.Address) (ptypes.Provider, bool)
+}
+
+// StatsFilters defines the parameters of market statistics queries
+type StatsFilters struct {
+	// Denom of the prices considered
+	Denom string
+	// Attribute is the provider attribute key statistics are broken down by; empty for none
+	Attribute string
+}
+
+// PriceStats holds the distribution of prices normalized per resource unit
+type PriceStats struct {
+	Count  int     `json:"count"`
+	P10    sdk.Dec `json:"p10"`
+	Median sdk.Dec `json:"median"`
+	P90    sdk.Dec `json:"p90"`
+}
+
+// UnitPriceStats holds price statistics per milli CPU, per GiB of memory and per GiB of storage.
+// Each statistic attributes the full price of an order to that resource.
+type UnitPriceStats struct {
+	CPU     PriceStats `json:"cpu"`
+	Memory  PriceStats `json:"memory"`
+	Storage PriceStats `json:"storage"`
+}
+
+// AttributeStats holds the market statistics of providers with a given attribute value
+type AttributeStats struct {
+	Value  string         `json:"value"`
+	Leases UnitPriceStats `json:"leases"`
+	Bids   UnitPriceStats `json:"bids"`
+}
+
+// MarketStats holds statistics of active lease prices and open bid prices
+type MarketStats struct {
+	Denom     string           `json:"denom"`
+	Leases    UnitPriceStats   `json:"leases"`
+	Bids      UnitPriceStats   `json:"bids"`
+	Attribute string           `json:"attribute,omitempty"`
+	Breakdown []AttributeStats `json:"breakdown,omitempty"`
+}
+
+// ForAttribute returns the statistics of providers with the given attribute value,
+// falling back to the statistics of all providers when there are no leases for it.
+func (obj MarketStats) ForAttribute(value string) UnitPriceStats {
+	for _, stats := range obj.Breakdown {
+		if stats.Value == value && stats.Leases.CPU.Count > 0 {
+			return stats.Leases
+		}
+	}
+	return obj.Leases
+}
+
+func (obj MarketStats) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Denom: %v\n", obj.Denom)
+	writeUnitPriceStats(&sb, "", "Leases", obj.Leases)
+	writeUnitPriceStats(&sb, "", "Bids", obj.Bids)
+	for _, stats := range obj.Breakdown {
+		fmt.Fprintf(&sb, "%v=%v\n", obj.Attribute, stats.Value)
+		writeUnitPriceStats(&sb, "  ", "Leases", stats.Leases)
+		writeUnitPriceStats(&sb, "  ", "Bids", stats.Bids)
+	}
+	return sb.String()
+}
+
+func writeUnitPriceStats(sb *strings.Builder, indent, title string, stats UnitPriceStats) {
+	fmt.Fprintf(sb, "%v%v:\n", indent, title)
+	fmt.Fprintf(sb, "%v  CPU (per milli):    %v\n", indent, stats.CPU)
+	fmt.Fprintf(sb, "%v  Memory (per GiB):   %v\n", indent, stats.Memory)
+	fmt.Fprintf(sb, "%v  Storage (per GiB):  %v\n", indent, stats.Storage)
+}
+
+func (obj PriceStats) String() string {
+	if obj.Count == 0 {
+		return "no samples"
+	}
+	return fmt.Sprintf("p10 %v median %v p90 %v (%v samples)", obj.P10, obj.Median, obj.P90, obj.Count)
+}
+
+// marketPrices collects the unit prices of leases and bids
+type marketPrices struct {
+	leases, bids unitPrices
+}
+
+func (p *marketPrices) add(spec dtypes.GroupSpec, price sdk.Coin, lease bool) {
+	if lease {
+		p.leases.add(spec, price)
+	} else {
+		p.bids.add(spec, price)
+	}
+}
+
+// unitPrices collects prices normalized per resource unit
+type unitPrices struct {
+	cpu, memory, storage []sdk.Dec
+}
+
+// add normalizes the price of an order's group. Resources the group does not use are skipped.
+func (p *unitPrices) add(spec dtypes.GroupSpec, price sdk.Coin) {
+	var cpu, memory, storage uint64
+	for _, res := range spec.Resources {
+		cpu += uint64(res.Unit.CPU) * uint64(res.Count)
+		memory += res.Unit.Memory * uint64(res.Count)
+		storage += res.Unit.Storage * uint64(res.Count)
+	}
+
+	amount := price.Amount.ToDec()
+	if cpu > 0 {
+		p.cpu = append(p.cpu, amount.QuoInt64(int64(cpu)))
+	}
+	if memory > 0 {
+		p.memory = append(p.memory, amount.MulInt64(int64(unit.Gi)).QuoInt64(int64(memory)))
+	}
+	if storage > 0 {
+		p.storage = append(p.storage, amount.MulInt64(int64(unit.Gi)).QuoInt64(int64(storage)))
+	}
+}
+
+func (p unitPrices) stats() UnitPriceStats {
+	return UnitPriceStats{
+		CPU:     priceStats(p.cpu),
+		Memory:  priceStats(p.memory),
+		Storage: priceStats(p.storage),
+	}
+}
+
+// priceStats computes nearest-rank percentiles of the given prices
+func priceStats(prices []sdk.Dec) PriceStats {
+	if len(prices) == 0 {
+		return PriceStats{P10: sdk.ZeroDec(), Median: sdk.ZeroDec(), P90: sdk.ZeroDec()}
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].LT(prices[j])
+	})
+
+	percentile := func(p int) sdk.Dec {
+		// rank = ceil(p/100 * n)
+		rank := (p*len(prices) + 99) / 100
+		if rank < 1 {
+			rank = 1
+		}
+		return prices[rank-1]
+	}
+
+	return PriceStats{
+		Count:  len(prices),
+		P10:    percentile(10),
+		Median: percentile(50),
+		P90:    percentile(90),
+	}
+}
+
+// computeStats computes statistics over the prices of active leases and open bids
+// in the given denomination, walking the lease and bid state indexes
+func computeStats(ctx sdk.Context, keeper keeper.Keeper, pkeeper ProviderKeeper, filters StatsFilters) (MarketStats, error) {
+	var (
+		all         marketPrices
+		byAttribute = make(map[string]*marketPrices)
+		attrs       = make(map[string]string)
+		specs       = make(map[string]dtypes.GroupSpec)
+	)
+
+	// attributeValue returns the value of the filtered attribute of a provider
+	attributeValue := func(provider sdk.AccAddress) (string, bool) {
+		if filters.Attribute == "" {
+			return "", false
+		}
+		if value, ok := attrs[provider.String()]; ok {
+			return value, value != ""
+		}
+		attrs[provider.String()] = ""
+		prov, ok := pkeeper.Get(ctx, provider)
+		if !ok {
+			return "", false
+		}
+		for _, attr := range prov.Attributes {
+			if attr.Key == filters.Attribute {
+				attrs[provider.String()] = attr.Value
+				return attr.Value, true
+			}
+		}
+		return "", false
+	}
+
+	add := func(provider sdk.AccAddress, oid types.OrderID, price sdk.Coin, lease bool) {
+		if price.Denom != filters.Denom {
+			return
+		}
+		// bids of an order share its specification
+		key := fmt.Sprintf("%v/%v/%v/%v", oid.Owner, oid.DSeq, oid.GSeq, oid.OSeq)
+		spec, ok := specs[key]
+		if !ok {
+			order, found := keeper.GetOrder(ctx, oid)
+			if !found {
+				return
+			}
+			spec = order.Spec
+			specs[key] = spec
+		}
+
+		all.add(spec, price, lease)
+
+		if value, ok := attributeValue(provider); ok {
+			prices := byAttribute[value]
+			if prices == nil {
+				prices = &marketPrices{}
+				byAttribute[value] = prices
+			}
+			prices.add(spec, price, lease)
+		}
+	}
+
+	if _, err := keeper.PaginateLeases(ctx, nil, nil, types.LeaseActive, true, sdkutil.PageRequest{},
+		func(lease types.Lease) bool {
+			add(lease.Provider, lease.OrderID(), lease.Price, true)
+			return true
+		}); err != nil {
+		return MarketStats{}, err
+	}
+
+	if _, err := keeper.PaginateBids(ctx, nil, nil, types.BidOpen, true, sdkutil.PageRequest{},
+		func(bid types.Bid) bool {
+			add(bid.Provider, bid.OrderID(), bid.Price, false)
+			return true
+		}); err != nil {
+		return MarketStats{}, err
+	}
+
+	stats := MarketStats{
+		Denom:     filters.Denom,
+		Leases:    all.leases.stats(),
+		Bids:      all.bids.stats(),
+		Attribute: filters.Attribute,
+	}
+
+	values := make([]string, 0, len(byAttribute))
+	for value := range byAttribute {
+		values = append(values, value)
+	}
+	sort.Strings(values)
+
+	for _, value := range values {
+		prices := byAttribute[value]
+		stats.Breakdown = append(stats.Breakdown, AttributeStats{
+			Value:  value,
+			Leases: prices.leases.stats(),
+			Bids:   prices.bids.stats(),
+		})
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,123 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/types"
+	"github.com/ovrclk/akash/types/unit"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+	"github.com/ovrclk/akash/x/market/keeper"
+	"github.com/ovrclk/akash/x/market/query"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	ptypes "github.com/ovrclk/akash/x/provider/types"
+)
+
+type providerKeeper map[string]ptypes.Provider
+
+func (k providerKeeper) Get(_ sdk.Context, id sdk.Address) (ptypes.Provider, bool) {
+	prov, ok := k[id.String()]
+	return prov, ok
+}
+
+func TestQueryStats(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	pkeeper := providerKeeper{}
+
+	// 200 milli CPU, 2 GiB memory and 4 GiB storage in total
+	spec := dtypes.GroupSpec{
+		Name: "group",
+		Resources: []dtypes.Resource{{
+			Unit:  types.Unit{CPU: 100, Memory: unit.Gi, Storage: 2 * unit.Gi},
+			Count: 2,
+			Price: sdk.NewInt64Coin(testutil.CoinDenom, 300),
+		}},
+	}
+
+	lease := func(region string, price int64) mtypes.LeaseID {
+		provider := testutil.AccAddress(t)
+		pkeeper[provider.String()] = ptypes.Provider{
+			Owner:      provider,
+			Attributes: []sdk.Attribute{sdk.NewAttribute("region", region)},
+		}
+
+		order, err := keeper.CreateOrder(ctx, testutil.GroupID(t), spec)
+		require.NoError(t, err)
+		bid, err := keeper.CreateBid(ctx, order.ID(), provider, sdk.NewInt64Coin(testutil.CoinDenom, price))
+		require.NoError(t, err)
+		keeper.CreateLease(ctx, bid)
+		keeper.OnBidMatched(ctx, bid)
+		return mtypes.MakeLeaseID(bid.ID())
+	}
+
+	lease("us-west", 200)
+	lease("us-east", 400)
+
+	// closed leases are excluded
+	closed, ok := keeper.GetLease(ctx, lease("eu-central", 900))
+	require.True(t, ok)
+	keeper.OnLeaseClosed(ctx, closed)
+
+	order, err := keeper.CreateOrder(ctx, testutil.GroupID(t), spec)
+	require.NoError(t, err)
+	_, err = keeper.CreateBid(ctx, order.ID(), testutil.AccAddress(t), sdk.NewInt64Coin(testutil.CoinDenom, 100))
+	require.NoError(t, err)
+	_, err = keeper.CreateBid(ctx, order.ID(), testutil.AccAddress(t), sdk.NewInt64Coin("other", 100))
+	require.NoError(t, err)
+
+	querier := query.NewQuerier(keeper, pkeeper)
+	buf, err := querier(ctx, []string{"stats", testutil.CoinDenom, "region"}, abci.RequestQuery{})
+	require.NoError(t, err)
+
+	var stats query.MarketStats
+	require.NoError(t, keeper.Codec().UnmarshalJSON(buf, &stats))
+
+	assert.Equal(t, 2, stats.Leases.CPU.Count)
+	assert.Equal(t, sdk.NewDec(1), stats.Leases.CPU.P10)
+	assert.Equal(t, sdk.NewDec(1), stats.Leases.CPU.Median)
+	assert.Equal(t, sdk.NewDec(2), stats.Leases.CPU.P90)
+	assert.Equal(t, sdk.NewDec(100), stats.Leases.Memory.Median)
+	assert.Equal(t, sdk.NewDec(100), stats.Leases.Storage.P90)
+
+	// bids in other denominations and bids that won are excluded
+	assert.Equal(t, 1, stats.Bids.CPU.Count)
+	assert.Equal(t, sdk.NewDecWithPrec(5, 1), stats.Bids.CPU.Median)
+
+	require.Len(t, stats.Breakdown, 2)
+	assert.Equal(t, "us-east", stats.Breakdown[0].Value)
+	assert.Equal(t, sdk.NewDec(2), stats.Breakdown[0].Leases.CPU.Median)
+	assert.Equal(t, "us-west", stats.Breakdown[1].Value)
+	assert.Equal(t, sdk.NewDec(1), stats.Breakdown[1].Leases.CPU.Median)
+
+	assert.Equal(t, stats.Breakdown[0].Leases, stats.ForAttribute("us-east"))
+	assert.Equal(t, stats.Leases, stats.ForAttribute("eu-central"))
+}
+
+func TestQueryStatsInvalidDenom(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+
+	_, err := query.NewQuerier(keeper, providerKeeper{})(ctx, []string{"stats", "!", ""}, abci.RequestQuery{})
+	require.Error(t, err)
+	assert.True(t, mtypes.ErrInternal.Is(err))
+}
+
+func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	key := sdk.NewKVStoreKey(mtypes.StoreKey)
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	err := ms.LoadLatestVersion()
+	require.NoError(t, err)
+	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key)
+}
@@ -3,6 +3,7 @@ package query
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/x/market/types"
 )
 
@@ -23,6 +24,24 @@ type (
 	Leases []Lease
 )
 
+// OrdersResponse is a page of orders
+type OrdersResponse struct {
+	Orders     Orders               `json:"orders"`
+	Pagination sdkutil.PageResponse `json:"pagination"`
+}
+
+// BidsResponse is a page of bids
+type BidsResponse struct {
+	Bids       Bids                 `json:"bids"`
+	Pagination sdkutil.PageResponse `json:"pagination"`
+}
+
+// LeasesResponse is a page of leases
+type LeasesResponse struct {
+	Leases     Leases               `json:"leases"`
+	Pagination sdkutil.PageResponse `json:"pagination"`
+}
+
 const (
 	todo = "TODO see deployment/query/types.go"
 )
@@ -34,24 +53,29 @@ type OrderFilters struct {
 	StateFlagVal string
 	// Actual state value decoded from OrderStateMap
 	State types.OrderState
+	Page  sdkutil.PageRequest
 }
 
 // BidFilters defines flags for bid list filter
 type BidFilters struct {
-	Owner sdk.AccAddress
+	Owner    sdk.AccAddress
+	Provider sdk.AccAddress
 	// State flag value given
 	StateFlagVal string
 	// Actual state value decoded from BidStateMap
 	State types.BidState
+	Page  sdkutil.PageRequest
 }
 
 // LeaseFilters defines flags for lease list filter
 type LeaseFilters struct {
-	Owner sdk.AccAddress
+	Owner    sdk.AccAddress
+	Provider sdk.AccAddress
 	// State flag value given
 	StateFlagVal string
 	// Actual state value decoded from LeaseStateMap
 	State types.LeaseState
+	Page  sdkutil.PageRequest
 }
 
 // Accept returns true if object matches filter requirements
@@ -68,6 +92,10 @@ func (f OrderFilters) Accept(obj types.Order, isValidState bool) bool {
 
 // Accept returns true if object matches filter requirements
 func (f BidFilters) Accept(obj types.Bid, isValidState bool) bool {
+	if !f.Provider.Empty() && !obj.Provider.Equals(f.Provider) {
+		return false
+	}
+
 	if (f.Owner.Empty() && !isValidState) ||
 		(f.Owner.Empty() && (obj.State == f.State)) ||
 		(!isValidState && obj.BidID.Owner.Equals(f.Owner)) ||
@@ -80,6 +108,10 @@ func (f BidFilters) Accept(obj types.Bid, isValidState bool) bool {
 
 // Accept returns true if object matches filter requirements
 func (f LeaseFilters) Accept(obj types.Lease, isValidState bool) bool {
+	if !f.Provider.Empty() && !obj.Provider.Equals(f.Provider) {
+		return false
+	}
+
 	if (f.Owner.Empty() && !isValidState) ||
 		(f.Owner.Empty() && (obj.State == f.State)) ||
 		(!isValidState && (obj.LeaseID.Owner.Equals(f.Owner))) ||
@@ -112,3 +144,15 @@ func (obj Lease) String() string {
 func (obj Leases) String() string {
 	return todo
 }
+
+func (obj OrdersResponse) String() string {
+	return todo
+}
+
+func (obj BidsResponse) String() string {
+	return todo
+}
+
+func (obj LeasesResponse) String() string {
+	return todo
+}
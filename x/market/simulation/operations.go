@@ -106,10 +106,15 @@ func SimulateMsgCreateBid(ak govtypes.AccountKeeper, ks keepers.Keepers) simulat
 			return simulation.NoOpMsg(types.ModuleName), nil, err
 		}
 
+		price, err := order.Price()
+		if err != nil {
+			return simulation.NoOpMsg(types.ModuleName), nil, err
+		}
+
 		msg := types.MsgCreateBid{
 			Order:    order.OrderID,
 			Provider: simAccount.Address,
-			Price:    order.Price(),
+			Price:    price,
 		}
 
 		tx := helpers.GenTx(
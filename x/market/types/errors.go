@@ -25,6 +25,12 @@ const (
 	errCodeInvalidPrice
 	errCodeOrderMatched
 	errCodeOrderClosed
+	errCodeInvalidDenom
+	errCodeInvalidStateTransition
+	errCodeOrderExists
+	errCodeLeaseExists
+	errCodeAmendmentProvider
+	errCodeOrderActive
 )
 
 var (
@@ -68,4 +74,16 @@ var (
 	ErrOrderMatched = sdkerrors.New(ModuleName, errCodeOrderMatched, "order matched")
 	// ErrOrderClosed order closed
 	ErrOrderClosed = sdkerrors.New(ModuleName, errCodeOrderClosed, "order closed")
+	// ErrBidInvalidDenom bid price denomination does not match order
+	ErrBidInvalidDenom = sdkerrors.Register(ModuleName, errCodeInvalidDenom, "bid price denomination does not match order")
+	// ErrInvalidStateTransition is the error when an order, bid or lease may not change to a state
+	ErrInvalidStateTransition = sdkerrors.Register(ModuleName, errCodeInvalidStateTransition, "invalid state transition")
+	// ErrOrderExists order exists
+	ErrOrderExists = sdkerrors.Register(ModuleName, errCodeOrderExists, "invalid order: order exists")
+	// ErrLeaseExists lease exists
+	ErrLeaseExists = sdkerrors.Register(ModuleName, errCodeLeaseExists, "invalid lease: lease exists for bid")
+	// ErrAmendmentProvider is the error when a provider bids on an amendment of another provider's lease
+	ErrAmendmentProvider = sdkerrors.Register(ModuleName, errCodeAmendmentProvider, "amendments are bid on by the lease provider")
+	// ErrOrderActive is the error when an order of the group is still open
+	ErrOrderActive = sdkerrors.Register(ModuleName, errCodeOrderActive, "order active")
 )
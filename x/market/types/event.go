@@ -13,15 +13,29 @@ import (
 const (
 	evActionOrderCreated = "order-created"
 	evActionOrderClosed  = "order-closed"
+	evActionOrderMatched = "order-matched"
 	evActionBidCreated   = "bid-created"
 	evActionBidClosed    = "bid-closed"
+	evActionBidMatched   = "bid-matched"
+	evActionBidLost      = "bid-lost"
 	evActionLeaseCreated = "lease-created"
 	evActionLeaseClosed  = "lease-closed"
+	evActionLeaseAmended = "lease-amended"
 
 	evOSeqKey        = "oseq"
 	evProviderKey    = "provider"
 	evPriceDenomKey  = "price-denom"
 	evPriceAmountKey = "price-amount"
+	evReasonKey      = "reason"
+)
+
+const (
+	// LeaseClosedReasonProviderDeleted is used when a lease is closed because its provider was deleted
+	LeaseClosedReasonProviderDeleted = "provider-deleted"
+	// LeaseClosedReasonAmendmentDeclined is used when a lease is closed because its provider did not bid on an amendment
+	LeaseClosedReasonAmendmentDeclined = "amendment-declined"
+	// LeaseClosedReasonGroupPaused is used when a lease is closed because its group was paused
+	LeaseClosedReasonGroupPaused = "group-paused"
 )
 
 var (
@@ -58,6 +72,21 @@ func (e EventOrderClosed) ToSDKEvent() sdk.Event {
 	)
 }
 
+// EventOrderMatched struct
+type EventOrderMatched struct {
+	ID OrderID
+}
+
+// ToSDKEvent method creates new sdk event for EventOrderMatched struct
+func (e EventOrderMatched) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionOrderMatched),
+		}, orderIDEVAttributes(e.ID)...)...,
+	)
+}
+
 // EventBidCreated struct
 type EventBidCreated struct {
 	ID    BidID
@@ -94,6 +123,42 @@ func (e EventBidClosed) ToSDKEvent() sdk.Event {
 	)
 }
 
+// EventBidMatched struct
+type EventBidMatched struct {
+	ID    BidID
+	Price sdk.Coin
+}
+
+// ToSDKEvent method creates new sdk event for EventBidMatched struct
+func (e EventBidMatched) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, evActionBidMatched),
+			}, bidIDEVAttributes(e.ID)...),
+			priceEVAttributes(e.Price)...)...,
+	)
+}
+
+// EventBidLost struct
+type EventBidLost struct {
+	ID    BidID
+	Price sdk.Coin
+}
+
+// ToSDKEvent method creates new sdk event for EventBidLost struct
+func (e EventBidLost) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append(
+			append([]sdk.Attribute{
+				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+				sdk.NewAttribute(sdk.AttributeKeyAction, evActionBidLost),
+			}, bidIDEVAttributes(e.ID)...),
+			priceEVAttributes(e.Price)...)...,
+	)
+}
+
 // EventLeaseCreated struct
 type EventLeaseCreated struct {
 	ID    LeaseID
@@ -113,17 +178,40 @@ func (e EventLeaseCreated) ToSDKEvent() sdk.Event {
 
 // EventLeaseClosed struct
 type EventLeaseClosed struct {
-	ID    LeaseID
-	Price sdk.Coin
+	ID     LeaseID
+	Price  sdk.Coin
+	Reason string
 }
 
 // ToSDKEvent method creates new sdk event for EventLeaseClosed struct
 func (e EventLeaseClosed) ToSDKEvent() sdk.Event {
+	attrs := append(
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionLeaseClosed),
+		}, leaseIDEVAttributes(e.ID)...),
+		priceEVAttributes(e.Price)...)
+
+	if e.Reason != "" {
+		attrs = append(attrs, sdk.NewAttribute(evReasonKey, e.Reason))
+	}
+
+	return sdk.NewEvent(sdkutil.EventTypeMessage, attrs...)
+}
+
+// EventLeaseAmended struct
+type EventLeaseAmended struct {
+	ID    LeaseID
+	Price sdk.Coin
+}
+
+// ToSDKEvent method creates new sdk event for EventLeaseAmended struct
+func (e EventLeaseAmended) ToSDKEvent() sdk.Event {
 	return sdk.NewEvent(sdkutil.EventTypeMessage,
 		append(
 			append([]sdk.Attribute{
 				sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
-				sdk.NewAttribute(sdk.AttributeKeyAction, evActionLeaseClosed),
+				sdk.NewAttribute(sdk.AttributeKeyAction, evActionLeaseAmended),
 			}, leaseIDEVAttributes(e.ID)...),
 			priceEVAttributes(e.Price)...)...)
 }
@@ -244,6 +332,12 @@ func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
 			return nil, err
 		}
 		return EventOrderClosed{ID: id}, nil
+	case evActionOrderMatched:
+		id, err := parseEVOrderID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventOrderMatched{ID: id}, nil
 
 	case evActionBidCreated:
 		id, err := parseEVBidID(ev.Attributes)
@@ -263,6 +357,26 @@ func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
 		// optional price
 		price, _ := parseEVPriceAttributes(ev.Attributes)
 		return EventBidClosed{ID: id, Price: price}, nil
+	case evActionBidMatched:
+		id, err := parseEVBidID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		price, err := parseEVPriceAttributes(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventBidMatched{ID: id, Price: price}, nil
+	case evActionBidLost:
+		id, err := parseEVBidID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		price, err := parseEVPriceAttributes(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventBidLost{ID: id, Price: price}, nil
 
 	case evActionLeaseCreated:
 		id, err := parseEVLeaseID(ev.Attributes)
@@ -279,9 +393,20 @@ func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
 		if err != nil {
 			return nil, err
 		}
-		// optional price
+		// optional price and reason
 		price, _ := parseEVPriceAttributes(ev.Attributes)
-		return EventLeaseClosed{ID: id, Price: price}, nil
+		reason, _ := sdkutil.GetString(ev.Attributes, evReasonKey)
+		return EventLeaseClosed{ID: id, Price: price, Reason: reason}, nil
+	case evActionLeaseAmended:
+		id, err := parseEVLeaseID(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		price, err := parseEVPriceAttributes(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventLeaseAmended{ID: id, Price: price}, nil
 
 	default:
 		return nil, sdkutil.ErrUnknownAction
@@ -183,6 +183,32 @@ var TEPS = []testEventParsing{
 		},
 		expErr: nil,
 	},
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionOrderMatched,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evGSeqKey,
+					Value: "2",
+				},
+				{
+					Key:   evOSeqKey,
+					Value: "5",
+				},
+			},
+		},
+		expErr: nil,
+	},
 
 	{
 		msg: sdkutil.Event{
@@ -338,6 +364,84 @@ var TEPS = []testEventParsing{
 		expErr: nil,
 	},
 
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionBidMatched,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evGSeqKey,
+					Value: "2",
+				},
+				{
+					Key:   evOSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evProviderKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evPriceDenomKey,
+					Value: "akt",
+				},
+				{
+					Key:   evPriceAmountKey,
+					Value: "23",
+				},
+			},
+		},
+		expErr: nil,
+	},
+
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionBidLost,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evGSeqKey,
+					Value: "2",
+				},
+				{
+					Key:   evOSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evProviderKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evPriceDenomKey,
+					Value: "akt",
+				},
+				{
+					Key:   evPriceAmountKey,
+					Value: "23",
+				},
+			},
+		},
+		expErr: nil,
+	},
+
 	{
 		msg: sdkutil.Event{
 			Type:   sdkutil.EventTypeMessage,
@@ -453,6 +557,74 @@ var TEPS = []testEventParsing{
 		},
 		expErr: nil,
 	},
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionLeaseAmended,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evGSeqKey,
+					Value: "2",
+				},
+				{
+					Key:   evOSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evProviderKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evPriceDenomKey,
+					Value: "akt",
+				},
+				{
+					Key:   evPriceAmountKey,
+					Value: "30",
+				},
+			},
+		},
+		expErr: nil,
+	},
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionLeaseAmended,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+				{
+					Key:   evDSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evGSeqKey,
+					Value: "2",
+				},
+				{
+					Key:   evOSeqKey,
+					Value: "5",
+				},
+				{
+					Key:   evProviderKey,
+					Value: keyAcc.String(),
+				},
+			},
+		},
+		expErr: errWildcard,
+	},
 }
 
 func TestEventParsing(t *testing.T) {
@@ -9,4 +9,8 @@ const (
 
 	// RouterKey is the message route for market
 	RouterKey = ModuleName
+
+	// ConsensusVersion is the version of the market store format.
+	// Version 2 adds order, bid and lease state and provider indexes.
+	ConsensusVersion uint64 = 2
 )
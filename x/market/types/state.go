@@ -0,0 +1,52 @@
+package types
+
+import (
+	"github.com/pkg/errors"
+)
+
+// orderTransitions lists the states each order state may change to
+var orderTransitions = map[OrderState][]OrderState{
+	OrderOpen:    {OrderMatched, OrderClosed},
+	OrderMatched: {OrderClosed},
+}
+
+// bidTransitions lists the states each bid state may change to
+var bidTransitions = map[BidState][]BidState{
+	BidOpen:    {BidMatched, BidLost, BidClosed},
+	BidMatched: {BidClosed},
+}
+
+// leaseTransitions lists the states each lease state may change to
+var leaseTransitions = map[LeaseState][]LeaseState{
+	LeaseActive: {LeaseInsufficientFunds, LeaseClosed},
+}
+
+// ValidateTransition returns ErrInvalidStateTransition unless an order in state s may change to next
+func (s OrderState) ValidateTransition(next OrderState) error {
+	for _, state := range orderTransitions[s] {
+		if state == next {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidStateTransition, "order %v -> %v", s, next)
+}
+
+// ValidateTransition returns ErrInvalidStateTransition unless a bid in state s may change to next
+func (s BidState) ValidateTransition(next BidState) error {
+	for _, state := range bidTransitions[s] {
+		if state == next {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidStateTransition, "bid %v -> %v", s, next)
+}
+
+// ValidateTransition returns ErrInvalidStateTransition unless a lease in state s may change to next
+func (s LeaseState) ValidateTransition(next LeaseState) error {
+	for _, state := range leaseTransitions[s] {
+		if state == next {
+			return nil
+		}
+	}
+	return errors.Wrapf(ErrInvalidStateTransition, "lease %v -> %v", s, next)
+}
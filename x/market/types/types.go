@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pkg/errors"
 
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	dtypes "github.com/ovrclk/akash/x/deployment/types"
 )
 
@@ -36,6 +37,10 @@ type Order struct {
 	// block height to start matching
 	StartAt int64            `json:"start-at"`
 	Spec    dtypes.GroupSpec `json:"spec"`
+
+	// Amends is the lease whose price and resources the order changes in place;
+	// nil for orders that create new leases
+	Amends *LeaseID `json:"amends,omitempty"`
 }
 
 // ID method returns OrderID details of specific order
@@ -43,6 +48,11 @@ func (o Order) ID() OrderID {
 	return o.OrderID
 }
 
+// IsAmendment returns whether the order amends an existing lease
+func (o Order) IsAmendment() bool {
+	return o.Amends != nil
+}
+
 // ValidateCanBid method validates whether order is open or not and
 // returns error if not
 func (o Order) ValidateCanBid() error {
@@ -70,7 +80,7 @@ func (o Order) ValidateInactive() error {
 }
 
 // Price method returns price of specific order
-func (o Order) Price() sdk.Coin {
+func (o Order) Price() (sdk.Coin, error) {
 	return o.Spec.Price()
 }
 
@@ -79,6 +89,12 @@ func (o Order) MatchAttributes(attrs []sdk.Attribute) bool {
 	return o.Spec.MatchAttributes(attrs)
 }
 
+// MatchRequirements method compares provided attributes and audited attributes with
+// specific order requirements
+func (o Order) MatchRequirements(attrs []sdk.Attribute, audited atypes.Providers) bool {
+	return o.Spec.MatchRequirements(attrs, audited)
+}
+
 // ValidateCanMatch method validates whether to match order for provided height
 func (o Order) ValidateCanMatch(height int64) error {
 	if err := o.validateMatchableState(); err != nil {
@@ -6,9 +6,13 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/ovrclk/akash/sdl"
+	aquery "github.com/ovrclk/akash/x/audit/query"
+	atypes "github.com/ovrclk/akash/x/audit/types"
 	"github.com/ovrclk/akash/x/provider/query"
 	"github.com/ovrclk/akash/x/provider/types"
-	"github.com/spf13/cobra"
 )
 
 // GetQueryCmd returns the transaction commands for the provider module
@@ -29,8 +33,10 @@ func GetQueryCmd(key string, cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+const flagFits = "fits"
+
 func cmdGetProviders(key string, cdc *codec.Codec) *cobra.Command {
-	return &cobra.Command{
+	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "Query for all providers",
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -40,9 +46,70 @@ func cmdGetProviders(key string, cdc *codec.Codec) *cobra.Command {
 			if err != nil {
 				return err
 			}
+
+			path, err := cmd.Flags().GetString(flagFits)
+			if err != nil {
+				return err
+			}
+			if path != "" {
+				if obj, err = providersFitting(ctx, obj, path); err != nil {
+					return err
+				}
+			}
 			return ctx.PrintOutput(obj)
 		},
 	}
+	cmd.Flags().String(flagFits, "", "List only providers whose capacity and attributes can satisfy every group of the SDL file")
+	return cmd
+}
+
+// providersFitting returns the providers that advertise capacity for, and have the
+// attributes required by, every group of the SDL file at path
+func providersFitting(ctx context.CLIContext, providers query.Providers, path string) (query.Providers, error) {
+	obj, err := sdl.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	groups, err := obj.DeploymentGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	var audited aquery.Providers
+	for _, group := range groups {
+		if !group.SignedBy.Empty() {
+			if audited, err = aquery.NewClient(ctx, atypes.StoreKey).AllProvidersAttributes(); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	result := make(query.Providers, 0, len(providers))
+
+loop:
+	for _, provider := range providers {
+		if provider.Draining || provider.Capacity == nil {
+			continue
+		}
+
+		var signed atypes.Providers
+		for _, attrs := range audited {
+			if attrs.Owner.Equals(provider.Owner) {
+				signed = append(signed, atypes.Provider(attrs))
+			}
+		}
+
+		for _, group := range groups {
+			if !provider.Capacity.Fits(group) || !group.MatchRequirements(provider.Attributes, signed) {
+				continue loop
+			}
+		}
+		result = append(result, provider)
+	}
+
+	return result, nil
 }
 
 func cmdGetProvider(key string, cdc *codec.Codec) *cobra.Command {
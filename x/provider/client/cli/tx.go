@@ -29,6 +29,9 @@ func GetTxCmd(key string, cdc *codec.Codec) *cobra.Command {
 	cmd.AddCommand(flags.PostCommands(
 		cmdCreate(key, cdc),
 		cmdUpdate(key, cdc),
+		cmdDelete(key, cdc),
+		cmdDrain(key, cdc),
+		cmdResume(key, cdc),
 	)...)
 	return cmd
 }
@@ -96,3 +99,69 @@ func cmdUpdate(key string, cdc *codec.Codec) *cobra.Command {
 
 	return cmd
 }
+
+func cmdDelete(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete",
+		Short: fmt.Sprintf("Delete %s, closing all of its bids and leases", key),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := context.NewCLIContext().WithCodec(cdc)
+			bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+			msg := types.MsgDeleteProvider{
+				Owner: ctx.GetFromAddress(),
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+		},
+	}
+
+	return cmd
+}
+
+func cmdDrain(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "drain",
+		Short: fmt.Sprintf("Stop %s from placing new bids while keeping current leases", key),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return broadcastDrain(cdc, true)
+		},
+	}
+
+	return cmd
+}
+
+func cmdResume(key string, cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume",
+		Short: fmt.Sprintf("Allow a draining %s to place new bids again", key),
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return broadcastDrain(cdc, false)
+		},
+	}
+
+	return cmd
+}
+
+func broadcastDrain(cdc *codec.Codec, draining bool) error {
+	ctx := context.NewCLIContext().WithCodec(cdc)
+	bldr := auth.NewTxBuilderFromCLI(os.Stdin).WithTxEncoder(utils.GetTxEncoder(cdc))
+
+	msg := types.MsgDrainProvider{
+		Owner:    ctx.GetFromAddress(),
+		Draining: draining,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	return utils.GenerateOrBroadcastMsgs(ctx, bldr, []sdk.Msg{msg})
+}
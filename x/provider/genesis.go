@@ -9,26 +9,33 @@ import (
 
 // GenesisState defines the basic genesis state used by provider module
 type GenesisState struct {
+	Params    types.Params     `json:"params"`
 	Providers []types.Provider `json:"providers"`
 }
 
 // ValidateGenesis does validation check of the Genesis and returns error incase of failure
 func ValidateGenesis(data GenesisState) error {
-	return nil
+	return data.Params.Validate()
 }
 
 // InitGenesis initiate genesis state and return updated validator details
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, data GenesisState) []abci.ValidatorUpdate {
+	keeper.SetStoreVersion(ctx, types.ConsensusVersion)
+	keeper.SetParams(ctx, data.Params)
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns genesis state as raw bytes for the provider module
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: k.GetParams(ctx),
+	}
 }
 
 // DefaultGenesisState returns default genesis state as raw bytes for the provider
 // module.
 func DefaultGenesisState() GenesisState {
-	return GenesisState{}
+	return GenesisState{
+		Params: types.DefaultParams(),
+	}
 }
@@ -12,7 +12,7 @@ import (
 )
 
 // NewHandler returns a handler for "provider" type messages.
-func NewHandler(keeper keeper.Keeper, mkeeper mkeeper.Keeper) sdk.Handler {
+func NewHandler(keeper keeper.Keeper, mkeeper mkeeper.Keeper, dkeeper DeploymentKeeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
 		switch msg := msg.(type) {
 		case types.MsgCreateProvider:
@@ -20,7 +20,11 @@ func NewHandler(keeper keeper.Keeper, mkeeper mkeeper.Keeper) sdk.Handler {
 		case types.MsgUpdateProvider:
 			return handleMsgUpdate(ctx, keeper, mkeeper, msg)
 		case types.MsgDeleteProvider:
-			return handleMsgDelete(ctx, keeper, msg)
+			return handleMsgDelete(ctx, keeper, mkeeper, dkeeper, msg)
+		case types.MsgDrainProvider:
+			return handleMsgDrain(ctx, keeper, msg)
+		case types.MsgUpdateProviderCapacity:
+			return handleMsgUpdateCapacity(ctx, keeper, msg)
 		default:
 			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized bank message type: %T", msg)
 		}
@@ -37,7 +41,13 @@ func handleMsgCreate(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgCreateP
 		return nil, errors.Wrapf(types.ErrProviderExists, "id: %s", msg.Owner)
 	}
 
-	if err := keeper.Create(ctx, types.Provider(msg)); err != nil {
+	prov := types.Provider{
+		Owner:      msg.Owner,
+		HostURI:    msg.HostURI,
+		Attributes: msg.Attributes,
+	}
+
+	if err := keeper.Create(ctx, prov); err != nil {
 		return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
 	}
 
@@ -78,7 +88,10 @@ func handleMsgUpdate(ctx sdk.Context, keeper keeper.Keeper, mkeeper mkeeper.Keep
 		return nil, err
 	}
 
-	if err := keeper.Update(ctx, types.Provider(msg)); err != nil {
+	prov.HostURI = msg.HostURI
+	prov.Attributes = msg.Attributes
+
+	if err := keeper.Update(ctx, prov); err != nil {
 		return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
 	}
 
@@ -87,11 +100,107 @@ func handleMsgUpdate(ctx sdk.Context, keeper keeper.Keeper, mkeeper mkeeper.Keep
 	}, nil
 }
 
-func handleMsgDelete(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgDeleteProvider) (*sdk.Result, error) {
+func handleMsgDelete(ctx sdk.Context, keeper keeper.Keeper, mkeeper mkeeper.Keeper,
+	dkeeper DeploymentKeeper, msg types.MsgDeleteProvider) (*sdk.Result, error) {
 	if _, ok := keeper.Get(ctx, msg.Owner); !ok {
 		return nil, types.ErrProviderNotFound
 	}
 
-	// TODO: cancel leases
-	return nil, sdkerrors.Wrapf(ErrInternal, "NOTIMPLEMENTED")
+	var bids []mtypes.Bid
+	mkeeper.WithBids(ctx, func(bid mtypes.Bid) bool {
+		if bid.Provider.Equals(msg.Owner) {
+			bids = append(bids, bid)
+		}
+		return false
+	})
+
+	for _, bid := range bids {
+		switch bid.State {
+		case mtypes.BidOpen:
+			if err := mkeeper.OnBidClosed(ctx, bid); err != nil {
+				return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
+			}
+		case mtypes.BidMatched:
+			lease, found := mkeeper.GetLease(ctx, mtypes.LeaseID(bid.ID()))
+			if !found || lease.State != mtypes.LeaseActive {
+				continue
+			}
+
+			order, found := mkeeper.GetOrder(ctx, bid.OrderID())
+			if !found {
+				return nil, errors.Wrapf(ErrInternal,
+					"order \"%s\" for lease \"%s\" has not been found",
+					mtypes.OrderIDString(bid.OrderID()),
+					mtypes.BidIDString(bid.ID()))
+			}
+
+			if err := closeLease(ctx, mkeeper, dkeeper, bid, lease, order); err != nil {
+				return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
+			}
+		}
+	}
+
+	if err := keeper.Delete(ctx, msg.Owner); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+// closeLease closes a lease of a deleted provider along with its bid and order
+func closeLease(ctx sdk.Context, mkeeper mkeeper.Keeper, dkeeper DeploymentKeeper,
+	bid mtypes.Bid, lease mtypes.Lease, order mtypes.Order) error {
+	if err := mkeeper.OnBidClosed(ctx, bid); err != nil {
+		return err
+	}
+	if err := mkeeper.OnLeaseClosedWithReason(ctx, lease, mtypes.LeaseClosedReasonProviderDeleted); err != nil {
+		return err
+	}
+	if err := mkeeper.OnOrderClosed(ctx, order); err != nil {
+		return err
+	}
+
+	// re-opens the group; a new order is created for it at the end of the block.
+	return dkeeper.OnLeaseClosed(ctx, order.GroupID())
+}
+
+func handleMsgDrain(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgDrainProvider) (*sdk.Result, error) {
+	prov, found := keeper.Get(ctx, msg.Owner)
+	if !found {
+		return nil, errors.Wrapf(types.ErrProviderNotFound, "id: %s", msg.Owner)
+	}
+
+	prov.Draining = msg.Draining
+
+	if err := keeper.Update(ctx, prov); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
+}
+
+func handleMsgUpdateCapacity(ctx sdk.Context, keeper keeper.Keeper, msg types.MsgUpdateProviderCapacity) (*sdk.Result, error) {
+	prov, found := keeper.Get(ctx, msg.Owner)
+	if !found {
+		return nil, errors.Wrapf(types.ErrProviderNotFound, "id: %s", msg.Owner)
+	}
+
+	if prov.Capacity != nil {
+		interval := keeper.GetParams(ctx).CapacityUpdateInterval
+		if next := prov.Capacity.Height + interval; ctx.BlockHeight() < next {
+			return nil, errors.Wrapf(types.ErrCapacityUpdateTooSoon, "next update at height %v", next)
+		}
+	}
+
+	if err := keeper.UpdateCapacity(ctx, msg.Owner, msg.Capacity); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInternal, "err: %v", err)
+	}
+
+	return &sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}, nil
 }
@@ -6,13 +6,18 @@ import (
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/ovrclk/akash/app"
+	"github.com/ovrclk/akash/sdkutil"
 	"github.com/ovrclk/akash/testutil"
+	atypes "github.com/ovrclk/akash/types"
+	dkeeper "github.com/ovrclk/akash/x/deployment/keeper"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
 	mkeeper "github.com/ovrclk/akash/x/market/keeper"
 	mtypes "github.com/ovrclk/akash/x/market/types"
 	"github.com/ovrclk/akash/x/provider/handler"
@@ -27,6 +32,7 @@ type testSuite struct {
 	ctx     sdk.Context
 	keeper  keeper.Keeper
 	mkeeper mkeeper.Keeper
+	dkeeper dkeeper.Keeper
 	handler sdk.Handler
 }
 
@@ -37,21 +43,30 @@ func setupTestSuite(t *testing.T) *testSuite {
 
 	pKey := sdk.NewTransientStoreKey(types.StoreKey)
 	mKey := sdk.NewTransientStoreKey(mtypes.StoreKey)
+	dKey := sdk.NewTransientStoreKey(dtypes.StoreKey)
+	paramsKey := sdk.NewKVStoreKey(params.StoreKey)
+	paramsTKey := sdk.NewTransientStoreKey(params.TStoreKey)
 
 	db := dbm.NewMemDB()
 	suite.ms = store.NewCommitMultiStore(db)
 	suite.ms.MountStoreWithDB(pKey, sdk.StoreTypeIAVL, db)
 	suite.ms.MountStoreWithDB(mKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(dKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsKey, sdk.StoreTypeIAVL, db)
+	suite.ms.MountStoreWithDB(paramsTKey, sdk.StoreTypeTransient, db)
 
 	err := suite.ms.LoadLatestVersion()
 	require.NoError(t, err)
 
 	suite.ctx = sdk.NewContext(suite.ms, abci.Header{}, true, testutil.Logger(t))
 
-	suite.keeper = keeper.NewKeeper(app.MakeCodec(), pKey)
+	pkeeper := params.NewKeeper(app.MakeCodec(), paramsKey, paramsTKey)
+	suite.keeper = keeper.NewKeeper(app.MakeCodec(), pKey, pkeeper.Subspace(types.DefaultParamspace))
+	suite.keeper.SetParams(suite.ctx, types.DefaultParams())
 	suite.mkeeper = mkeeper.NewKeeper(app.MakeCodec(), mKey)
+	suite.dkeeper = dkeeper.NewKeeper(app.MakeCodec(), dKey, pkeeper.Subspace(dtypes.DefaultParamspace))
 
-	suite.handler = handler.NewHandler(suite.keeper, suite.mkeeper)
+	suite.handler = handler.NewHandler(suite.keeper, suite.mkeeper, suite.dkeeper)
 
 	return suite
 }
@@ -109,7 +124,11 @@ func TestProviderUpdateExisting(t *testing.T) {
 		Attributes: createMsg.Attributes,
 	}
 
-	err := suite.keeper.Create(suite.ctx, types.Provider(createMsg))
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:      createMsg.Owner,
+		HostURI:    createMsg.HostURI,
+		Attributes: createMsg.Attributes,
+	})
 	require.NoError(t, err)
 
 	res, err := suite.handler(suite.ctx, updateMsg)
@@ -158,7 +177,11 @@ func TestProviderUpdateAttributes(t *testing.T) {
 		Attributes: createMsg.Attributes,
 	}
 
-	err := suite.keeper.Create(suite.ctx, types.Provider(createMsg))
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:      createMsg.Owner,
+		HostURI:    createMsg.HostURI,
+		Attributes: createMsg.Attributes,
+	})
 	require.NoError(t, err)
 
 	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 0)
@@ -210,19 +233,212 @@ func TestProviderDeleteExisting(t *testing.T) {
 		Owner: addr,
 	}
 
-	err := suite.keeper.Create(suite.ctx, types.Provider(createMsg))
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:      createMsg.Owner,
+		HostURI:    createMsg.HostURI,
+		Attributes: createMsg.Attributes,
+	})
 	require.NoError(t, err)
 
 	res, err := suite.handler(suite.ctx, deleteMsg)
-	require.Nil(t, res)
-	require.EqualError(t, err, handler.ErrInternal.Error()+": NOTIMPLEMENTED")
-	require.True(t, errors.Is(err, handler.ErrInternal))
+	require.NoError(t, err)
+	require.NotNil(t, res)
 
 	t.Run("ensure event created", func(t *testing.T) {
-		// TODO: this should emit a ProviderDelete
+		iev := testutil.ParseProviderEvent(t, res.Events[1:])
+		require.IsType(t, types.EventProviderDelete{}, iev)
+
+		dev := iev.(types.EventProviderDelete)
+
+		require.Equal(t, deleteMsg.Owner, dev.Owner)
+	})
+
+	_, found := suite.keeper.Get(suite.ctx, addr)
+	require.False(t, found)
+}
+
+func TestProviderDeleteClosesBidsAndLeases(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:   addr,
+		HostURI: testutil.Hostname(t),
+	})
+	require.NoError(t, err)
+
+	// leased group
+	group := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+	group.State = dtypes.GroupMatched
+	err = suite.dkeeper.Create(suite.ctx, dtypes.Deployment{
+		DeploymentID: group.ID().DeploymentID(),
+		State:        dtypes.DeploymentActive,
+	}, []dtypes.Group{group})
+	require.NoError(t, err)
+
+	order, err := suite.mkeeper.CreateOrder(suite.ctx, group.ID(), group.GroupSpec)
+	require.NoError(t, err)
+	bid, err := suite.mkeeper.CreateBid(suite.ctx, order.ID(), addr, testutil.Coin(t))
+	require.NoError(t, err)
+	suite.mkeeper.CreateLease(suite.ctx, bid)
+	suite.mkeeper.OnBidMatched(suite.ctx, bid)
+	suite.mkeeper.OnOrderMatched(suite.ctx, order)
+
+	// open bid
+	openGroup := testutil.DeploymentGroup(t, testutil.DeploymentID(t), 1)
+	openOrder, err := suite.mkeeper.CreateOrder(suite.ctx, openGroup.ID(), openGroup.GroupSpec)
+	require.NoError(t, err)
+	openBid, err := suite.mkeeper.CreateBid(suite.ctx, openOrder.ID(), addr, testutil.Coin(t))
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.ctx, types.MsgDeleteProvider{Owner: addr})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	lease, found := suite.mkeeper.GetLease(suite.ctx, mtypes.LeaseID(bid.ID()))
+	require.True(t, found)
+	require.Equal(t, mtypes.LeaseClosed, lease.State)
+
+	t.Run("ensure lease closed with reason", func(t *testing.T) {
+		var ev mtypes.EventLeaseClosed
+		for _, abciev := range res.Events.ToABCIEvents() {
+			uev, err := sdkutil.ParseEvent(sdk.StringifyEvent(abciev))
+			require.NoError(t, err)
+			if iev, err := mtypes.ParseEvent(uev); err == nil {
+				if lev, ok := iev.(mtypes.EventLeaseClosed); ok {
+					ev = lev
+				}
+			}
+		}
+		require.Equal(t, lease.ID(), ev.ID)
+		require.Equal(t, mtypes.LeaseClosedReasonProviderDeleted, ev.Reason)
+	})
+
+	order, found = suite.mkeeper.GetOrder(suite.ctx, order.ID())
+	require.True(t, found)
+	require.Equal(t, mtypes.OrderClosed, order.State)
+
+	group, found = suite.dkeeper.GetGroup(suite.ctx, group.ID())
+	require.True(t, found)
+	require.Equal(t, dtypes.GroupOpen, group.State)
+
+	openBid, found = suite.mkeeper.GetBid(suite.ctx, openBid.ID())
+	require.True(t, found)
+	require.Equal(t, mtypes.BidClosed, openBid.State)
+
+	_, found = suite.keeper.Get(suite.ctx, addr)
+	require.False(t, found)
+}
+
+func TestProviderDrain(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:   addr,
+		HostURI: testutil.Hostname(t),
+	})
+	require.NoError(t, err)
+
+	res, err := suite.handler(suite.ctx, types.MsgDrainProvider{Owner: addr, Draining: true})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	prov, found := suite.keeper.Get(suite.ctx, addr)
+	require.True(t, found)
+	require.True(t, prov.Draining)
+
+	t.Run("update keeps draining state", func(t *testing.T) {
+		_, err := suite.handler(suite.ctx, types.MsgUpdateProvider{
+			Owner:   addr,
+			HostURI: testutil.Hostname(t),
+		})
+		require.NoError(t, err)
+
+		prov, found := suite.keeper.Get(suite.ctx, addr)
+		require.True(t, found)
+		require.True(t, prov.Draining)
+	})
+
+	_, err = suite.handler(suite.ctx, types.MsgDrainProvider{Owner: addr, Draining: false})
+	require.NoError(t, err)
+
+	prov, found = suite.keeper.Get(suite.ctx, addr)
+	require.True(t, found)
+	require.False(t, prov.Draining)
+}
+
+func TestProviderDrainNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	res, err := suite.handler(suite.ctx, types.MsgDrainProvider{Owner: testutil.AccAddress(t), Draining: true})
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrProviderNotFound))
+}
+
+func TestProviderUpdateCapacity(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	addr := testutil.AccAddress(t)
+
+	err := suite.keeper.Create(suite.ctx, types.Provider{
+		Owner:   addr,
+		HostURI: testutil.Hostname(t),
+	})
+	require.NoError(t, err)
+
+	capacity := types.Capacity{
+		Available: atypes.Unit{CPU: 4000, Memory: 8192, Storage: 16384},
+		Largest:   atypes.Unit{CPU: 2000, Memory: 4096, Storage: 8192},
+	}
+
+	ctx := suite.ctx.WithBlockHeight(10)
+	res, err := suite.handler(ctx, types.MsgUpdateProviderCapacity{Owner: addr, Capacity: capacity})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	prov, found := suite.keeper.Get(ctx, addr)
+	require.True(t, found)
+	require.NotNil(t, prov.Capacity)
+	require.Equal(t, capacity.Available, prov.Capacity.Available)
+	require.Equal(t, int64(10), prov.Capacity.Height)
+
+	t.Run("rate limited", func(t *testing.T) {
+		interval := types.DefaultParams().CapacityUpdateInterval
+		ctx := suite.ctx.WithBlockHeight(10 + interval - 1)
+		_, err := suite.handler(ctx, types.MsgUpdateProviderCapacity{Owner: addr, Capacity: capacity})
+		require.True(t, errors.Is(err, types.ErrCapacityUpdateTooSoon))
+
+		ctx = suite.ctx.WithBlockHeight(10 + interval)
+		_, err = suite.handler(ctx, types.MsgUpdateProviderCapacity{Owner: addr, Capacity: capacity})
+		require.NoError(t, err)
+	})
+
+	t.Run("update keeps capacity", func(t *testing.T) {
+		_, err := suite.handler(suite.ctx, types.MsgUpdateProvider{
+			Owner:   addr,
+			HostURI: testutil.Hostname(t),
+		})
+		require.NoError(t, err)
+
+		prov, found := suite.keeper.Get(suite.ctx, addr)
+		require.True(t, found)
+		require.NotNil(t, prov.Capacity)
 	})
 }
 
+func TestProviderUpdateCapacityNonExisting(t *testing.T) {
+	suite := setupTestSuite(t)
+
+	res, err := suite.handler(suite.ctx, types.MsgUpdateProviderCapacity{Owner: testutil.AccAddress(t)})
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.True(t, errors.Is(err, types.ErrProviderNotFound))
+}
+
 func TestProviderDeleteNonExisting(t *testing.T) {
 	suite := setupTestSuite(t)
 	msg := types.MsgDeleteProvider{
@@ -0,0 +1,11 @@
+package handler
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	dtypes "github.com/ovrclk/akash/x/deployment/types"
+)
+
+// DeploymentKeeper Interface includes deployment methods
+type DeploymentKeeper interface {
+	OnLeaseClosed(ctx sdk.Context, id dtypes.GroupID) error
+}
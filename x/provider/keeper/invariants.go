@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	"github.com/ovrclk/akash/x/provider/types"
+)
+
+// MarketKeeper is the market state the provider invariants are checked against
+type MarketKeeper interface {
+	WithBids(ctx sdk.Context, fn func(mtypes.Bid) bool)
+	WithLeases(ctx sdk.Context, fn func(mtypes.Lease) bool)
+}
+
+// RegisterInvariants registers the provider module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper, mkeeper MarketKeeper) {
+	ir.RegisterRoute(types.ModuleName, "registered-providers", RegisteredProvidersInvariant(k, mkeeper))
+}
+
+// RegisteredProvidersInvariant checks that the providers of open bids and active
+// leases are registered
+func RegisteredProvidersInvariant(k Keeper, mkeeper MarketKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		var count int
+
+		mkeeper.WithBids(ctx, func(bid mtypes.Bid) bool {
+			if bid.State != mtypes.BidOpen {
+				return false
+			}
+			if _, ok := k.Get(ctx, bid.Provider); !ok {
+				count++
+				msg += fmt.Sprintf("\topen bid %v: provider not found\n", bid.ID())
+			}
+			return false
+		})
+
+		mkeeper.WithLeases(ctx, func(lease mtypes.Lease) bool {
+			if lease.State != mtypes.LeaseActive {
+				return false
+			}
+			if _, ok := k.Get(ctx, lease.Provider); !ok {
+				count++
+				msg += fmt.Sprintf("\tactive lease %v: provider not found\n", lease.ID())
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(types.ModuleName, "registered-providers",
+			fmt.Sprintf("bids and leases of unregistered providers found %d\n%s", count, msg)), count != 0
+	}
+}
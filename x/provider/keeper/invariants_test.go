@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/testutil"
+	mtypes "github.com/ovrclk/akash/x/market/types"
+	"github.com/ovrclk/akash/x/provider/keeper"
+)
+
+type marketKeeper struct {
+	bids   []mtypes.Bid
+	leases []mtypes.Lease
+}
+
+func (k *marketKeeper) WithBids(_ sdk.Context, fn func(mtypes.Bid) bool) {
+	for _, bid := range k.bids {
+		if fn(bid) {
+			return
+		}
+	}
+}
+
+func (k *marketKeeper) WithLeases(_ sdk.Context, fn func(mtypes.Lease) bool) {
+	for _, lease := range k.leases {
+		if fn(lease) {
+			return
+		}
+	}
+}
+
+func TestRegisteredProvidersInvariant(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	prov := testutil.Provider(t)
+	require.NoError(t, k.Create(ctx, prov))
+
+	bid := mtypes.MakeBidID(testutil.OrderID(t), prov.Owner)
+	market := &marketKeeper{
+		bids:   []mtypes.Bid{{BidID: bid, State: mtypes.BidOpen}},
+		leases: []mtypes.Lease{{LeaseID: mtypes.MakeLeaseID(bid), State: mtypes.LeaseActive}},
+	}
+
+	_, broken := keeper.RegisteredProvidersInvariant(k, market)(ctx)
+	assert.False(t, broken)
+
+	// bids and leases of a provider that is not registered
+	unknown := mtypes.MakeBidID(testutil.OrderID(t), testutil.AccAddress(t))
+	market.bids = append(market.bids, mtypes.Bid{BidID: unknown, State: mtypes.BidOpen})
+	market.leases = append(market.leases, mtypes.Lease{LeaseID: mtypes.MakeLeaseID(unknown), State: mtypes.LeaseActive})
+
+	msg, broken := keeper.RegisteredProvidersInvariant(k, market)(ctx)
+	assert.True(t, broken)
+	assert.Contains(t, msg, "bids and leases of unregistered providers found 2")
+
+	// closed bids and leases of unregistered providers are consistent
+	market.bids[1].State = mtypes.BidClosed
+	market.leases[1].State = mtypes.LeaseClosed
+	_, broken = keeper.RegisteredProvidersInvariant(k, market)(ctx)
+	assert.False(t, broken)
+}
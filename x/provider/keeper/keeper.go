@@ -3,21 +3,28 @@ package keeper
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 
 	"github.com/ovrclk/akash/x/provider/types"
 )
 
 // Keeper of the provider store
 type Keeper struct {
-	skey sdk.StoreKey
-	cdc  *codec.Codec
+	skey   sdk.StoreKey
+	cdc    *codec.Codec
+	pspace params.Subspace
 }
 
 // NewKeeper creates and returns an instance for Provider keeper
-func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey) Keeper {
+func NewKeeper(cdc *codec.Codec, skey sdk.StoreKey, pspace params.Subspace) Keeper {
+	if !pspace.HasKeyTable() {
+		pspace = pspace.WithKeyTable(types.ParamKeyTable())
+	}
+
 	return Keeper{
-		skey: skey,
-		cdc:  cdc,
+		skey:   skey,
+		cdc:    cdc,
+		pspace: pspace,
 	}
 }
 
@@ -26,6 +33,17 @@ func (k Keeper) Codec() *codec.Codec {
 	return k.cdc
 }
 
+// GetParams returns the provider module params
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.pspace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the provider module params
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.pspace.SetParamSet(ctx, &params)
+}
+
 // Get returns a provider with given provider id
 func (k Keeper) Get(ctx sdk.Context, id sdk.Address) (types.Provider, bool) {
 	store := ctx.KVStore(k.skey)
@@ -62,7 +80,7 @@ func (k Keeper) Create(ctx sdk.Context, provider types.Provider) error {
 // WithProviders iterates all providers
 func (k Keeper) WithProviders(ctx sdk.Context, fn func(types.Provider) bool) {
 	store := ctx.KVStore(k.skey)
-	iter := store.Iterator(nil, nil)
+	iter := sdk.KVStorePrefixIterator(store, providerPrefix)
 	for ; iter.Valid(); iter.Next() {
 		var val types.Provider
 		k.cdc.MustUnmarshalBinaryBare(iter.Value(), &val)
@@ -89,7 +107,39 @@ func (k Keeper) Update(ctx sdk.Context, provider types.Provider) error {
 	return nil
 }
 
-// Delete delete a provider
-func (k Keeper) Delete(ctx sdk.Context, id sdk.Address) {
-	panic("TODO")
+// UpdateCapacity records the capacity advertised by a provider
+func (k Keeper) UpdateCapacity(ctx sdk.Context, id sdk.AccAddress, capacity types.Capacity) error {
+	provider, found := k.Get(ctx, id)
+	if !found {
+		return types.ErrProviderNotFound
+	}
+
+	capacity.Height = ctx.BlockHeight()
+	provider.Capacity = &capacity
+
+	store := ctx.KVStore(k.skey)
+	store.Set(providerKey(id), k.cdc.MustMarshalBinaryBare(provider))
+
+	ctx.EventManager().EmitEvent(
+		types.EventProviderCapacityUpdate{Owner: id}.ToSDKEvent(),
+	)
+
+	return nil
+}
+
+// Delete removes a provider from the store
+func (k Keeper) Delete(ctx sdk.Context, id sdk.AccAddress) error {
+	store := ctx.KVStore(k.skey)
+	key := providerKey(id)
+
+	if !store.Has(key) {
+		return types.ErrProviderNotFound
+	}
+	store.Delete(key)
+
+	ctx.EventManager().EmitEvent(
+		types.EventProviderDelete{Owner: id}.ToSDKEvent(),
+	)
+
+	return nil
 }
@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/params"
 	"github.com/stretchr/testify/require"
 	abci "github.com/tendermint/tendermint/abci/types"
 	dbm "github.com/tendermint/tm-db"
@@ -56,13 +57,20 @@ func TestProviderDeleteExisting(t *testing.T) {
 	err := keeper.Create(ctx, prov)
 	require.NoError(t, err)
 
-	require.Panics(t, func() {
-		keeper.Delete(ctx, prov.Owner)
-	})
+	err = keeper.Delete(ctx, prov.Owner)
+	require.NoError(t, err)
 
 	foundProv, found := keeper.Get(ctx, prov.Owner)
-	require.True(t, found)
-	require.Equal(t, prov, foundProv)
+	require.False(t, found)
+	require.Equal(t, types.Provider{}, foundProv)
+}
+
+func TestProviderDeleteNonExisting(t *testing.T) {
+	ctx, keeper := setupKeeper(t)
+	prov := testutil.Provider(t)
+
+	err := keeper.Delete(ctx, prov.Owner)
+	require.EqualError(t, err, types.ErrProviderNotFound.Error())
 }
 
 func TestProviderUpdateNonExisting(t *testing.T) {
@@ -144,13 +152,26 @@ func TestKeeperCoder(t *testing.T) {
 }
 
 func setupKeeper(t testing.TB) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+	ctx, keeper, _ := setupKeeperWithStore(t)
+	return ctx, keeper
+}
+
+// setupKeeperWithStore also returns the keeper's store key, for tests that
+// write the store directly
+func setupKeeperWithStore(t testing.TB) (sdk.Context, keeper.Keeper, sdk.StoreKey) {
 	t.Helper()
 	key := sdk.NewKVStoreKey(types.StoreKey)
+	pkey := sdk.NewKVStoreKey(params.StoreKey)
+	tkey := sdk.NewTransientStoreKey(params.TStoreKey)
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 	ms.MountStoreWithDB(key, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(pkey, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tkey, sdk.StoreTypeTransient, db)
 	err := ms.LoadLatestVersion()
 	require.NoError(t, err)
 	ctx := sdk.NewContext(ms, abci.Header{Time: time.Unix(0, 0)}, false, testutil.Logger(t))
-	return ctx, keeper.NewKeeper(app.MakeCodec(), key)
+	pspace := params.NewKeeper(app.MakeCodec(), pkey, tkey).Subspace(types.DefaultParamspace)
+	return ctx, keeper.NewKeeper(app.MakeCodec(), key, pspace), key
 }
@@ -2,6 +2,13 @@ package keeper
 
 import sdk "github.com/cosmos/cosmos-sdk/types"
 
+var (
+	// storeVersionKey holds the version of the store format
+	storeVersionKey = []byte{0x00}
+
+	providerPrefix = []byte{0x01}
+)
+
 func providerKey(id sdk.Address) []byte {
-	return id.Bytes()
+	return append(append([]byte{}, providerPrefix...), id.Bytes()...)
 }
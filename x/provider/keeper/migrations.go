@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/x/provider/types"
+)
+
+// StoreVersion returns the version of the provider store format
+func (k Keeper) StoreVersion(ctx sdk.Context) uint64 {
+	return sdkutil.GetStoreVersion(ctx.KVStore(k.skey), storeVersionKey)
+}
+
+// SetStoreVersion records the version of the provider store format
+func (k Keeper) SetStoreVersion(ctx sdk.Context, version uint64) {
+	sdkutil.SetStoreVersion(ctx.KVStore(k.skey), storeVersionKey, version)
+}
+
+// RegisterMigrations registers the provider store and its migrations with m
+func RegisterMigrations(m *sdkutil.Migrator, k Keeper) error {
+	m.RegisterModule(types.ModuleName, k, types.ConsensusVersion)
+	if err := m.RegisterMigration(types.ModuleName, 1, k.migrateV1); err != nil {
+		return err
+	}
+	return m.RegisterMigration(types.ModuleName, 2, k.migrateV2)
+}
+
+// migrateV1 moves providers, which version 1 stores key by owner address
+// alone, under the provider prefix
+func (k Keeper) migrateV1(ctx sdk.Context) error {
+	store := ctx.KVStore(k.skey)
+
+	var keys, values [][]byte
+	iter := store.Iterator(nil, nil)
+	for ; iter.Valid(); iter.Next() {
+		if len(iter.Key()) != sdk.AddrLen {
+			continue
+		}
+		keys = append(keys, append([]byte{}, iter.Key()...))
+		values = append(values, append([]byte{}, iter.Value()...))
+	}
+	iter.Close()
+
+	for idx, key := range keys {
+		store.Delete(key)
+		store.Set(providerKey(sdk.AccAddress(key)), values[idx])
+	}
+	return nil
+}
+
+// migrateV2 sets the module params, which version 2 stores do not have
+func (k Keeper) migrateV2(ctx sdk.Context) error {
+	k.SetParams(ctx, types.DefaultParams())
+	return nil
+}
@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/ovrclk/akash/sdkutil"
+	"github.com/ovrclk/akash/testutil"
+	"github.com/ovrclk/akash/x/provider/keeper"
+	"github.com/ovrclk/akash/x/provider/types"
+)
+
+func TestMigrateV1(t *testing.T) {
+	ctx, pkeeper, key := setupKeeperWithStore(t)
+	prov := testutil.Provider(t)
+
+	// version 1 stores key providers by owner address alone.
+	store := ctx.KVStore(key)
+	store.Set(prov.Owner.Bytes(), pkeeper.Codec().MustMarshalBinaryBare(prov))
+	require.Equal(t, sdkutil.InitialStoreVersion, pkeeper.StoreVersion(ctx))
+
+	m := sdkutil.NewMigrator()
+	require.NoError(t, keeper.RegisterMigrations(m, pkeeper))
+	require.NoError(t, m.Migrate(ctx))
+	require.Equal(t, types.ConsensusVersion, pkeeper.StoreVersion(ctx))
+	require.False(t, store.Has(prov.Owner.Bytes()))
+	require.Equal(t, types.DefaultParams(), pkeeper.GetParams(ctx))
+
+	found, ok := pkeeper.Get(ctx, prov.Owner)
+	require.True(t, ok)
+	require.Equal(t, prov, found)
+
+	var providers []types.Provider
+	pkeeper.WithProviders(ctx, func(provider types.Provider) bool {
+		providers = append(providers, provider)
+		return false
+	})
+	require.Equal(t, []types.Provider{prov}, providers)
+}
+
+func TestMigrateV2(t *testing.T) {
+	ctx, pkeeper := setupKeeper(t)
+	pkeeper.SetStoreVersion(ctx, 2)
+
+	// version 2 chains never set the module params
+	require.Panics(t, func() { pkeeper.GetParams(ctx) })
+
+	m := sdkutil.NewMigrator()
+	require.NoError(t, keeper.RegisterMigrations(m, pkeeper))
+	require.NoError(t, m.Migrate(ctx))
+	require.Equal(t, types.ConsensusVersion, pkeeper.StoreVersion(ctx))
+	require.Equal(t, types.DefaultParams(), pkeeper.GetParams(ctx))
+}
+
+func TestMigrateNewerStore(t *testing.T) {
+	ctx, pkeeper := setupKeeper(t)
+	pkeeper.SetStoreVersion(ctx, types.ConsensusVersion+1)
+
+	m := sdkutil.NewMigrator()
+	require.NoError(t, keeper.RegisterMigrations(m, pkeeper))
+	require.True(t, sdkutil.ErrStoreVersion.Is(m.Migrate(ctx)))
+}
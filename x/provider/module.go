@@ -87,15 +87,18 @@ type AppModule struct {
 	keeper  keeper.Keeper
 	bkeeper bank.Keeper
 	mkeeper mkeeper.Keeper
+	dkeeper handler.DeploymentKeeper
 }
 
 // NewAppModule creates a new AppModule object
-func NewAppModule(k keeper.Keeper, bkeeper bank.Keeper, mkeeper mkeeper.Keeper) AppModule {
+func NewAppModule(k keeper.Keeper, bkeeper bank.Keeper, mkeeper mkeeper.Keeper,
+	dkeeper handler.DeploymentKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: AppModuleBasic{},
 		keeper:         k,
 		bkeeper:        bkeeper,
 		mkeeper:        mkeeper,
+		dkeeper:        dkeeper,
 	}
 }
 
@@ -105,7 +108,9 @@ func (AppModule) Name() string {
 }
 
 // RegisterInvariants registers module invariants
-func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {}
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, am.keeper, am.mkeeper)
+}
 
 // Route returns the message routing key for the provider module.
 func (am AppModule) Route() string {
@@ -114,7 +119,7 @@ func (am AppModule) Route() string {
 
 // NewHandler returns an sdk.Handler for the provider module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return handler.NewHandler(am.keeper, am.mkeeper)
+	return handler.NewHandler(am.keeper, am.mkeeper, am.dkeeper)
 }
 
 // QuerierRoute returns the provider module's querier route name.
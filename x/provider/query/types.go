@@ -20,7 +20,9 @@ func (p Provider) String() string {
 	Owner:   %s
 	HostURI: %s
 	Attributes: %v
-	`, p.Owner, p.HostURI, p.Attributes)
+	Draining: %v
+	Capacity: %v
+	`, p.Owner, p.HostURI, p.Attributes, p.Draining, p.Capacity)
 }
 
 func (obj Providers) String() string {
@@ -7,12 +7,15 @@ import (
 
 // GenesisState defines the basic genesis state used by provider module
 type GenesisState struct {
+	Params    types.Params     `json:"params"`
 	Providers []types.Provider `json:"providers"`
 }
 
 // RandomizedGenState generates a random GenesisState for supply
 func RandomizedGenState(simState *module.SimulationState) {
-	providerGenesis := GenesisState{}
+	providerGenesis := GenesisState{
+		Params: types.DefaultParams(),
+	}
 
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(providerGenesis)
 }
@@ -15,6 +15,8 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgCreateProvider{}, ModuleName+"/"+msgTypeCreateProvider, nil)
 	cdc.RegisterConcrete(MsgUpdateProvider{}, ModuleName+"/"+msgTypeUpdateProvider, nil)
 	cdc.RegisterConcrete(MsgDeleteProvider{}, ModuleName+"/"+msgTypeDeleteProvider, nil)
+	cdc.RegisterConcrete(MsgDrainProvider{}, ModuleName+"/"+msgTypeDrainProvider, nil)
+	cdc.RegisterConcrete(MsgUpdateProviderCapacity{}, ModuleName+"/"+msgTypeUpdateProviderCapacity, nil)
 }
 
 // MustMarshalJSON panics if an error occurs. Besides that it behaves exactly like MarshalJSON
@@ -12,6 +12,10 @@ const (
 	errInvalidAddress
 	errAttributes
 	errIncompatibleAttributes
+	errProviderDraining
+	errInvalidCapacity
+	_ // registered by handler.ErrInternal
+	errCapacityUpdateTooSoon
 )
 
 var (
@@ -35,4 +39,13 @@ var (
 
 	// ErrIncompatibleAttributes error code for attributes update
 	ErrIncompatibleAttributes = sdkerrors.Register(ModuleName, errIncompatibleAttributes, "attributes cannot be changed")
+
+	// ErrProviderDraining error code for providers that do not accept new bids
+	ErrProviderDraining = sdkerrors.Register(ModuleName, errProviderDraining, "provider is draining")
+
+	// ErrInvalidCapacity error code for inconsistent capacity advertisements
+	ErrInvalidCapacity = sdkerrors.Register(ModuleName, errInvalidCapacity, "invalid capacity")
+
+	// ErrCapacityUpdateTooSoon error code for capacity updates sent before the update interval elapsed
+	ErrCapacityUpdateTooSoon = sdkerrors.Register(ModuleName, errCapacityUpdateTooSoon, "capacity updated too soon")
 )
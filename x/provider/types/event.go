@@ -10,6 +10,8 @@ const (
 	evActionProviderUpdate = "provider-update"
 	evActionProviderDelete = "provider-delete"
 	evOwnerKey             = "owner"
+
+	evActionProviderCapacityUpdate = "provider-capacity-update"
 )
 
 // EventProviderCreate struct
@@ -57,6 +59,21 @@ func (ev EventProviderDelete) ToSDKEvent() sdk.Event {
 	)
 }
 
+// EventProviderCapacityUpdate struct
+type EventProviderCapacityUpdate struct {
+	Owner sdk.AccAddress
+}
+
+// ToSDKEvent method creates new sdk event for EventProviderCapacityUpdate struct
+func (ev EventProviderCapacityUpdate) ToSDKEvent() sdk.Event {
+	return sdk.NewEvent(sdkutil.EventTypeMessage,
+		append([]sdk.Attribute{
+			sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAction, evActionProviderCapacityUpdate),
+		}, ProviderEVAttributes(ev.Owner)...)...,
+	)
+}
+
 // ProviderEVAttributes returns event attribues for given Provider
 func ProviderEVAttributes(owner sdk.AccAddress) []sdk.Attribute {
 	return []sdk.Attribute{
@@ -102,6 +119,12 @@ func ParseEvent(ev sdkutil.Event) (sdkutil.ModuleEvent, error) {
 			return nil, err
 		}
 		return EventProviderDelete{Owner: owner}, nil
+	case evActionProviderCapacityUpdate:
+		owner, err := ParseEVProvider(ev.Attributes)
+		if err != nil {
+			return nil, err
+		}
+		return EventProviderCapacityUpdate{Owner: owner}, nil
 	default:
 		return nil, sdkutil.ErrUnknownAction
 	}
@@ -187,6 +187,29 @@ var TEPS = []testEventParsing{
 		},
 		expErr: errWildcard,
 	},
+	{
+		msg: sdkutil.Event{
+			Type:   sdkutil.EventTypeMessage,
+			Module: ModuleName,
+			Action: evActionProviderCapacityUpdate,
+			Attributes: []sdk.Attribute{
+				{
+					Key:   evOwnerKey,
+					Value: keyAcc.String(),
+				},
+			},
+		},
+		expErr: nil,
+	},
+	{
+		msg: sdkutil.Event{
+			Type:       sdkutil.EventTypeMessage,
+			Module:     ModuleName,
+			Action:     evActionProviderCapacityUpdate,
+			Attributes: []sdk.Attribute{},
+		},
+		expErr: errWildcard,
+	},
 }
 
 func TestEventParsing(t *testing.T) {
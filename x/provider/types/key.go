@@ -9,4 +9,9 @@ const (
 
 	// RouterKey is the message route for provider
 	RouterKey = ModuleName
+
+	// ConsensusVersion is the version of the provider store format.
+	// Version 2 adds providers keyed under a prefix.
+	// Version 3 adds the module params.
+	ConsensusVersion uint64 = 3
 )
@@ -12,10 +12,17 @@ const (
 	msgTypeCreateProvider = "create-provider"
 	msgTypeUpdateProvider = "update-provider"
 	msgTypeDeleteProvider = "delete-provider"
+	msgTypeDrainProvider  = "drain-provider"
+
+	msgTypeUpdateProviderCapacity = "update-provider-capacity"
 )
 
 // MsgCreateProvider defines an SDK message for creating a provider
-type MsgCreateProvider Provider
+type MsgCreateProvider struct {
+	Owner      sdk.AccAddress  `json:"owner"`
+	HostURI    string          `json:"host-uri"`
+	Attributes []sdk.Attribute `json:"attributes"`
+}
 
 // Route implements the sdk.Msg interface
 func (msg MsgCreateProvider) Route() string { return RouterKey }
@@ -44,8 +51,12 @@ func (msg MsgCreateProvider) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
-// MsgUpdateProvider defines an SDK message for updating a provider
-type MsgUpdateProvider Provider
+// MsgUpdateProvider defines an SDK message for updating the host and attributes of a provider
+type MsgUpdateProvider struct {
+	Owner      sdk.AccAddress  `json:"owner"`
+	HostURI    string          `json:"host-uri"`
+	Attributes []sdk.Attribute `json:"attributes"`
+}
 
 // Route implements the sdk.Msg interface
 func (msg MsgUpdateProvider) Route() string { return RouterKey }
@@ -103,6 +114,66 @@ func (msg MsgDeleteProvider) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Owner}
 }
 
+// MsgDrainProvider defines an SDK message for moving a provider in or out of the draining state
+type MsgDrainProvider struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Draining bool           `json:"draining"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgDrainProvider) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgDrainProvider) Type() string { return msgTypeDrainProvider }
+
+// ValidateBasic does basic validation
+func (msg MsgDrainProvider) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgDrain: Invalid Provider Address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgDrainProvider) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgDrainProvider) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
+// MsgUpdateProviderCapacity defines an SDK message for advertising the capacity of a provider
+type MsgUpdateProviderCapacity struct {
+	Owner    sdk.AccAddress `json:"owner"`
+	Capacity Capacity       `json:"capacity"`
+}
+
+// Route implements the sdk.Msg interface
+func (msg MsgUpdateProviderCapacity) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface
+func (msg MsgUpdateProviderCapacity) Type() string { return msgTypeUpdateProviderCapacity }
+
+// ValidateBasic does basic validation
+func (msg MsgUpdateProviderCapacity) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Owner); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, "MsgUpdateCapacity: Invalid Provider Address")
+	}
+	return msg.Capacity.Validate()
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgUpdateProviderCapacity) GetSignBytes() []byte {
+	return sdk.MustSortJSON(cdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgUpdateProviderCapacity) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Owner}
+}
+
 func validateProviderURI(val string) error {
 	u, err := url.Parse(val)
 	if err != nil {
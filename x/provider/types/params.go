@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/params"
+)
+
+const (
+	// DefaultParamspace is the paramstore subspace of the provider module
+	DefaultParamspace = ModuleName
+
+	// DefaultCapacityUpdateInterval is the default number of blocks between capacity updates
+	DefaultCapacityUpdateInterval int64 = 100
+)
+
+var (
+	// KeyCapacityUpdateInterval is the paramstore key of the capacity update interval
+	KeyCapacityUpdateInterval = []byte("CapacityUpdateInterval")
+)
+
+// Params defines the parameters of the provider module
+type Params struct {
+	// CapacityUpdateInterval is the minimum number of blocks between two capacity updates of a provider
+	CapacityUpdateInterval int64 `json:"capacity_update_interval" yaml:"capacity_update_interval"`
+}
+
+// ParamKeyTable returns the key table of the provider module params
+func ParamKeyTable() params.KeyTable {
+	return params.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// DefaultParams returns the default provider module params
+func DefaultParams() Params {
+	return Params{
+		CapacityUpdateInterval: DefaultCapacityUpdateInterval,
+	}
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() params.ParamSetPairs {
+	return params.ParamSetPairs{
+		params.NewParamSetPair(KeyCapacityUpdateInterval, &p.CapacityUpdateInterval, validateCapacityUpdateInterval),
+	}
+}
+
+// Validate returns error if params are invalid
+func (p Params) Validate() error {
+	return validateCapacityUpdateInterval(p.CapacityUpdateInterval)
+}
+
+func (p Params) String() string {
+	return fmt.Sprintf(`Provider Params:
+	Capacity Update Interval: %v`, p.CapacityUpdateInterval)
+}
+
+func validateCapacityUpdateInterval(i interface{}) error {
+	interval, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if interval < 0 {
+		return fmt.Errorf("capacity update interval cannot be negative: %v", interval)
+	}
+	return nil
+}
@@ -2,6 +2,9 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	atypes "github.com/ovrclk/akash/types"
 )
 
 // Provider stores owner and host details
@@ -9,4 +12,64 @@ type Provider struct {
 	Owner      sdk.AccAddress  `json:"owner"`
 	HostURI    string          `json:"host-uri"`
 	Attributes []sdk.Attribute `json:"attributes"`
+
+	// Draining providers keep their current leases but may not place new bids
+	Draining bool `json:"draining,omitempty"`
+
+	// Capacity is the capacity last advertised by the provider
+	Capacity *Capacity `json:"capacity,omitempty"`
+}
+
+// Capacity stores the resources a provider has available for new leases
+type Capacity struct {
+	// Available is the sum of the resources available on all nodes
+	Available atypes.Unit `json:"available"`
+
+	// Largest holds the most of each resource available on a single node
+	Largest atypes.Unit `json:"largest"`
+
+	// Prices hint at what the provider bids per GiB of memory, one coin per denomination it bids in
+	Prices sdk.Coins `json:"prices,omitempty"`
+
+	// Height is the block height the capacity was advertised at
+	Height int64 `json:"height"`
+}
+
+// Validate returns error if the capacity is inconsistent
+func (c Capacity) Validate() error {
+	if c.Largest.CPU > c.Available.CPU ||
+		c.Largest.Memory > c.Available.Memory ||
+		c.Largest.Storage > c.Available.Storage {
+		return errors.Wrap(ErrInvalidCapacity, "largest node exceeds available resources")
+	}
+	if !c.Prices.IsValid() {
+		return errors.Wrapf(ErrInvalidCapacity, "invalid prices: %v", c.Prices)
+	}
+	return nil
+}
+
+// Fits returns true if every resource of the group fits on a single node and
+// the resources of the group fit in the available capacity
+func (c Capacity) Fits(group atypes.ResourceGroup) bool {
+	total := atypes.Unit{}
+
+	for _, resource := range group.GetResources() {
+		if resource.Unit.CPU > c.Largest.CPU ||
+			resource.Unit.Memory > c.Largest.Memory ||
+			resource.Unit.Storage > c.Largest.Storage {
+			return false
+		}
+
+		count := uint64(resource.Count)
+		total.Memory += resource.Unit.Memory * count
+		total.Storage += resource.Unit.Storage * count
+
+		cpu := uint64(total.CPU) + uint64(resource.Unit.CPU)*count
+		if cpu > uint64(c.Available.CPU) {
+			return false
+		}
+		total.CPU = uint32(cpu)
+	}
+
+	return total.Memory <= c.Available.Memory && total.Storage <= c.Available.Storage
 }
@@ -0,0 +1,71 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/tendermint/tendermint/crypto/ed25519"
+
+	atypes "github.com/ovrclk/akash/types"
+)
+
+type testGroup []atypes.Resource
+
+func (g testGroup) GetName() string                 { return "test" }
+func (g testGroup) GetResources() []atypes.Resource { return g }
+
+func testCapacity() Capacity {
+	return Capacity{
+		Available: atypes.Unit{CPU: 4000, Memory: 8192, Storage: 16384},
+		Largest:   atypes.Unit{CPU: 2000, Memory: 4096, Storage: 8192},
+		Prices:    sdk.NewCoins(sdk.NewInt64Coin("akash", 50)),
+	}
+}
+
+func TestMsgUpdateProviderCapacityValidation(t *testing.T) {
+	owner := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	msg := MsgUpdateProviderCapacity{
+		Owner:    owner,
+		Capacity: testCapacity(),
+	}
+	assert.NoError(t, msg.ValidateBasic())
+
+	msg.Owner = sdk.AccAddress("")
+	assert.True(t, errors.Is(msg.ValidateBasic(), sdkerrors.ErrInvalidAddress))
+
+	msg.Owner = owner
+	msg.Capacity.Largest.Memory = msg.Capacity.Available.Memory + 1
+	assert.True(t, errors.Is(msg.ValidateBasic(), ErrInvalidCapacity))
+
+	msg.Capacity = testCapacity()
+	msg.Capacity.Prices = sdk.Coins{sdk.Coin{Denom: "akash", Amount: sdk.NewInt(-1)}}
+	assert.True(t, errors.Is(msg.ValidateBasic(), ErrInvalidCapacity))
+}
+
+func TestCapacityFits(t *testing.T) {
+	capacity := testCapacity()
+
+	assert.True(t, capacity.Fits(testGroup{
+		{Unit: atypes.Unit{CPU: 1000, Memory: 2048, Storage: 4096}, Count: 4},
+	}))
+
+	// too much in total
+	assert.False(t, capacity.Fits(testGroup{
+		{Unit: atypes.Unit{CPU: 1000, Memory: 2048, Storage: 4096}, Count: 5},
+	}))
+
+	// a unit larger than any node
+	assert.False(t, capacity.Fits(testGroup{
+		{Unit: atypes.Unit{CPU: 3000, Memory: 1024, Storage: 1024}, Count: 1},
+	}))
+
+	// totals are summed across resources
+	assert.False(t, capacity.Fits(testGroup{
+		{Unit: atypes.Unit{CPU: 100, Memory: 4096, Storage: 100}, Count: 1},
+		{Unit: atypes.Unit{CPU: 100, Memory: 4096, Storage: 100}, Count: 1},
+		{Unit: atypes.Unit{CPU: 100, Memory: 1, Storage: 100}, Count: 1},
+	}))
+}